@@ -26,6 +26,9 @@ var ClusterExtensionKind = "ClusterExtension"
 type (
 	UpgradeConstraintPolicy     string
 	CRDUpgradeSafetyEnforcement string
+	PruneBehavior               string
+	AdoptionPolicy              string
+	NamespaceDeletionPolicy     string
 
 	ClusterExtensionConfigType string
 )
@@ -47,6 +50,8 @@ const (
 )
 
 // ClusterExtensionSpec defines the desired state of ClusterExtension
+//
+// +kubebuilder:validation:XValidation:rule="(self.serviceAccount.name != \"\") != (has(self.install) && has(self.install.impersonate))",message="exactly one of [serviceAccount.name, install.impersonate] is required"
 type ClusterExtensionSpec struct {
 	// namespace specifies a Kubernetes namespace.
 	// This is the namespace where the provided ServiceAccount must exist.
@@ -61,7 +66,7 @@ type ClusterExtensionSpec struct {
 	// [RFC 1123]: https://tools.ietf.org/html/rfc1123
 	//
 	// +kubebuilder:validation:MaxLength:=63
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="namespace is immutable"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="namespace is immutable: to move to a different namespace, delete and recreate this ClusterExtension"
 	// +kubebuilder:validation:XValidation:rule="self.matches(\"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\")",message="namespace must be a valid DNS1123 label"
 	// +kubebuilder:validation:Required
 	Namespace string `json:"namespace"`
@@ -70,10 +75,13 @@ type ClusterExtensionSpec struct {
 	// that are required to manage the extension.
 	// The ServiceAccount must be configured with the necessary permissions to perform these interactions.
 	// The ServiceAccount must exist in the namespace referenced in the spec.
-	// The serviceAccount field is required.
 	//
-	// +kubebuilder:validation:Required
-	ServiceAccount ServiceAccountReference `json:"serviceAccount"`
+	// Exactly one of serviceAccount or install.impersonate is required: use serviceAccount for the
+	// standard pre-provisioned-identity model, or install.impersonate to instead have interactions
+	// performed as an impersonated user and set of groups.
+	//
+	// +optional
+	ServiceAccount ServiceAccountReference `json:"serviceAccount,omitempty"`
 
 	// source is required and selects the installation source of content for this ClusterExtension.
 	// Set the sourceType field to perform the selection.
@@ -118,6 +126,70 @@ type ClusterExtensionSpec struct {
 	// +optional
 	// <opcon:experimental>
 	ProgressDeadlineMinutes int32 `json:"progressDeadlineMinutes,omitempty"`
+
+	// rollbackTo optionally requests that the extension be reverted to a previously
+	// installed bundle version recorded in status.history. It must exactly match the
+	// bundle.version of one of those history entries; if it doesn't, the extension's
+	// Progressing condition reports the mismatch and no change is made.
+	//
+	// Setting rollbackTo re-runs resolution, preflight checks, and the apply step
+	// against that historical bundle version exactly as if it had just been resolved
+	// from the catalog, and the resulting history entry is recorded with outcome
+	// Rollback. It does not permanently pin the extension to that version: once the
+	// rollback completes, unsetting rollbackTo resumes normal catalog-driven
+	// resolution under source.catalog.version.
+	//
+	// +kubebuilder:validation:MaxLength:=64
+	// +optional
+	// <opcon:experimental>
+	RollbackTo string `json:"rollbackTo,omitempty"`
+
+	// freezeVersion optionally stops the extension from moving to a different bundle
+	// version, even if source.catalog.version is a range that would otherwise allow
+	// an automatic upgrade. The currently installed bundle continues to be
+	// reconciled and drift-corrected as normal; only resolution of a new version is
+	// skipped. This is useful for holding a version steady during a change freeze
+	// window without detaching the extension from reconciliation the way pausing it
+	// would.
+	//
+	// It has no effect on rollbackTo: an explicit rollback request is still honored
+	// while freezeVersion is set.
+	//
+	// +optional
+	// <opcon:experimental>
+	FreezeVersion bool `json:"freezeVersion,omitempty"`
+
+	// autoRevertAfterFailures optionally enables automatic reversion to the
+	// last successfully installed bundle version after this many consecutive
+	// reconcile failures trying to move to a different one (status.failureRepeatCount
+	// reaching this value). The extension stays reverted, re-applying and
+	// drift-correcting the last-known-good version, until the spec changes again;
+	// it does not automatically retry the version that failed. The RolledBack
+	// condition reports whether this has happened.
+	//
+	// When unset, persistent upgrade failures are left for the admin to resolve,
+	// which may mean the extension stays in a failed state indefinitely.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	// <opcon:experimental>
+	AutoRevertAfterFailures *int32 `json:"autoRevertAfterFailures,omitempty"`
+
+	// dependsOn optionally lists the names of other ClusterExtensions that must be
+	// Installed and Healthy before this ClusterExtension is resolved and installed.
+	// While any named ClusterExtension is missing, not yet Installed, or not yet
+	// Healthy, this ClusterExtension's resolution and installation are deferred and
+	// its WaitingForDependencies condition reports which ones are still pending.
+	//
+	// This only orders this ClusterExtension's own installation; it does not affect
+	// the reconciliation of the ClusterExtensions it depends on, and it does not
+	// detect or reject dependency cycles - a cycle simply leaves every ClusterExtension
+	// in it waiting forever.
+	//
+	// +kubebuilder:validation:MaxItems:=20
+	// +optional
+	// <opcon:experimental>
+	DependsOn []string `json:"dependsOn,omitempty"`
 }
 
 const SourceTypeCatalog = "Catalog"
@@ -161,6 +233,226 @@ type ClusterExtensionInstallConfig struct {
 	//
 	// +optional
 	Preflight *PreflightConfig `json:"preflight,omitempty"`
+
+	// unpackTimeout is optional and configures the maximum amount of time
+	// that unpacking the bundle content for this ClusterExtension may take
+	// before it is considered failed.
+	//
+	// When unspecified, the controller-wide default unpack timeout is used.
+	//
+	// The value must be a valid Go duration string, e.g. "5m", "1h30m".
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^0|([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +optional
+	UnpackTimeout *metav1.Duration `json:"unpackTimeout,omitempty"`
+
+	// timeout is optional and configures the maximum amount of time to wait
+	// for the resources applied for this ClusterExtension to become healthy
+	// during an install or upgrade before it is considered failed.
+	//
+	// When unspecified, the applier waits indefinitely.
+	//
+	// The value must be a valid Go duration string, e.g. "5m", "1h30m".
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^0|([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// pruneBehavior is optional and configures how objects that were part of
+	// a previously installed bundle revision but are absent from the
+	// currently resolved bundle are handled during install/upgrade.
+	//
+	// Allowed values are "Delete" and "Orphan". The default value is "Delete".
+	//
+	// When set to "Delete", such objects are deleted from the cluster.
+	//
+	// When set to "Orphan", such objects are left in the cluster, no longer
+	// managed by this ClusterExtension. Use this option with caution, as
+	// orphaned objects will not be cleaned up when the ClusterExtension is
+	// deleted.
+	//
+	// Note: switching from "Delete" to "Orphan" takes effect starting with the
+	// revision after the one that removes the object. An object removed from
+	// the bundle in the very same revision that first sets pruneBehavior to
+	// "Orphan" is still deleted, because it was never annotated to be kept by
+	// the prior revision's apply. From the following revision onward, objects
+	// removed from the bundle are orphaned as expected.
+	//
+	// +kubebuilder:validation:Enum:="Delete";"Orphan"
+	// +optional
+	PruneBehavior PruneBehavior `json:"pruneBehavior,omitempty"`
+
+	// adoptionPolicy is optional and configures whether resources already
+	// present on the cluster and owned by an OLMv0 ClusterServiceVersion may
+	// be taken over by this ClusterExtension on install, instead of failing
+	// because the resources already exist.
+	//
+	// Allowed values are "CreateOnly" and "AdoptOLMV0". The default value is
+	// "CreateOnly".
+	//
+	// When set to "CreateOnly", pre-existing resources are left untouched and
+	// installation fails if a resource this ClusterExtension needs to create
+	// already exists.
+	//
+	// When set to "AdoptOLMV0", a pre-existing resource that is owned by an
+	// OLMv0 ClusterServiceVersion has its OLMv0 ownership markers removed so
+	// that this ClusterExtension can take over management of it. Use this
+	// option when migrating a package from OLMv0 to OLMv1 without deleting
+	// and reinstalling it. It has no effect on resources not owned by an
+	// OLMv0 ClusterServiceVersion.
+	//
+	// +kubebuilder:validation:Enum:="CreateOnly";"AdoptOLMV0"
+	// +optional
+	AdoptionPolicy AdoptionPolicy `json:"adoptionPolicy,omitempty"`
+
+	// allowMultiplePerPackage is optional and, when the PackageSingletonPreflight feature gate
+	// is enabled, opts this ClusterExtension out of the check that otherwise fails install when
+	// another ClusterExtension already installs the same catalog package.
+	//
+	// Only set this once each ClusterExtension installing the package scopes itself to a
+	// distinct watch namespace via spec.config, so they don't end up managing the same
+	// resources; this field does not enforce that on its own.
+	//
+	// +optional
+	AllowMultiplePerPackage bool `json:"allowMultiplePerPackage,omitempty"`
+
+	// exclude is optional and specifies a list of objects that must be
+	// removed from the bundle's rendered manifests before they are applied.
+	//
+	// Use this to drop a bundled object that conflicts with cluster policy,
+	// such as a PodDisruptionBudget or NetworkPolicy the bundle ships that
+	// this cluster manages separately.
+	//
+	// +kubebuilder:validation:MaxItems=100
+	// +listType=atomic
+	// +optional
+	Exclude []ResourceExclusion `json:"exclude,omitempty"`
+
+	// createNamespace is optional and configures the controller to create the
+	// namespace referenced by spec.namespace when it does not already exist,
+	// instead of failing install.
+	//
+	// When unset, the namespace must already exist.
+	//
+	// +optional
+	CreateNamespace *CreateNamespaceConfig `json:"createNamespace,omitempty"`
+
+	// impersonate is optional and configures the controller to perform all
+	// interactions with the cluster that are required to manage the
+	// extension as an impersonated user and set of groups, instead of using
+	// a ServiceAccount.
+	//
+	// Use this, for example, when the cluster's RBAC is driven by an external
+	// identity provider (OIDC) and impersonation integrates better with that
+	// setup than provisioning a dedicated ServiceAccount.
+	//
+	// Exactly one of serviceAccount or install.impersonate is required. See
+	// the ClusterExtensionSpec documentation.
+	//
+	// +optional
+	Impersonate *ImpersonationConfig `json:"impersonate,omitempty"`
+
+	// networkPolicy is optional and configures the controller to generate a
+	// default-deny NetworkPolicy for the bundle's workloads, along with
+	// allow rules for the traffic those workloads need: webhook callbacks
+	// from the API server, and metrics scraping.
+	//
+	// Use this on clusters that require every namespace to be
+	// network-restricted.
+	//
+	// When unset, no NetworkPolicies are generated for the bundle's
+	// workloads.
+	//
+	// +optional
+	NetworkPolicy *NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+
+	// forceDeletion is optional and, when true, allows this ClusterExtension
+	// to be deleted even while custom resources of a CRD it owns still exist
+	// on the cluster.
+	//
+	// When unset or false, deletion is blocked while such custom resources
+	// exist. This is because deleting a ClusterExtension that owns a CRD
+	// deletes the CRD along with it, which in turn cascades to delete every
+	// custom resource of that CRD across every namespace on the cluster.
+	//
+	// +optional
+	ForceDeletion *bool `json:"forceDeletion,omitempty"`
+}
+
+// CreateNamespaceConfig configures the namespace created by the controller
+// on behalf of a ClusterExtension when spec.install.createNamespace is set.
+type CreateNamespaceConfig struct {
+	// labels are optional and specify the labels applied to the created
+	// namespace.
+	//
+	// Use this, for example, to apply a Pod Security Standard level, such as
+	// "pod-security.kubernetes.io/enforce": "restricted".
+	//
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// deletionPolicy is optional and configures whether the namespace created
+	// by the controller is deleted when this ClusterExtension is deleted.
+	//
+	// Allowed values are "Delete" and "Keep". The default value is "Keep".
+	//
+	// When set to "Delete", the namespace is deleted along with the
+	// ClusterExtension. Use this option with caution, as any other content
+	// left in the namespace is deleted along with it.
+	//
+	// When set to "Keep", the namespace is left in the cluster after the
+	// ClusterExtension is deleted.
+	//
+	// deletionPolicy has no effect if the namespace already existed before
+	// this ClusterExtension created it.
+	//
+	// +kubebuilder:validation:Enum:="Delete";"Keep"
+	// +optional
+	DeletionPolicy NamespaceDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// NetworkPolicyConfig configures generation of NetworkPolicies for a
+// ClusterExtension's installed workloads.
+type NetworkPolicyConfig struct {
+	// enabled is optional and, when true, generates a default-deny
+	// NetworkPolicy for the bundle's workloads in the install namespace,
+	// along with allow rules for the traffic those workloads need: webhook
+	// callbacks from the API server, and metrics scraping.
+	//
+	// When unset or false, no NetworkPolicies are generated.
+	//
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// ResourceExclusion identifies an object, by group/kind and optionally name,
+// that should be dropped from a bundle's rendered manifests before apply.
+//
+// When name is empty, every object of the given group/kind is excluded.
+type ResourceExclusion struct {
+	// group is the API group of the object to exclude. The empty string
+	// selects the core API group.
+	//
+	// +kubebuilder:validation:MaxLength:=253
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// kind is required and is the kind of the object to exclude, e.g.
+	// "PodDisruptionBudget" or "NetworkPolicy".
+	//
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Required
+	Kind string `json:"kind"`
+
+	// name optionally restricts exclusion to the object with this name.
+	//
+	// When unset, every object of the given group/kind is excluded.
+	//
+	// +kubebuilder:validation:MaxLength:=253
+	// +optional
+	Name string `json:"name,omitempty"`
 }
 
 // ClusterExtensionConfig is a discriminated union which selects the source configuration values to be merged into
@@ -378,11 +670,14 @@ type CatalogFilter struct {
 
 // ServiceAccountReference identifies the serviceAccount used fo install a ClusterExtension.
 type ServiceAccountReference struct {
-	// name is a required, immutable reference to the name of the ServiceAccount used for installation
+	// name is an immutable reference to the name of the ServiceAccount used for installation
 	// and management of the content for the package specified in the packageName field.
 	//
 	// This ServiceAccount must exist in the installNamespace.
 	//
+	// The name field is required when serviceAccount is used, and is forbidden when
+	// install.impersonate is used instead. See the ClusterExtensionSpec documentation.
+	//
 	// The name field follows the DNS subdomain standard as defined in [RFC 1123].
 	// It must contain only lowercase alphanumeric characters, hyphens (-) or periods (.),
 	// start and end with an alphanumeric character, and be no longer than 253 characters.
@@ -401,10 +696,30 @@ type ServiceAccountReference struct {
 	// [RFC 1123]: https://tools.ietf.org/html/rfc1123
 	//
 	// +kubebuilder:validation:MaxLength:=253
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
-	// +kubebuilder:validation:XValidation:rule="self.matches(\"^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$\")",message="name must be a valid DNS1123 subdomain. It must contain only lowercase alphanumeric characters, hyphens (-) or periods (.), start and end with an alphanumeric character, and be no longer than 253 characters"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable: to use a different ServiceAccount, delete and recreate this ClusterExtension"
+	// +kubebuilder:validation:XValidation:rule="self == \"\" || self.matches(\"^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$\")",message="name must be a valid DNS1123 subdomain. It must contain only lowercase alphanumeric characters, hyphens (-) or periods (.), start and end with an alphanumeric character, and be no longer than 253 characters"
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// ImpersonationConfig identifies the user and groups impersonated to install a ClusterExtension.
+type ImpersonationConfig struct {
+	// username is required and specifies the name of the user to impersonate
+	// for installation and management of the content for the package
+	// specified in the packageName field.
+	//
+	// +kubebuilder:validation:MaxLength:=253
 	// +kubebuilder:validation:Required
-	Name string `json:"name"`
+	Username string `json:"username"`
+
+	// groups is optional and specifies the groups to impersonate, in
+	// addition to username, for installation and management of the content
+	// for the package specified in the packageName field.
+	//
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=atomic
+	// +optional
+	Groups []string `json:"groups,omitempty"`
 }
 
 // PreflightConfig holds the configuration for the preflight checks.  If used, at least one preflight check must be non-nil.
@@ -447,6 +762,21 @@ const (
 	CRDUpgradeSafetyEnforcementNone CRDUpgradeSafetyEnforcement = "None"
 	// Strict will enforce the CRD upgrade safety check and block the upgrade if the CRD would not pass the check.
 	CRDUpgradeSafetyEnforcementStrict CRDUpgradeSafetyEnforcement = "Strict"
+
+	// Delete will delete objects that are no longer part of the resolved bundle.
+	PruneBehaviorDelete PruneBehavior = "Delete"
+	// Orphan will leave objects that are no longer part of the resolved bundle in the cluster, unmanaged.
+	PruneBehaviorOrphan PruneBehavior = "Orphan"
+
+	// CreateOnly will leave pre-existing resources untouched, failing installation if one already exists.
+	AdoptionPolicyCreateOnly AdoptionPolicy = "CreateOnly"
+	// AdoptOLMV0 will take over management of pre-existing resources owned by an OLMv0 ClusterServiceVersion.
+	AdoptionPolicyAdoptOLMV0 AdoptionPolicy = "AdoptOLMV0"
+
+	// Delete will delete the namespace created for this ClusterExtension when the ClusterExtension is deleted.
+	NamespaceDeletionPolicyDelete NamespaceDeletionPolicy = "Delete"
+	// Keep will leave the namespace created for this ClusterExtension in the cluster when the ClusterExtension is deleted.
+	NamespaceDeletionPolicyKeep NamespaceDeletionPolicy = "Keep"
 )
 
 // BundleMetadata is a representation of the identifying attributes of a bundle.
@@ -467,6 +797,38 @@ type BundleMetadata struct {
 	Version string `json:"version"`
 }
 
+// UpgradeEdgeReason describes why the catalog treats a bundle as reachable from the
+// installed bundle via an upgrade edge.
+type UpgradeEdgeReason string
+
+const (
+	// UpgradeEdgeReasonReplaces is used when the candidate bundle's channel entry names the
+	// installed bundle in its replaces field.
+	UpgradeEdgeReasonReplaces UpgradeEdgeReason = "Replaces"
+	// UpgradeEdgeReasonSkips is used when the candidate bundle's channel entry names the
+	// installed bundle in its skips list.
+	UpgradeEdgeReasonSkips UpgradeEdgeReason = "Skips"
+	// UpgradeEdgeReasonSkipRange is used when the installed bundle's version falls within the
+	// candidate bundle's channel entry skipRange.
+	UpgradeEdgeReasonSkipRange UpgradeEdgeReason = "SkipRange"
+)
+
+// AvailableUpgradeEdge describes a single catalog-provided upgrade edge from the installed
+// bundle to a candidate bundle.
+type AvailableUpgradeEdge struct {
+	// bundle is required and identifies the candidate bundle this edge leads to.
+	//
+	// +kubebuilder:validation:Required
+	Bundle BundleMetadata `json:"bundle"`
+
+	// reason is required and explains why the catalog connects the installed bundle to this
+	// candidate: Replaces, Skips, or SkipRange.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum:=Replaces;Skips;SkipRange
+	Reason UpgradeEdgeReason `json:"reason"`
+}
+
 // RevisionStatus defines the observed state of a ClusterExtensionRevision.
 type RevisionStatus struct {
 	// name of the ClusterExtensionRevision resource
@@ -524,8 +886,140 @@ type ClusterExtensionStatus struct {
 	// +optional
 	// <opcon:experimental>
 	ActiveRevisions []RevisionStatus `json:"activeRevisions,omitempty"`
+
+	// unhealthyObjects lists a bounded set of applied resources that are not
+	// currently healthy, formatted as "<kind> <namespace>/<name>: <reason>".
+	// It is only populated while the Healthy condition is False, and is
+	// truncated if there are more unhealthy resources than can be listed.
+	//
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=atomic
+	// +optional
+	UnhealthyObjects []string `json:"unhealthyObjects,omitempty"`
+
+	// applyErrors lists a bounded set of per-object errors encountered while
+	// applying the bundle's contents, formatted as "<kind> <namespace>/<name>:
+	// <error>", so that a partial apply failure (e.g. a single forbidden RBAC
+	// object) can be diagnosed without parsing the flattened Progressing
+	// message. It is only populated when the apply step fails, and is
+	// truncated if there are more errors than can be listed.
+	//
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=atomic
+	// +optional
+	ApplyErrors []string `json:"applyErrors,omitempty"`
+
+	// missingPermissions lists a bounded set of RBAC rules the configured
+	// ServiceAccount is missing to manage the bundle's contents, rendered as
+	// ready-to-apply Role/ClusterRole YAML documents. It is only populated
+	// when the apply step fails because of missing permissions, and is
+	// truncated if there are more missing rules than can be listed.
+	//
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=atomic
+	// +optional
+	MissingPermissions []string `json:"missingPermissions,omitempty"`
+
+	// availableUpgradeEdges lists a bounded set of bundles the installed bundle can move to
+	// according to the catalog's upgrade graph (replaces, skips, and skipRange entries),
+	// regardless of whether UpgradeConstraintPolicy or a ClusterExtensionUpgradeEdgeOverride
+	// would actually permit resolving to them. It is only populated when semver+replaces
+	// resolution found the installed bundle in the catalog, and is truncated, favoring the
+	// highest versions, if there are more edges than can be listed.
+	//
+	// +kubebuilder:validation:MaxItems=20
+	// +listType=atomic
+	// +optional
+	AvailableUpgradeEdges []AvailableUpgradeEdge `json:"availableUpgradeEdges,omitempty"`
+
+	// lastResolvedTime is the last time bundle resolution succeeded for this ClusterExtension.
+	// +optional
+	LastResolvedTime *metav1.Time `json:"lastResolvedTime,omitempty"`
+
+	// lastUnpackedTime is the last time the resolved bundle's contents were unpacked.
+	// +optional
+	LastUnpackedTime *metav1.Time `json:"lastUnpackedTime,omitempty"`
+
+	// lastInstallTime is the last time the bundle's contents were successfully applied to the cluster,
+	// whether that was the initial install or a subsequent upgrade.
+	// +optional
+	LastInstallTime *metav1.Time `json:"lastInstallTime,omitempty"`
+
+	// lastAutomaticUpgradeTime is the last time this ClusterExtension was upgraded by channel-head
+	// tracking, i.e. version does not pin an exact version and a newer bundle was applied without
+	// any spec change. It is unset for a ClusterExtension that pins an exact version, since pinning
+	// an exact version never produces an automatic upgrade.
+	// +optional
+	LastAutomaticUpgradeTime *metav1.Time `json:"lastAutomaticUpgradeTime,omitempty"`
+
+	// history records a bounded, most-recent-first log of the bundles that
+	// have been successfully applied to the cluster for this ClusterExtension,
+	// so an admin can see how it got to its current version without an
+	// external audit system. It is truncated if there are more entries than
+	// can be listed.
+	//
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=atomic
+	// +optional
+	History []ClusterExtensionHistoryEntry `json:"history,omitempty"`
+
+	// failureRepeatCount is the number of consecutive reconciles that have failed with the same
+	// error message as the current Progressing condition. It resets to zero whenever the error
+	// changes or reconciliation succeeds, letting an admin tell a fleeting blip apart from a hot
+	// failure loop without having to correlate controller log timestamps.
+	//
+	// +optional
+	FailureRepeatCount int32 `json:"failureRepeatCount,omitempty"`
+
+	// nextRetryTime is an estimate of when the controller will next retry reconciliation after the
+	// failure described by the current Progressing condition. It lets an admin decide whether to
+	// wait for the next automatic retry or intervene immediately, without having to know the
+	// controller's backoff algorithm. It is cleared whenever the error resolves or spec changes,
+	// since either restarts the backoff from its initial delay.
+	//
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
 }
 
+// ClusterExtensionHistoryEntry records a single bundle version that was
+// successfully applied to the cluster for a ClusterExtension.
+type ClusterExtensionHistoryEntry struct {
+	// bundle is required and represents the identifying attributes of the bundle that was applied.
+	//
+	// +kubebuilder:validation:Required
+	Bundle BundleMetadata `json:"bundle"`
+
+	// image is the resolved, content-addressable reference (including digest) of the bundle image that was applied.
+	//
+	// +kubebuilder:validation:Required
+	Image string `json:"image"`
+
+	// outcome describes how this bundle version relates to the one applied immediately before it:
+	// Install for the first bundle ever applied, Upgrade for a move to a newer version, and Rollback for a move to an older version.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum:=Install;Upgrade;Rollback
+	Outcome ClusterExtensionHistoryOutcome `json:"outcome"`
+
+	// installedAt is the time this bundle version was successfully applied.
+	//
+	// +kubebuilder:validation:Required
+	InstalledAt metav1.Time `json:"installedAt"`
+}
+
+// ClusterExtensionHistoryOutcome describes how a ClusterExtensionHistoryEntry's bundle version
+// relates to the one applied immediately before it.
+type ClusterExtensionHistoryOutcome string
+
+const (
+	// ClusterExtensionHistoryOutcomeInstall is used for the first bundle ever applied for a ClusterExtension.
+	ClusterExtensionHistoryOutcomeInstall ClusterExtensionHistoryOutcome = "Install"
+	// ClusterExtensionHistoryOutcomeUpgrade is used when a bundle version replaces an older previously installed version.
+	ClusterExtensionHistoryOutcomeUpgrade ClusterExtensionHistoryOutcome = "Upgrade"
+	// ClusterExtensionHistoryOutcomeRollback is used when a bundle version replaces a newer previously installed version.
+	ClusterExtensionHistoryOutcomeRollback ClusterExtensionHistoryOutcome = "Rollback"
+)
+
 // ClusterExtensionInstallStatus is a representation of the status of the identified bundle.
 type ClusterExtensionInstallStatus struct {
 	// bundle is required and represents the identifying attributes of a bundle.
@@ -535,9 +1029,16 @@ type ClusterExtensionInstallStatus struct {
 	//
 	// +kubebuilder:validation:Required
 	Bundle BundleMetadata `json:"bundle"`
+
+	// installedAt is the time this bundle version was first successfully installed.
+	// +optional
+	InstalledAt *metav1.Time `json:"installedAt,omitempty"`
 }
 
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
+// +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Installed Bundle",type=string,JSONPath=`.status.install.bundle.name`
@@ -0,0 +1,129 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Condition types
+	TypeValid = "Valid"
+
+	// Valid reasons
+	ReasonEdgesValid  = "EdgesValid"
+	ReasonInvalidEdge = "InvalidEdge"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name=Package,type=string,JSONPath=`.spec.packageName`
+//+kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterExtensionUpgradeEdgeOverride lets a fleet administrator whitelist specific
+// non-successor upgrade paths for a package - version jumps that a catalog's replaces,
+// skips, or skipRange graph does not otherwise permit - without relaxing the
+// UpgradeConstraintPolicy of every ClusterExtension that installs that package.
+//
+// Each whitelisted edge is additive: it is only ever consulted when the catalog's own
+// upgrade graph already rules a candidate version out, and it never overrides a
+// ClusterExtension whose UpgradeConstraintPolicy is already SelfCertified, which ignores
+// the upgrade graph entirely.
+type ClusterExtensionUpgradeEdgeOverride struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata"`
+
+	// spec is a required field that defines the desired state of the ClusterExtensionUpgradeEdgeOverride.
+	// +kubebuilder:validation:Required
+	Spec ClusterExtensionUpgradeEdgeOverrideSpec `json:"spec"`
+
+	// status contains information about whether this override's edges are valid.
+	// +optional
+	Status ClusterExtensionUpgradeEdgeOverrideStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterExtensionUpgradeEdgeOverrideList contains a list of ClusterExtensionUpgradeEdgeOverride
+type ClusterExtensionUpgradeEdgeOverrideList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata"`
+
+	// items is a list of ClusterExtensionUpgradeEdgeOverrides.
+	// items is required.
+	// +kubebuilder:validation:Required
+	Items []ClusterExtensionUpgradeEdgeOverride `json:"items"`
+}
+
+// ClusterExtensionUpgradeEdgeOverrideSpec defines the desired state of ClusterExtensionUpgradeEdgeOverride
+type ClusterExtensionUpgradeEdgeOverrideSpec struct {
+	// packageName is a required field that names the package these edges apply to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength:=253
+	PackageName string `json:"packageName"`
+
+	// edges is a required field listing the non-successor upgrade paths that are
+	// whitelisted for packageName.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:MaxItems:=100
+	Edges []UpgradeEdgeOverride `json:"edges"`
+}
+
+// UpgradeEdgeOverride whitelists upgrading packageName directly from fromVersion to
+// toVersion, regardless of whether the catalog's upgrade graph connects them.
+type UpgradeEdgeOverride struct {
+	// fromVersion is a required field giving the installed version this edge starts from,
+	// in semver format.
+	// +kubebuilder:validation:Required
+	FromVersion string `json:"fromVersion"`
+
+	// toVersion is a required field giving the version this edge allows upgrading to,
+	// in semver format.
+	// +kubebuilder:validation:Required
+	ToVersion string `json:"toVersion"`
+}
+
+// ClusterExtensionUpgradeEdgeOverrideStatus defines the observed state of ClusterExtensionUpgradeEdgeOverride
+type ClusterExtensionUpgradeEdgeOverrideStatus struct {
+	// conditions represents the current state of this ClusterExtensionUpgradeEdgeOverride.
+	//
+	// The current condition type is Valid, which represents whether every edge in
+	// spec.edges names well-formed semver versions:
+	//   - When status is True and reason is EdgesValid, every edge is well-formed.
+	//   - When status is False and reason is InvalidEdge, at least one edge's
+	//     fromVersion or toVersion is not a valid semver version.
+	//
+	// An invalid edge is never consulted during resolution; only well-formed edges in a
+	// Valid ClusterExtensionUpgradeEdgeOverride take effect.
+	//
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterExtensionUpgradeEdgeOverride{}, &ClusterExtensionUpgradeEdgeOverrideList{})
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Condition types
+	TypeSelecting = "Selecting"
+
+	// Selecting reasons
+	ReasonExtensionsSelected  = "ExtensionsSelected"
+	ReasonNoExtensionsMatched = "NoExtensionsMatched"
+	ReasonInvalidSelector     = "InvalidSelector"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name=MatchedExtensions,type=integer,JSONPath=`.status.matchedExtensions`
+//+kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterExtensionUpgradePolicy lets a fleet administrator apply a shared set of
+// upgrade controls to every ClusterExtension matched by a label selector, instead
+// of configuring each ClusterExtension individually.
+//
+// A ClusterExtensionUpgradePolicy only selects and reports on the ClusterExtensions
+// it applies to; it does not itself install or modify them. status.matchedExtensions
+// reflects the current selection on every reconcile.
+type ClusterExtensionUpgradePolicy struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata"`
+
+	// spec is a required field that defines the desired state of the ClusterExtensionUpgradePolicy.
+	// +kubebuilder:validation:Required
+	Spec ClusterExtensionUpgradePolicySpec `json:"spec"`
+
+	// status contains information about which ClusterExtensions this policy currently selects.
+	// +optional
+	Status ClusterExtensionUpgradePolicyStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterExtensionUpgradePolicyList contains a list of ClusterExtensionUpgradePolicy
+type ClusterExtensionUpgradePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata"`
+
+	// items is a list of ClusterExtensionUpgradePolicies.
+	// items is required.
+	// +kubebuilder:validation:Required
+	Items []ClusterExtensionUpgradePolicy `json:"items"`
+}
+
+// ClusterExtensionUpgradePolicySpec defines the desired state of ClusterExtensionUpgradePolicy
+type ClusterExtensionUpgradePolicySpec struct {
+	// selector is a required field that selects the ClusterExtensions this policy applies to.
+	// An empty selector selects no ClusterExtensions.
+	// +kubebuilder:validation:Required
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// upgradeWindows is an optional field that restricts the times during which matched
+	// ClusterExtensions are permitted to move to a different bundle version.
+	//
+	// When omitted, matched ClusterExtensions are not restricted to any window.
+	//
+	// +optional
+	UpgradeWindows []UpgradeWindow `json:"upgradeWindows,omitempty"`
+
+	// approvalRequired is an optional field that, when true, indicates that a matched
+	// ClusterExtension's upgrade to a different bundle version requires explicit approval
+	// before it proceeds.
+	//
+	// When omitted, the default value is false.
+	//
+	// +kubebuilder:default:=false
+	// +optional
+	ApprovalRequired bool `json:"approvalRequired,omitempty"`
+
+	// maxConcurrentUpgrades is an optional field that caps how many of the matched
+	// ClusterExtensions may upgrade to a different bundle version at the same time.
+	//
+	// When omitted, there is no limit on the number of concurrent upgrades.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxConcurrentUpgrades *int32 `json:"maxConcurrentUpgrades,omitempty"`
+}
+
+// UpgradeWindow defines a recurring window of time, expressed as a set of days and a
+// start/end time-of-day, during which matched ClusterExtensions are permitted to upgrade.
+type UpgradeWindow struct {
+	// days is a required field listing the days of the week this window applies to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:Items:Enum:="Sunday";"Monday";"Tuesday";"Wednesday";"Thursday";"Friday";"Saturday"
+	Days []string `json:"days"`
+
+	// startTime is a required field giving the start of the window, in 24-hour "HH:MM" format, UTC.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+
+	// endTime is a required field giving the end of the window, in 24-hour "HH:MM" format, UTC.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	EndTime string `json:"endTime"`
+}
+
+// ClusterExtensionUpgradePolicyStatus defines the observed state of ClusterExtensionUpgradePolicy
+type ClusterExtensionUpgradePolicyStatus struct {
+	// conditions represents the current state of this ClusterExtensionUpgradePolicy.
+	//
+	// The current condition type is Selecting, which represents whether the selector
+	// could be evaluated and, if so, whether it currently matches any ClusterExtensions:
+	//   - When status is True and reason is ExtensionsSelected, the selector matched one or more ClusterExtensions.
+	//   - When status is False and reason is NoExtensionsMatched, the selector is valid but matched no ClusterExtensions.
+	//   - When status is False and reason is InvalidSelector, the selector could not be evaluated.
+	//
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// matchedExtensions lists the names of the ClusterExtensions currently selected by this policy.
+	// +optional
+	MatchedExtensions []string `json:"matchedExtensions,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterExtensionUpgradePolicy{}, &ClusterExtensionUpgradePolicyList{})
+}
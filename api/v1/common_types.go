@@ -17,22 +17,67 @@ limitations under the License.
 package v1
 
 const (
-	TypeInstalled   = "Installed"
-	TypeProgressing = "Progressing"
+	TypeInstalled                          = "Installed"
+	TypeProgressing                        = "Progressing"
+	TypeHealthy                            = "Healthy"
+	TypeUpgradeAvailable                   = "UpgradeAvailable"
+	TypeRolledBack                         = "RolledBack"
+	TypeWaitingForDependencies             = "WaitingForDependencies"
+	TypePaused                             = "Paused"
+	TypeInstalledVersionMissingFromCatalog = "InstalledVersionMissingFromCatalog"
+	TypeManagedByOLMv0                     = "ManagedByOLMv0"
+	TypeDuplicatePackageInstall            = "DuplicatePackageInstall"
 
 	// Installed reasons
-	ReasonAbsent = "Absent"
+	ReasonAbsent  = "Absent"
+	ReasonTimeout = "Timeout"
+
+	// UpgradeAvailable reasons
+	ReasonUpgradeAvailable        = "UpgradeAvailable"
+	ReasonNoUpgradeAvailable      = "NoUpgradeAvailable"
+	ReasonUpgradeAvailableUnknown = "UpgradeAvailableUnknown"
 
 	// Progressing reasons
-	ReasonRollingOut = "RollingOut"
-	ReasonRetrying   = "Retrying"
-	ReasonBlocked    = "Blocked"
+	ReasonRollingOut                  = "RollingOut"
+	ReasonRetrying                    = "Retrying"
+	ReasonBlocked                     = "Blocked"
+	ReasonUnpackTimeout               = "UnpackTimeout"
+	ReasonQuotaExceeded               = "QuotaExceeded"
+	ReasonAdmissionDenied             = "AdmissionDenied"
+	ReasonTerminating                 = "Terminating"
+	ReasonBlockedByUpgradeConstraints = "BlockedByUpgradeConstraints"
 
 	// Deprecation reasons
 	ReasonDeprecated               = "Deprecated"
 	ReasonNotDeprecated            = "NotDeprecated"
 	ReasonDeprecationStatusUnknown = "DeprecationStatusUnknown"
 
+	// Healthy reasons
+	ReasonHealthUnknown = "HealthUnknown"
+
+	// RolledBack reasons
+	ReasonPersistentUpgradeFailure = "PersistentUpgradeFailure"
+	ReasonNotRolledBack            = "NotRolledBack"
+
+	// WaitingForDependencies reasons
+	ReasonDependenciesNotReady = "DependenciesNotReady"
+	ReasonDependenciesReady    = "DependenciesReady"
+
+	// InstalledVersionMissingFromCatalog reasons
+	ReasonInstalledVersionMissingFromCatalog = "InstalledVersionMissingFromCatalog"
+
+	// ManagedByOLMv0 reasons
+	ReasonManagedByOLMv0    = "ManagedByOLMv0"
+	ReasonNotManagedByOLMv0 = "NotManagedByOLMv0"
+
+	// DuplicatePackageInstall reasons
+	ReasonDuplicatePackageInstall   = "DuplicatePackageInstall"
+	ReasonNoDuplicatePackageInstall = "NoDuplicatePackageInstall"
+
+	// Paused reasons
+	ReasonMaintenanceModeEnabled  = "MaintenanceModeEnabled"
+	ReasonMaintenanceModeDisabled = "MaintenanceModeDisabled"
+
 	// Common reasons
 	ReasonSucceeded                = "Succeeded"
 	ReasonFailed                   = "Failed"
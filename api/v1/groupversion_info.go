@@ -28,9 +28,20 @@ var (
 	// GroupVersion is group version used to register these objects
 	GroupVersion = schema.GroupVersion{Group: "olm.operatorframework.io", Version: "v1"}
 
+	// SchemeGroupVersion is an alias of GroupVersion, named to match the convention client-gen
+	// expects when generating the typed clientset under pkg/generated.
+	SchemeGroupVersion = GroupVersion
+
 	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
 	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
 
 	// AddToScheme adds the types in this group-version to the given scheme.
 	AddToScheme = SchemeBuilder.AddToScheme
 )
+
+// Resource takes an unqualified resource name and returns a GroupResource qualified with this
+// package's group, matching the convention client-gen expects when generating the typed listers
+// under pkg/generated.
+func Resource(resource string) schema.GroupResource {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
+}
@@ -21,10 +21,27 @@ limitations under the License.
 package v1
 
 import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailableUpgradeEdge) DeepCopyInto(out *AvailableUpgradeEdge) {
+	*out = *in
+	out.Bundle = in.Bundle
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailableUpgradeEdge.
+func (in *AvailableUpgradeEdge) DeepCopy() *AvailableUpgradeEdge {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailableUpgradeEdge)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BundleMetadata) DeepCopyInto(out *BundleMetadata) {
 	*out = *in
@@ -65,7 +82,8 @@ func (in *CatalogFilter) DeepCopyInto(out *CatalogFilter) {
 	}
 	if in.Selector != nil {
 		in, out := &in.Selector, &out.Selector
-		*out = (*in).DeepCopy()
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -257,7 +275,8 @@ func (in *ClusterExtensionConfig) DeepCopyInto(out *ClusterExtensionConfig) {
 	*out = *in
 	if in.Inline != nil {
 		in, out := &in.Inline, &out.Inline
-		*out = (*in).DeepCopy()
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -279,6 +298,41 @@ func (in *ClusterExtensionInstallConfig) DeepCopyInto(out *ClusterExtensionInsta
 		*out = new(PreflightConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UnpackTimeout != nil {
+		in, out := &in.UnpackTimeout, &out.UnpackTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]ResourceExclusion, len(*in))
+		copy(*out, *in)
+	}
+	if in.CreateNamespace != nil {
+		in, out := &in.CreateNamespace, &out.CreateNamespace
+		*out = new(CreateNamespaceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Impersonate != nil {
+		in, out := &in.Impersonate, &out.Impersonate
+		*out = new(ImpersonationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicyConfig)
+		**out = **in
+	}
+	if in.ForceDeletion != nil {
+		in, out := &in.ForceDeletion, &out.ForceDeletion
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExtensionInstallConfig.
@@ -295,6 +349,10 @@ func (in *ClusterExtensionInstallConfig) DeepCopy() *ClusterExtensionInstallConf
 func (in *ClusterExtensionInstallStatus) DeepCopyInto(out *ClusterExtensionInstallStatus) {
 	*out = *in
 	out.Bundle = in.Bundle
+	if in.InstalledAt != nil {
+		in, out := &in.InstalledAt, &out.InstalledAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExtensionInstallStatus.
@@ -307,6 +365,23 @@ func (in *ClusterExtensionInstallStatus) DeepCopy() *ClusterExtensionInstallStat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterExtensionHistoryEntry) DeepCopyInto(out *ClusterExtensionHistoryEntry) {
+	*out = *in
+	out.Bundle = in.Bundle
+	in.InstalledAt.DeepCopyInto(&out.InstalledAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExtensionHistoryEntry.
+func (in *ClusterExtensionHistoryEntry) DeepCopy() *ClusterExtensionHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterExtensionHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterExtensionList) DeepCopyInto(out *ClusterExtensionList) {
 	*out = *in
@@ -495,6 +570,16 @@ func (in *ClusterExtensionSpec) DeepCopyInto(out *ClusterExtensionSpec) {
 		*out = new(ClusterExtensionConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AutoRevertAfterFailures != nil {
+		in, out := &in.AutoRevertAfterFailures, &out.AutoRevertAfterFailures
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExtensionSpec.
@@ -520,7 +605,7 @@ func (in *ClusterExtensionStatus) DeepCopyInto(out *ClusterExtensionStatus) {
 	if in.Install != nil {
 		in, out := &in.Install, &out.Install
 		*out = new(ClusterExtensionInstallStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ActiveRevisions != nil {
 		in, out := &in.ActiveRevisions, &out.ActiveRevisions
@@ -529,6 +614,53 @@ func (in *ClusterExtensionStatus) DeepCopyInto(out *ClusterExtensionStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UnhealthyObjects != nil {
+		in, out := &in.UnhealthyObjects, &out.UnhealthyObjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApplyErrors != nil {
+		in, out := &in.ApplyErrors, &out.ApplyErrors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MissingPermissions != nil {
+		in, out := &in.MissingPermissions, &out.MissingPermissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AvailableUpgradeEdges != nil {
+		in, out := &in.AvailableUpgradeEdges, &out.AvailableUpgradeEdges
+		*out = make([]AvailableUpgradeEdge, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastResolvedTime != nil {
+		in, out := &in.LastResolvedTime, &out.LastResolvedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUnpackedTime != nil {
+		in, out := &in.LastUnpackedTime, &out.LastUnpackedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastInstallTime != nil {
+		in, out := &in.LastInstallTime, &out.LastInstallTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastAutomaticUpgradeTime != nil {
+		in, out := &in.LastAutomaticUpgradeTime, &out.LastAutomaticUpgradeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]ClusterExtensionHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextRetryTime != nil {
+		in, out := &in.NextRetryTime, &out.NextRetryTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExtensionStatus.
@@ -541,6 +673,278 @@ func (in *ClusterExtensionStatus) DeepCopy() *ClusterExtensionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterExtensionUpgradeEdgeOverride) DeepCopyInto(out *ClusterExtensionUpgradeEdgeOverride) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExtensionUpgradeEdgeOverride.
+func (in *ClusterExtensionUpgradeEdgeOverride) DeepCopy() *ClusterExtensionUpgradeEdgeOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterExtensionUpgradeEdgeOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterExtensionUpgradeEdgeOverride) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterExtensionUpgradeEdgeOverrideList) DeepCopyInto(out *ClusterExtensionUpgradeEdgeOverrideList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterExtensionUpgradeEdgeOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExtensionUpgradeEdgeOverrideList.
+func (in *ClusterExtensionUpgradeEdgeOverrideList) DeepCopy() *ClusterExtensionUpgradeEdgeOverrideList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterExtensionUpgradeEdgeOverrideList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterExtensionUpgradeEdgeOverrideList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterExtensionUpgradeEdgeOverrideSpec) DeepCopyInto(out *ClusterExtensionUpgradeEdgeOverrideSpec) {
+	*out = *in
+	if in.Edges != nil {
+		in, out := &in.Edges, &out.Edges
+		*out = make([]UpgradeEdgeOverride, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExtensionUpgradeEdgeOverrideSpec.
+func (in *ClusterExtensionUpgradeEdgeOverrideSpec) DeepCopy() *ClusterExtensionUpgradeEdgeOverrideSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterExtensionUpgradeEdgeOverrideSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterExtensionUpgradeEdgeOverrideStatus) DeepCopyInto(out *ClusterExtensionUpgradeEdgeOverrideStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExtensionUpgradeEdgeOverrideStatus.
+func (in *ClusterExtensionUpgradeEdgeOverrideStatus) DeepCopy() *ClusterExtensionUpgradeEdgeOverrideStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterExtensionUpgradeEdgeOverrideStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterExtensionUpgradePolicy) DeepCopyInto(out *ClusterExtensionUpgradePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExtensionUpgradePolicy.
+func (in *ClusterExtensionUpgradePolicy) DeepCopy() *ClusterExtensionUpgradePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterExtensionUpgradePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterExtensionUpgradePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterExtensionUpgradePolicyList) DeepCopyInto(out *ClusterExtensionUpgradePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterExtensionUpgradePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExtensionUpgradePolicyList.
+func (in *ClusterExtensionUpgradePolicyList) DeepCopy() *ClusterExtensionUpgradePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterExtensionUpgradePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterExtensionUpgradePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterExtensionUpgradePolicySpec) DeepCopyInto(out *ClusterExtensionUpgradePolicySpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.UpgradeWindows != nil {
+		in, out := &in.UpgradeWindows, &out.UpgradeWindows
+		*out = make([]UpgradeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxConcurrentUpgrades != nil {
+		in, out := &in.MaxConcurrentUpgrades, &out.MaxConcurrentUpgrades
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExtensionUpgradePolicySpec.
+func (in *ClusterExtensionUpgradePolicySpec) DeepCopy() *ClusterExtensionUpgradePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterExtensionUpgradePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterExtensionUpgradePolicyStatus) DeepCopyInto(out *ClusterExtensionUpgradePolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MatchedExtensions != nil {
+		in, out := &in.MatchedExtensions, &out.MatchedExtensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExtensionUpgradePolicyStatus.
+func (in *ClusterExtensionUpgradePolicyStatus) DeepCopy() *ClusterExtensionUpgradePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterExtensionUpgradePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeEdgeOverride) DeepCopyInto(out *UpgradeEdgeOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeEdgeOverride.
+func (in *UpgradeEdgeOverride) DeepCopy() *UpgradeEdgeOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeEdgeOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeWindow) DeepCopyInto(out *UpgradeWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeWindow.
+func (in *UpgradeWindow) DeepCopy() *UpgradeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CreateNamespaceConfig) DeepCopyInto(out *CreateNamespaceConfig) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateNamespaceConfig.
+func (in *CreateNamespaceConfig) DeepCopy() *CreateNamespaceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CreateNamespaceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageSource) DeepCopyInto(out *ImageSource) {
 	*out = *in
@@ -561,6 +965,41 @@ func (in *ImageSource) DeepCopy() *ImageSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImpersonationConfig) DeepCopyInto(out *ImpersonationConfig) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImpersonationConfig.
+func (in *ImpersonationConfig) DeepCopy() *ImpersonationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImpersonationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyConfig) DeepCopyInto(out *NetworkPolicyConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyConfig.
+func (in *NetworkPolicyConfig) DeepCopy() *NetworkPolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PreflightConfig) DeepCopyInto(out *PreflightConfig) {
 	*out = *in
@@ -616,6 +1055,21 @@ func (in *ResolvedImageSource) DeepCopy() *ResolvedImageSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceExclusion) DeepCopyInto(out *ResourceExclusion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceExclusion.
+func (in *ResourceExclusion) DeepCopy() *ResourceExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RevisionStatus) DeepCopyInto(out *RevisionStatus) {
 	*out = *in
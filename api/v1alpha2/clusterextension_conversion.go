@@ -0,0 +1,124 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+// ConvertTo converts this ClusterExtension to the hub version (api/v1), folding the install
+// identity union (serviceAccount/impersonate) back into v1's separate spec.serviceAccount field.
+func (src *ClusterExtension) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*ocv1.ClusterExtension)
+	if !ok {
+		return fmt.Errorf("expected *v1.ClusterExtension but got %T", dstRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.Namespace = src.Spec.Namespace
+	dst.Spec.ServiceAccount.Name = src.Spec.Install.ServiceAccount.Name
+
+	if err := convertIdentical(&src.Spec.Source, &dst.Spec.Source); err != nil {
+		return fmt.Errorf("converting spec.source: %w", err)
+	}
+
+	dst.Spec.Install = &ocv1.ClusterExtensionInstallConfig{}
+	if err := convertIdentical(&src.Spec.Install, dst.Spec.Install); err != nil {
+		return fmt.Errorf("converting spec.install: %w", err)
+	}
+
+	if src.Spec.Config != nil {
+		dst.Spec.Config = &ocv1.ClusterExtensionConfig{}
+		if err := convertIdentical(src.Spec.Config, dst.Spec.Config); err != nil {
+			return fmt.Errorf("converting spec.config: %w", err)
+		}
+	}
+
+	dst.Spec.ProgressDeadlineMinutes = src.Spec.ProgressDeadlineMinutes
+	dst.Spec.RollbackTo = src.Spec.RollbackTo
+	dst.Spec.FreezeVersion = src.Spec.FreezeVersion
+	dst.Spec.AutoRevertAfterFailures = src.Spec.AutoRevertAfterFailures
+	dst.Spec.DependsOn = src.Spec.DependsOn
+
+	if err := convertIdentical(&src.Status, &dst.Status); err != nil {
+		return fmt.Errorf("converting status: %w", err)
+	}
+	return nil
+}
+
+// ConvertFrom converts from the hub version (api/v1) to this ClusterExtension, lifting v1's
+// separate spec.serviceAccount field into the install identity union.
+func (dst *ClusterExtension) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*ocv1.ClusterExtension)
+	if !ok {
+		return fmt.Errorf("expected *v1.ClusterExtension but got %T", srcRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.Namespace = src.Spec.Namespace
+
+	if err := convertIdentical(&src.Spec.Source, &dst.Spec.Source); err != nil {
+		return fmt.Errorf("converting spec.source: %w", err)
+	}
+
+	if src.Spec.Install != nil {
+		if err := convertIdentical(src.Spec.Install, &dst.Spec.Install); err != nil {
+			return fmt.Errorf("converting spec.install: %w", err)
+		}
+	}
+	dst.Spec.Install.ServiceAccount.Name = src.Spec.ServiceAccount.Name
+
+	if src.Spec.Config != nil {
+		dst.Spec.Config = &ClusterExtensionConfig{}
+		if err := convertIdentical(src.Spec.Config, dst.Spec.Config); err != nil {
+			return fmt.Errorf("converting spec.config: %w", err)
+		}
+	}
+
+	dst.Spec.ProgressDeadlineMinutes = src.Spec.ProgressDeadlineMinutes
+	dst.Spec.RollbackTo = src.Spec.RollbackTo
+	dst.Spec.FreezeVersion = src.Spec.FreezeVersion
+	dst.Spec.AutoRevertAfterFailures = src.Spec.AutoRevertAfterFailures
+	dst.Spec.DependsOn = src.Spec.DependsOn
+
+	if err := convertIdentical(&src.Status, &dst.Status); err != nil {
+		return fmt.Errorf("converting status: %w", err)
+	}
+	return nil
+}
+
+// convertIdentical copies src into dst by round-tripping through JSON. It's used for the parts of
+// the ClusterExtension schema that are identical (field-for-field, tag-for-tag) between v1 and
+// v1alpha2, so that a real shape change in one version's struct definition doesn't silently stop
+// being carried over by a conversion function nobody remembered to update.
+func convertIdentical(src, dst any) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return fmt.Errorf("marshaling %T: %w", src, err)
+	}
+	if err := json.Unmarshal(data, dst); err != nil {
+		return fmt.Errorf("unmarshaling into %T: %w", dst, err)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/randfill"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	ocv1alpha2 "github.com/operator-framework/operator-controller/api/v1alpha2"
+)
+
+// fillClusterExtension returns a randomly-populated v1alpha2 ClusterExtension suitable for round-trip
+// conversion testing:
+//   - its install identity union always satisfies the "exactly one of [serviceAccount.name,
+//     impersonate]" XValidation rule, so fuzzed values represent objects the API server would
+//     actually accept;
+//   - its metav1.Time fields are truncated to whole seconds, since that's the precision metav1.Time
+//     itself preserves across a JSON round trip;
+//   - its slices always have at least one element, so none of them hit the empty-slice-vs-nil
+//     ambiguity that "omitempty" JSON tags introduce (conversion is expected to preserve content,
+//     not the nil-ness of an empty collection);
+//   - its TypeMeta is left zeroed, since conversion functions don't set it: the API server fills in
+//     apiVersion/kind for the response after conversion runs, the same as it does for any other type.
+func fillClusterExtension(seed int64) *ocv1alpha2.ClusterExtension {
+	filler := randfill.NewWithSeed(seed).NilChance(0.5).NumElements(1, 3).Funcs(
+		func(t *metav1.Time, c randfill.Continue) {
+			var unix int64
+			c.Fill(&unix)
+			*t = metav1.NewTime(metav1.Unix(unix%1e9, 0).Time)
+		},
+		func(j *apiextensionsv1.JSON, c randfill.Continue) {
+			var s string
+			c.Fill(&s)
+			raw, err := json.Marshal(s)
+			if err != nil {
+				raw = []byte(`null`)
+			}
+			j.Raw = raw
+		},
+	)
+
+	ext := &ocv1alpha2.ClusterExtension{}
+	filler.Fill(ext)
+
+	ext.TypeMeta = metav1.TypeMeta{}
+
+	// Exactly one of serviceAccount.name or impersonate must be set. Randomize which union member
+	// this iteration populates, so the fuzz suite actually exercises both identity shapes instead
+	// of only ever round-tripping a ServiceAccount.
+	var useServiceAccount bool
+	filler.Fill(&useServiceAccount)
+	if useServiceAccount {
+		ext.Spec.Install.ServiceAccount.Name = "fuzzed-service-account"
+		ext.Spec.Install.Impersonate = nil
+	} else {
+		ext.Spec.Install.ServiceAccount.Name = ""
+		if ext.Spec.Install.Impersonate == nil {
+			ext.Spec.Install.Impersonate = &ocv1alpha2.ImpersonationConfig{}
+		}
+		filler.Fill(ext.Spec.Install.Impersonate)
+		ext.Spec.Install.Impersonate.Username = "fuzzed-user"
+	}
+
+	return ext
+}
+
+// FuzzClusterExtensionConversionRoundTrip asserts that converting a v1alpha2 ClusterExtension to the
+// hub version (v1) and back always reproduces the original object. This is the guarantee the API
+// server relies on when it serves the same stored object to clients requesting either version.
+func FuzzClusterExtensionConversionRoundTrip(f *testing.F) {
+	f.Add(int64(0))
+	f.Add(int64(1))
+	f.Add(int64(42))
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		original := fillClusterExtension(seed)
+
+		hub := &ocv1.ClusterExtension{}
+		require.NoError(t, original.ConvertTo(hub))
+
+		roundTripped := &ocv1alpha2.ClusterExtension{}
+		require.NoError(t, roundTripped.ConvertFrom(hub))
+
+		require.Equal(t, original, roundTripped, "v1alpha2 -> v1 -> v1alpha2 must round-trip losslessly")
+	})
+}
+
+// fillHubClusterExtension returns a randomly-populated v1 (hub) ClusterExtension suitable for
+// round-trip conversion testing, mirroring fillClusterExtension's invariants but for the hub's own
+// shape: the install identity union lives at spec.serviceAccount / spec.install.impersonate rather
+// than nested entirely under spec.install.
+func fillHubClusterExtension(seed int64) *ocv1.ClusterExtension {
+	filler := randfill.NewWithSeed(seed).NilChance(0.5).NumElements(1, 3).Funcs(
+		func(t *metav1.Time, c randfill.Continue) {
+			var unix int64
+			c.Fill(&unix)
+			*t = metav1.NewTime(metav1.Unix(unix%1e9, 0).Time)
+		},
+		func(j *apiextensionsv1.JSON, c randfill.Continue) {
+			var s string
+			c.Fill(&s)
+			raw, err := json.Marshal(s)
+			if err != nil {
+				raw = []byte(`null`)
+			}
+			j.Raw = raw
+		},
+	)
+
+	ext := &ocv1.ClusterExtension{}
+	filler.Fill(ext)
+
+	ext.TypeMeta = metav1.TypeMeta{}
+
+	if ext.Spec.Install == nil {
+		ext.Spec.Install = &ocv1.ClusterExtensionInstallConfig{}
+	}
+
+	// Exactly one of serviceAccount.name or install.impersonate must be set. Randomize which union
+	// member this iteration populates, so the fuzz suite exercises both identity shapes starting
+	// from the hub's own shape, not just the spoke's.
+	var useServiceAccount bool
+	filler.Fill(&useServiceAccount)
+	if useServiceAccount {
+		ext.Spec.ServiceAccount.Name = "fuzzed-service-account"
+		ext.Spec.Install.Impersonate = nil
+	} else {
+		ext.Spec.ServiceAccount.Name = ""
+		if ext.Spec.Install.Impersonate == nil {
+			ext.Spec.Install.Impersonate = &ocv1.ImpersonationConfig{}
+		}
+		filler.Fill(ext.Spec.Install.Impersonate)
+		ext.Spec.Install.Impersonate.Username = "fuzzed-user"
+	}
+
+	return ext
+}
+
+// FuzzClusterExtensionConversionRoundTripFromHub asserts the reverse direction of
+// FuzzClusterExtensionConversionRoundTrip: converting a v1 (hub, storage version) ClusterExtension to
+// v1alpha2 and back always reproduces the original object. A field that exists only on the hub's
+// ClusterExtensionInstallConfig (as opposed to one renamed or relocated, like serviceAccount) but was
+// never added to v1alpha2's would pass the spoke->hub->spoke direction undetected, since it would
+// never be populated starting from a v1alpha2 object; this direction is what actually exercises it.
+func FuzzClusterExtensionConversionRoundTripFromHub(f *testing.F) {
+	f.Add(int64(0))
+	f.Add(int64(1))
+	f.Add(int64(42))
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		original := fillHubClusterExtension(seed)
+
+		spoke := &ocv1alpha2.ClusterExtension{}
+		require.NoError(t, spoke.ConvertFrom(original))
+
+		roundTripped := &ocv1.ClusterExtension{}
+		require.NoError(t, spoke.ConvertTo(roundTripped))
+
+		require.Equal(t, original, roundTripped, "v1 -> v1alpha2 -> v1 must round-trip losslessly")
+	})
+}
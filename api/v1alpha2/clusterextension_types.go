@@ -0,0 +1,1073 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ClusterExtensionKind = "ClusterExtension"
+
+type (
+	UpgradeConstraintPolicy     string
+	CRDUpgradeSafetyEnforcement string
+	PruneBehavior               string
+	AdoptionPolicy              string
+	NamespaceDeletionPolicy     string
+
+	ClusterExtensionConfigType string
+)
+
+const (
+	// The extension will only upgrade if the new version satisfies
+	// the upgrade constraints set by the package author.
+	UpgradeConstraintPolicyCatalogProvided UpgradeConstraintPolicy = "CatalogProvided"
+
+	// Unsafe option which allows an extension to be
+	// upgraded or downgraded to any available version of the package and
+	// ignore the upgrade path designed by package authors.
+	// This assumes that users independently verify the outcome of the changes.
+	// Use with caution as this can lead to unknown and potentially
+	// disastrous results such as data loss.
+	UpgradeConstraintPolicySelfCertified UpgradeConstraintPolicy = "SelfCertified"
+
+	ClusterExtensionConfigTypeInline ClusterExtensionConfigType = "Inline"
+)
+
+// ClusterExtensionSpec defines the desired state of ClusterExtension
+type ClusterExtensionSpec struct {
+	// namespace specifies a Kubernetes namespace.
+	// This is the namespace where the identity configured in the install field must exist.
+	// It also designates the default namespace where namespace-scoped resources for the extension are applied to the cluster.
+	// Some extensions may contain namespace-scoped resources to be applied in other namespaces.
+	// This namespace must exist.
+	//
+	// The namespace field is required, immutable, and follows the DNS label standard as defined in [RFC 1123].
+	// It must contain only lowercase alphanumeric characters or hyphens (-), start and end with an alphanumeric character,
+	// and be no longer than 63 characters.
+	//
+	// [RFC 1123]: https://tools.ietf.org/html/rfc1123
+	//
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="namespace is immutable: to move to a different namespace, delete and recreate this ClusterExtension"
+	// +kubebuilder:validation:XValidation:rule="self.matches(\"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\")",message="namespace must be a valid DNS1123 label"
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace"`
+
+	// source is required and selects the installation source of content for this ClusterExtension.
+	// Set the sourceType field to perform the selection.
+	//
+	// Catalog is currently the only implemented sourceType.
+	// Setting sourceType to "Catalog" requires the catalog field to also be defined.
+	//
+	// Below is a minimal example of a source definition (in yaml):
+	//
+	// source:
+	//   sourceType: Catalog
+	//   catalog:
+	//     packageName: example-package
+	//
+	// +kubebuilder:validation:Required
+	Source SourceConfig `json:"source"`
+
+	// install is required and configures installation options for the ClusterExtension, including
+	// the identity used to perform all interactions with the cluster that are required to manage
+	// the extension.
+	//
+	// +kubebuilder:validation:Required
+	Install InstallConfig `json:"install"`
+
+	// config is optional and specifies bundle-specific configuration.
+	// Configuration is bundle-specific and a bundle may provide a configuration schema.
+	// When not specified, the default configuration of the resolved bundle is used.
+	//
+	// config is validated against a configuration schema provided by the resolved bundle. If the bundle does not provide
+	// a configuration schema the bundle is deemed to not be configurable. More information on how
+	// to configure bundles can be found in the OLM documentation associated with your current OLM version.
+	//
+	// +optional
+	Config *ClusterExtensionConfig `json:"config,omitempty"`
+
+	// progressDeadlineMinutes is an optional field that defines the maximum period
+	// of time in minutes after which an installation should be considered failed and
+	// require manual intervention. This functionality is disabled when no value
+	// is provided. The minimum period is 10 minutes, and the maximum is 720 minutes (12 hours).
+	//
+	// +kubebuilder:validation:Minimum:=10
+	// +kubebuilder:validation:Maximum:=720
+	// +optional
+	// <opcon:experimental>
+	ProgressDeadlineMinutes int32 `json:"progressDeadlineMinutes,omitempty"`
+
+	// rollbackTo optionally requests that the extension be reverted to a previously
+	// installed bundle version recorded in status.history. It must exactly match the
+	// bundle.version of one of those history entries; if it doesn't, the extension's
+	// Progressing condition reports the mismatch and no change is made.
+	//
+	// Setting rollbackTo re-runs resolution, preflight checks, and the apply step
+	// against that historical bundle version exactly as if it had just been resolved
+	// from the catalog, and the resulting history entry is recorded with outcome
+	// Rollback. It does not permanently pin the extension to that version: once the
+	// rollback completes, unsetting rollbackTo resumes normal catalog-driven
+	// resolution under source.catalog.version.
+	//
+	// +kubebuilder:validation:MaxLength:=64
+	// +optional
+	// <opcon:experimental>
+	RollbackTo string `json:"rollbackTo,omitempty"`
+
+	// freezeVersion optionally stops the extension from moving to a different bundle
+	// version, even if source.catalog.version is a range that would otherwise allow
+	// an automatic upgrade. The currently installed bundle continues to be
+	// reconciled and drift-corrected as normal; only resolution of a new version is
+	// skipped. This is useful for holding a version steady during a change freeze
+	// window without detaching the extension from reconciliation the way pausing it
+	// would.
+	//
+	// It has no effect on rollbackTo: an explicit rollback request is still honored
+	// while freezeVersion is set.
+	//
+	// +optional
+	// <opcon:experimental>
+	FreezeVersion bool `json:"freezeVersion,omitempty"`
+
+	// autoRevertAfterFailures optionally enables automatic reversion to the
+	// last successfully installed bundle version after this many consecutive
+	// reconcile failures trying to move to a different one (status.failureRepeatCount
+	// reaching this value). The extension stays reverted, re-applying and
+	// drift-correcting the last-known-good version, until the spec changes again;
+	// it does not automatically retry the version that failed. The RolledBack
+	// condition reports whether this has happened.
+	//
+	// When unset, persistent upgrade failures are left for the admin to resolve,
+	// which may mean the extension stays in a failed state indefinitely.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	// <opcon:experimental>
+	AutoRevertAfterFailures *int32 `json:"autoRevertAfterFailures,omitempty"`
+
+	// dependsOn optionally lists the names of other ClusterExtensions that must be
+	// Installed and Healthy before this ClusterExtension is resolved and installed.
+	// While any named ClusterExtension is missing, not yet Installed, or not yet
+	// Healthy, this ClusterExtension's resolution and installation are deferred and
+	// its WaitingForDependencies condition reports which ones are still pending.
+	//
+	// This only orders this ClusterExtension's own installation; it does not affect
+	// the reconciliation of the ClusterExtensions it depends on, and it does not
+	// detect or reject dependency cycles - a cycle simply leaves every ClusterExtension
+	// in it waiting forever.
+	//
+	// +kubebuilder:validation:MaxItems:=20
+	// +optional
+	// <opcon:experimental>
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+const SourceTypeCatalog = "Catalog"
+
+// SourceConfig is a discriminated union which selects the installation source.
+//
+// +union
+// +kubebuilder:validation:XValidation:rule="has(self.sourceType) && self.sourceType == 'Catalog' ? has(self.catalog) : !has(self.catalog)",message="catalog is required when sourceType is Catalog, and forbidden otherwise"
+type SourceConfig struct {
+	// sourceType is required and specifies the type of install source.
+	//
+	// The only allowed value is "Catalog".
+	//
+	// When set to "Catalog", information for determining the appropriate bundle of content to install
+	// is fetched from ClusterCatalog resources on the cluster.
+	// When using the Catalog sourceType, the catalog field must also be set.
+	//
+	// +unionDiscriminator
+	// +kubebuilder:validation:Enum:="Catalog"
+	// +kubebuilder:validation:Required
+	SourceType string `json:"sourceType"`
+
+	// catalog configures how information is sourced from a catalog.
+	// It is required when sourceType is "Catalog", and forbidden otherwise.
+	//
+	// +optional
+	Catalog *CatalogFilter `json:"catalog,omitempty"`
+}
+
+// InstallConfig configures how a ClusterExtension is installed, including the identity used to
+// perform all interactions with the cluster that are required to manage it.
+//
+// +kubebuilder:validation:XValidation:rule="(self.serviceAccount.name != \"\") != has(self.impersonate)",message="exactly one of [serviceAccount.name, impersonate] is required"
+type InstallConfig struct {
+	// serviceAccount specifies a ServiceAccount used to perform all interactions with the cluster
+	// that are required to manage the extension.
+	// The ServiceAccount must be configured with the necessary permissions to perform these interactions.
+	// The ServiceAccount must exist in the namespace referenced in the spec.
+	//
+	// Exactly one of serviceAccount or impersonate is required: use serviceAccount for the
+	// standard pre-provisioned-identity model, or impersonate to instead have interactions
+	// performed as an impersonated user and set of groups.
+	//
+	// +optional
+	ServiceAccount ServiceAccountReference `json:"serviceAccount,omitempty"`
+
+	// preflight is optional and configures the checks that run before installation or upgrade
+	// of the content for the package specified in the packageName field.
+	//
+	// When specified, it replaces the default preflight configuration for install/upgrade actions.
+	// When not specified, the default configuration is used.
+	//
+	// +optional
+	Preflight *PreflightConfig `json:"preflight,omitempty"`
+
+	// unpackTimeout is optional and configures the maximum amount of time
+	// that unpacking the bundle content for this ClusterExtension may take
+	// before it is considered failed.
+	//
+	// When unspecified, the controller-wide default unpack timeout is used.
+	//
+	// The value must be a valid Go duration string, e.g. "5m", "1h30m".
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^0|([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +optional
+	UnpackTimeout *metav1.Duration `json:"unpackTimeout,omitempty"`
+
+	// timeout is optional and configures the maximum amount of time to wait
+	// for the resources applied for this ClusterExtension to become healthy
+	// during an install or upgrade before it is considered failed.
+	//
+	// When unspecified, the applier waits indefinitely.
+	//
+	// The value must be a valid Go duration string, e.g. "5m", "1h30m".
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^0|([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// pruneBehavior is optional and configures how objects that were part of
+	// a previously installed bundle revision but are absent from the
+	// currently resolved bundle are handled during install/upgrade.
+	//
+	// Allowed values are "Delete" and "Orphan". The default value is "Delete".
+	//
+	// When set to "Delete", such objects are deleted from the cluster.
+	//
+	// When set to "Orphan", such objects are left in the cluster, no longer
+	// managed by this ClusterExtension. Use this option with caution, as
+	// orphaned objects will not be cleaned up when the ClusterExtension is
+	// deleted.
+	//
+	// +kubebuilder:validation:Enum:="Delete";"Orphan"
+	// +optional
+	PruneBehavior PruneBehavior `json:"pruneBehavior,omitempty"`
+
+	// adoptionPolicy is optional and configures whether resources already
+	// present on the cluster and owned by an OLMv0 ClusterServiceVersion may
+	// be taken over by this ClusterExtension on install, instead of failing
+	// because the resources already exist.
+	//
+	// Allowed values are "CreateOnly" and "AdoptOLMV0". The default value is
+	// "CreateOnly".
+	//
+	// When set to "CreateOnly", pre-existing resources are left untouched and
+	// installation fails if a resource this ClusterExtension needs to create
+	// already exists.
+	//
+	// When set to "AdoptOLMV0", a pre-existing resource that is owned by an
+	// OLMv0 ClusterServiceVersion has its OLMv0 ownership markers removed so
+	// that this ClusterExtension can take over management of it. Use this
+	// option when migrating a package from OLMv0 to OLMv1 without deleting
+	// and reinstalling it. It has no effect on resources not owned by an
+	// OLMv0 ClusterServiceVersion.
+	//
+	// +kubebuilder:validation:Enum:="CreateOnly";"AdoptOLMV0"
+	// +optional
+	AdoptionPolicy AdoptionPolicy `json:"adoptionPolicy,omitempty"`
+
+	// allowMultiplePerPackage is optional and, when the PackageSingletonPreflight feature gate
+	// is enabled, opts this ClusterExtension out of the check that otherwise fails install when
+	// another ClusterExtension already installs the same catalog package.
+	//
+	// Only set this once each ClusterExtension installing the package scopes itself to a
+	// distinct watch namespace via spec.config, so they don't end up managing the same
+	// resources; this field does not enforce that on its own.
+	//
+	// +optional
+	AllowMultiplePerPackage bool `json:"allowMultiplePerPackage,omitempty"`
+
+	// exclude is optional and specifies a list of objects that must be
+	// removed from the bundle's rendered manifests before they are applied.
+	//
+	// Use this to drop a bundled object that conflicts with cluster policy,
+	// such as a PodDisruptionBudget or NetworkPolicy the bundle ships that
+	// this cluster manages separately.
+	//
+	// +kubebuilder:validation:MaxItems=100
+	// +listType=atomic
+	// +optional
+	Exclude []ResourceExclusion `json:"exclude,omitempty"`
+
+	// createNamespace is optional and configures the controller to create the
+	// namespace referenced by spec.namespace when it does not already exist,
+	// instead of failing install.
+	//
+	// When unset, the namespace must already exist.
+	//
+	// +optional
+	CreateNamespace *CreateNamespaceConfig `json:"createNamespace,omitempty"`
+
+	// impersonate is optional and configures the controller to perform all
+	// interactions with the cluster that are required to manage the
+	// extension as an impersonated user and set of groups, instead of using
+	// a ServiceAccount.
+	//
+	// Use this, for example, when the cluster's RBAC is driven by an external
+	// identity provider (OIDC) and impersonation integrates better with that
+	// setup than provisioning a dedicated ServiceAccount.
+	//
+	// Exactly one of serviceAccount or impersonate is required. See the
+	// InstallConfig documentation.
+	//
+	// +optional
+	Impersonate *ImpersonationConfig `json:"impersonate,omitempty"`
+
+	// networkPolicy is optional and configures the controller to generate a
+	// default-deny NetworkPolicy for the bundle's workloads, along with
+	// allow rules for the traffic those workloads need: webhook callbacks
+	// from the API server, and metrics scraping.
+	//
+	// Use this on clusters that require every namespace to be
+	// network-restricted.
+	//
+	// When unset, no NetworkPolicies are generated for the bundle's
+	// workloads.
+	//
+	// +optional
+	NetworkPolicy *NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+
+	// forceDeletion is optional and, when true, allows this ClusterExtension
+	// to be deleted even while custom resources of a CRD it owns still exist
+	// on the cluster.
+	//
+	// When unset or false, deletion is blocked while such custom resources
+	// exist. This is because deleting a ClusterExtension that owns a CRD
+	// deletes the CRD along with it, which in turn cascades to delete every
+	// custom resource of that CRD across every namespace on the cluster.
+	//
+	// +optional
+	ForceDeletion *bool `json:"forceDeletion,omitempty"`
+}
+
+// CreateNamespaceConfig configures the namespace created by the controller
+// on behalf of a ClusterExtension when spec.install.createNamespace is set.
+type CreateNamespaceConfig struct {
+	// labels are optional and specify the labels applied to the created
+	// namespace.
+	//
+	// Use this, for example, to apply a Pod Security Standard level, such as
+	// "pod-security.kubernetes.io/enforce": "restricted".
+	//
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// deletionPolicy is optional and configures whether the namespace created
+	// by the controller is deleted when this ClusterExtension is deleted.
+	//
+	// Allowed values are "Delete" and "Keep". The default value is "Keep".
+	//
+	// When set to "Delete", the namespace is deleted along with the
+	// ClusterExtension. Use this option with caution, as any other content
+	// left in the namespace is deleted along with it.
+	//
+	// When set to "Keep", the namespace is left in the cluster after the
+	// ClusterExtension is deleted.
+	//
+	// deletionPolicy has no effect if the namespace already existed before
+	// this ClusterExtension created it.
+	//
+	// +kubebuilder:validation:Enum:="Delete";"Keep"
+	// +optional
+	DeletionPolicy NamespaceDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// NetworkPolicyConfig configures generation of NetworkPolicies for a
+// ClusterExtension's installed workloads.
+type NetworkPolicyConfig struct {
+	// enabled is optional and, when true, generates a default-deny
+	// NetworkPolicy for the bundle's workloads in the install namespace,
+	// along with allow rules for the traffic those workloads need: webhook
+	// callbacks from the API server, and metrics scraping.
+	//
+	// When unset or false, no NetworkPolicies are generated.
+	//
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// ResourceExclusion identifies an object, by group/kind and optionally name,
+// that should be dropped from a bundle's rendered manifests before apply.
+//
+// When name is empty, every object of the given group/kind is excluded.
+type ResourceExclusion struct {
+	// group is the API group of the object to exclude. The empty string
+	// selects the core API group.
+	//
+	// +kubebuilder:validation:MaxLength:=253
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// kind is required and is the kind of the object to exclude, e.g.
+	// "PodDisruptionBudget" or "NetworkPolicy".
+	//
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Required
+	Kind string `json:"kind"`
+
+	// name optionally restricts exclusion to the object with this name.
+	//
+	// When unset, every object of the given group/kind is excluded.
+	//
+	// +kubebuilder:validation:MaxLength:=253
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// ClusterExtensionConfig is a discriminated union which selects the source configuration values to be merged into
+// the ClusterExtension's rendered manifests.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.configType) && self.configType == 'Inline' ?has(self.inline) : !has(self.inline)",message="inline is required when configType is Inline, and forbidden otherwise"
+// +union
+type ClusterExtensionConfig struct {
+	// configType is required and specifies the type of configuration source.
+	//
+	// The only allowed value is "Inline".
+	//
+	// When set to "Inline", the cluster extension configuration is defined inline within the ClusterExtension resource.
+	//
+	// +unionDiscriminator
+	// +kubebuilder:validation:Enum:="Inline"
+	// +kubebuilder:validation:Required
+	ConfigType ClusterExtensionConfigType `json:"configType"`
+
+	// inline contains JSON or YAML values specified directly in the ClusterExtension.
+	//
+	// It is used to specify arbitrary configuration values for the ClusterExtension.
+	// It must be set if configType is 'Inline' and must be a valid JSON/YAML object containing at least one property.
+	// The configuration values are validated at runtime against a JSON schema provided by the bundle.
+	//
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:validation:MinProperties=1
+	// +optional
+	// +unionMember
+	Inline *apiextensionsv1.JSON `json:"inline,omitempty"`
+}
+
+// CatalogFilter defines the attributes used to identify and filter content from a catalog.
+type CatalogFilter struct {
+	// packageName specifies the name of the package to be installed and is used to filter
+	// the content from catalogs.
+	//
+	// It is required, immutable, and follows the DNS subdomain standard as defined in [RFC 1123].
+	// It must contain only lowercase alphanumeric characters, hyphens (-) or periods (.),
+	// start and end with an alphanumeric character, and be no longer than 253 characters.
+	//
+	// Some examples of valid values are:
+	//   - some-package
+	//   - 123-package
+	//   - 1-package-2
+	//   - somepackage
+	//
+	// Some examples of invalid values are:
+	//   - -some-package
+	//   - some-package-
+	//   - thisisareallylongpackagenamethatisgreaterthanthemaximumlength
+	//   - some.package
+	//
+	// [RFC 1123]: https://tools.ietf.org/html/rfc1123
+	//
+	// +kubebuilder:validation.Required
+	// +kubebuilder:validation:MaxLength:=253
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="packageName is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.matches(\"^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$\")",message="packageName must be a valid DNS1123 subdomain. It must contain only lowercase alphanumeric characters, hyphens (-) or periods (.), start and end with an alphanumeric character, and be no longer than 253 characters"
+	// +kubebuilder:validation:Required
+	PackageName string `json:"packageName"`
+
+	// version is an optional semver constraint (a specific version or range of versions).
+	// When unspecified, the latest version available is installed.
+	//
+	// Acceptable version ranges are no longer than 64 characters.
+	// Version ranges are composed of comma- or space-delimited values and one or more comparison operators,
+	// known as comparison strings.
+	// You can add additional comparison strings using the OR operator (||).
+	//
+	// # Range Comparisons
+	//
+	// To specify a version range, you can use a comparison string like ">=3.0,
+	// <3.6". When specifying a range, automatic updates will occur within that
+	// range. The example comparison string means "install any version greater than
+	// or equal to 3.0.0 but less than 3.6.0.". It also states intent that if any
+	// upgrades are available within the version range after initial installation,
+	// those upgrades should be automatically performed.
+	//
+	// # Pinned Versions
+	//
+	// To specify an exact version to install you can use a version range that
+	// "pins" to a specific version. When pinning to a specific version, no
+	// automatic updates will occur. An example of a pinned version range is
+	// "0.6.0", which means "only install version 0.6.0 and never
+	// upgrade from this version".
+	//
+	// # Basic Comparison Operators
+	//
+	// The basic comparison operators and their meanings are:
+	//   - "=", equal (not aliased to an operator)
+	//   - "!=", not equal
+	//   - "<", less than
+	//   - ">", greater than
+	//   - ">=", greater than OR equal to
+	//   - "<=", less than OR equal to
+	//
+	// # Wildcard Comparisons
+	//
+	// You can use the "x", "X", and "*" characters as wildcard characters in all
+	// comparison operations. Some examples of using the wildcard characters:
+	//   - "1.2.x", "1.2.X", and "1.2.*" is equivalent to ">=1.2.0, < 1.3.0"
+	//   - ">= 1.2.x", ">= 1.2.X", and ">= 1.2.*" is equivalent to ">= 1.2.0"
+	//   - "<= 2.x", "<= 2.X", and "<= 2.*" is equivalent to "< 3"
+	//   - "x", "X", and "*" is equivalent to ">= 0.0.0"
+	//
+	// # Patch Release Comparisons
+	//
+	// When you want to specify a minor version up to the next major version you
+	// can use the "~" character to perform patch comparisons. Some examples:
+	//   - "~1.2.3" is equivalent to ">=1.2.3, <1.3.0"
+	//   - "~1" and "~1.x" is equivalent to ">=1, <2"
+	//   - "~2.3" is equivalent to ">=2.3, <2.4"
+	//   - "~1.2.x" is equivalent to ">=1.2.0, <1.3.0"
+	//
+	// # Major Release Comparisons
+	//
+	// You can use the "^" character to make major release comparisons after a
+	// stable 1.0.0 version is published. If there is no stable version published, // minor versions define the stability level. Some examples:
+	//   - "^1.2.3" is equivalent to ">=1.2.3, <2.0.0"
+	//   - "^1.2.x" is equivalent to ">=1.2.0, <2.0.0"
+	//   - "^2.3" is equivalent to ">=2.3, <3"
+	//   - "^2.x" is equivalent to ">=2.0.0, <3"
+	//   - "^0.2.3" is equivalent to ">=0.2.3, <0.3.0"
+	//   - "^0.2" is equivalent to ">=0.2.0, <0.3.0"
+	//   - "^0.0.3" is equvalent to ">=0.0.3, <0.0.4"
+	//   - "^0.0" is equivalent to ">=0.0.0, <0.1.0"
+	//   - "^0" is equivalent to ">=0.0.0, <1.0.0"
+	//
+	// # OR Comparisons
+	// You can use the "||" character to represent an OR operation in the version
+	// range. Some examples:
+	//   - ">=1.2.3, <2.0.0 || >3.0.0"
+	//   - "^0 || ^3 || ^5"
+	//
+	// For more information on semver, please see https://semver.org/
+	//
+	// +kubebuilder:validation:MaxLength:=64
+	// +kubebuilder:validation:XValidation:rule="self.matches(\"^(\\\\s*(=||!=|>|<|>=|=>|<=|=<|~|~>|\\\\^)\\\\s*(v?(0|[1-9]\\\\d*|[x|X|\\\\*])(\\\\.(0|[1-9]\\\\d*|x|X|\\\\*]))?(\\\\.(0|[1-9]\\\\d*|x|X|\\\\*))?(-([0-9A-Za-z\\\\-]+(\\\\.[0-9A-Za-z\\\\-]+)*))?(\\\\+([0-9A-Za-z\\\\-]+(\\\\.[0-9A-Za-z\\\\-]+)*))?)\\\\s*)((?:\\\\s+|,\\\\s*|\\\\s*\\\\|\\\\|\\\\s*)(=||!=|>|<|>=|=>|<=|=<|~|~>|\\\\^)\\\\s*(v?(0|[1-9]\\\\d*|x|X|\\\\*])(\\\\.(0|[1-9]\\\\d*|x|X|\\\\*))?(\\\\.(0|[1-9]\\\\d*|x|X|\\\\*]))?(-([0-9A-Za-z\\\\-]+(\\\\.[0-9A-Za-z\\\\-]+)*))?(\\\\+([0-9A-Za-z\\\\-]+(\\\\.[0-9A-Za-z\\\\-]+)*))?)\\\\s*)*$\")",message="invalid version expression"
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// channels is optional and specifies a set of channels belonging to the package
+	// specified in the packageName field.
+	//
+	// A channel is a package-author-defined stream of updates for an extension.
+	//
+	// Each channel in the list must follow the DNS subdomain standard as defined in [RFC 1123].
+	// It must contain only lowercase alphanumeric characters, hyphens (-) or periods (.),
+	// start and end with an alphanumeric character, and be no longer than 253 characters.
+	// You can specify no more than 256 channels.
+	//
+	// When specified, it constrains the set of installable bundles and the automated upgrade path.
+	// This constraint is an AND operation with the version field. For example:
+	//   - Given channel is set to "foo"
+	//   - Given version is set to ">=1.0.0, <1.5.0"
+	//   - Only bundles that exist in channel "foo" AND satisfy the version range comparison are considered installable
+	//   - Automatic upgrades are constrained to upgrade edges defined by the selected channel
+	//
+	// When unspecified, upgrade edges across all channels are used to identify valid automatic upgrade paths.
+	//
+	// Some examples of valid values are:
+	//   - 1.1.x
+	//   - alpha
+	//   - stable
+	//   - stable-v1
+	//   - v1-stable
+	//   - dev-preview
+	//   - preview
+	//   - community
+	//
+	// Some examples of invalid values are:
+	//   - -some-channel
+	//   - some-channel-
+	//   - thisisareallylongchannelnamethatisgreaterthanthemaximumlength
+	//   - original_40
+	//   - --default-channel
+	//
+	// [RFC 1123]: https://tools.ietf.org/html/rfc1123
+	//
+	// +kubebuilder:validation:items:MaxLength:=253
+	// +kubebuilder:validation:MaxItems:=256
+	// +kubebuilder:validation:items:XValidation:rule="self.matches(\"^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$\")",message="channels entries must be valid DNS1123 subdomains"
+	// +optional
+	Channels []string `json:"channels,omitempty"`
+
+	// selector is optional and filters the set of ClusterCatalogs used in the bundle selection process.
+	//
+	// When unspecified, all ClusterCatalogs are used in the bundle selection process.
+	//
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// upgradeConstraintPolicy is optional and controls whether the upgrade paths defined in the catalog
+	// are enforced for the package referenced in the packageName field.
+	//
+	// Allowed values are "CatalogProvided", "SelfCertified", or omitted.
+	//
+	// When set to "CatalogProvided", automatic upgrades only occur when upgrade constraints specified by the package
+	// author are met.
+	//
+	// When set to "SelfCertified", the upgrade constraints specified by the package author are ignored.
+	// This allows upgrades and downgrades to any version of the package.
+	// This is considered a dangerous operation as it can lead to unknown and potentially disastrous outcomes,
+	// such as data loss.
+	// Use this option only if you have independently verified the changes.
+	//
+	// When omitted, the default value is "CatalogProvided".
+	//
+	// +kubebuilder:validation:Enum:=CatalogProvided;SelfCertified
+	// +kubebuilder:default:=CatalogProvided
+	// +optional
+	UpgradeConstraintPolicy UpgradeConstraintPolicy `json:"upgradeConstraintPolicy,omitempty"`
+}
+
+// ServiceAccountReference identifies the serviceAccount used fo install a ClusterExtension.
+type ServiceAccountReference struct {
+	// name is an immutable reference to the name of the ServiceAccount used for installation
+	// and management of the content for the package specified in the packageName field.
+	//
+	// This ServiceAccount must exist in the installNamespace.
+	//
+	// The name field is required when serviceAccount is used, and is forbidden when
+	// impersonate is used instead. See the InstallConfig documentation.
+	//
+	// The name field follows the DNS subdomain standard as defined in [RFC 1123].
+	// It must contain only lowercase alphanumeric characters, hyphens (-) or periods (.),
+	// start and end with an alphanumeric character, and be no longer than 253 characters.
+	//
+	// Some examples of valid values are:
+	//   - some-serviceaccount
+	//   - 123-serviceaccount
+	//   - 1-serviceaccount-2
+	//   - someserviceaccount
+	//   - some.serviceaccount
+	//
+	// Some examples of invalid values are:
+	//   - -some-serviceaccount
+	//   - some-serviceaccount-
+	//
+	// [RFC 1123]: https://tools.ietf.org/html/rfc1123
+	//
+	// +kubebuilder:validation:MaxLength:=253
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable: to use a different ServiceAccount, delete and recreate this ClusterExtension"
+	// +kubebuilder:validation:XValidation:rule="self == \"\" || self.matches(\"^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$\")",message="name must be a valid DNS1123 subdomain. It must contain only lowercase alphanumeric characters, hyphens (-) or periods (.), start and end with an alphanumeric character, and be no longer than 253 characters"
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// ImpersonationConfig identifies the user and groups impersonated to install a ClusterExtension.
+type ImpersonationConfig struct {
+	// username is required and specifies the name of the user to impersonate
+	// for installation and management of the content for the package
+	// specified in the packageName field.
+	//
+	// +kubebuilder:validation:MaxLength:=253
+	// +kubebuilder:validation:Required
+	Username string `json:"username"`
+
+	// groups is optional and specifies the groups to impersonate, in
+	// addition to username, for installation and management of the content
+	// for the package specified in the packageName field.
+	//
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=atomic
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// PreflightConfig holds the configuration for the preflight checks.  If used, at least one preflight check must be non-nil.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.crdUpgradeSafety)",message="at least one of [crdUpgradeSafety] are required when preflight is specified"
+type PreflightConfig struct {
+	// crdUpgradeSafety configures the CRD Upgrade Safety pre-flight checks that run
+	// before upgrades of installed content.
+	//
+	// The CRD Upgrade Safety pre-flight check safeguards from unintended consequences of upgrading a CRD,
+	// such as data loss.
+	CRDUpgradeSafety *CRDUpgradeSafetyPreflightConfig `json:"crdUpgradeSafety"`
+}
+
+// CRDUpgradeSafetyPreflightConfig is the configuration for CRD upgrade safety preflight check.
+type CRDUpgradeSafetyPreflightConfig struct {
+	// enforcement is required and configures the state of the CRD Upgrade Safety pre-flight check.
+	//
+	// Allowed values are "None" or "Strict". The default value is "Strict".
+	//
+	// When set to "None", the CRD Upgrade Safety pre-flight check is skipped during an upgrade operation.
+	// Use this option with caution as unintended consequences such as data loss can occur.
+	//
+	// When set to "Strict", the CRD Upgrade Safety pre-flight check runs during an upgrade operation.
+	//
+	// +kubebuilder:validation:Enum:="None";"Strict"
+	// +kubebuilder:validation:Required
+	Enforcement CRDUpgradeSafetyEnforcement `json:"enforcement"`
+}
+
+const (
+	// TypeDeprecated is a rollup condition that is present when
+	// any of the deprecated conditions are present.
+	TypeDeprecated        = "Deprecated"
+	TypePackageDeprecated = "PackageDeprecated"
+	TypeChannelDeprecated = "ChannelDeprecated"
+	TypeBundleDeprecated  = "BundleDeprecated"
+
+	// None will not perform CRD upgrade safety checks.
+	CRDUpgradeSafetyEnforcementNone CRDUpgradeSafetyEnforcement = "None"
+	// Strict will enforce the CRD upgrade safety check and block the upgrade if the CRD would not pass the check.
+	CRDUpgradeSafetyEnforcementStrict CRDUpgradeSafetyEnforcement = "Strict"
+
+	// Delete will delete objects that are no longer part of the resolved bundle.
+	PruneBehaviorDelete PruneBehavior = "Delete"
+	// Orphan will leave objects that are no longer part of the resolved bundle in the cluster, unmanaged.
+	PruneBehaviorOrphan PruneBehavior = "Orphan"
+
+	// CreateOnly will leave pre-existing resources untouched, failing installation if one already exists.
+	AdoptionPolicyCreateOnly AdoptionPolicy = "CreateOnly"
+	// AdoptOLMV0 will take over management of pre-existing resources owned by an OLMv0 ClusterServiceVersion.
+	AdoptionPolicyAdoptOLMV0 AdoptionPolicy = "AdoptOLMV0"
+
+	// Delete will delete the namespace created for this ClusterExtension when the ClusterExtension is deleted.
+	NamespaceDeletionPolicyDelete NamespaceDeletionPolicy = "Delete"
+	// Keep will leave the namespace created for this ClusterExtension in the cluster when the ClusterExtension is deleted.
+	NamespaceDeletionPolicyKeep NamespaceDeletionPolicy = "Keep"
+)
+
+// BundleMetadata is a representation of the identifying attributes of a bundle.
+type BundleMetadata struct {
+	// name is required and follows the DNS subdomain standard as defined in [RFC 1123].
+	// It must contain only lowercase alphanumeric characters, hyphens (-) or periods (.),
+	// start and end with an alphanumeric character, and be no longer than 253 characters.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self.matches(\"^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$\")",message="packageName must be a valid DNS1123 subdomain. It must contain only lowercase alphanumeric characters, hyphens (-) or periods (.), start and end with an alphanumeric character, and be no longer than 253 characters"
+	Name string `json:"name"`
+
+	// version is required and references the version that this bundle represents.
+	// It follows the semantic versioning standard as defined in https://semver.org/.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self.matches(\"^([0-9]+)(\\\\.[0-9]+)?(\\\\.[0-9]+)?(-([-0-9A-Za-z]+(\\\\.[-0-9A-Za-z]+)*))?(\\\\+([-0-9A-Za-z]+(-\\\\.[-0-9A-Za-z]+)*))?\")",message="version must be well-formed semver"
+	Version string `json:"version"`
+}
+
+// UpgradeEdgeReason describes why the catalog treats a bundle as reachable from the
+// installed bundle via an upgrade edge.
+type UpgradeEdgeReason string
+
+const (
+	// UpgradeEdgeReasonReplaces is used when the candidate bundle's channel entry names the
+	// installed bundle in its replaces field.
+	UpgradeEdgeReasonReplaces UpgradeEdgeReason = "Replaces"
+	// UpgradeEdgeReasonSkips is used when the candidate bundle's channel entry names the
+	// installed bundle in its skips list.
+	UpgradeEdgeReasonSkips UpgradeEdgeReason = "Skips"
+	// UpgradeEdgeReasonSkipRange is used when the installed bundle's version falls within the
+	// candidate bundle's channel entry skipRange.
+	UpgradeEdgeReasonSkipRange UpgradeEdgeReason = "SkipRange"
+)
+
+// AvailableUpgradeEdge describes a single catalog-provided upgrade edge from the installed
+// bundle to a candidate bundle.
+type AvailableUpgradeEdge struct {
+	// bundle is required and identifies the candidate bundle this edge leads to.
+	//
+	// +kubebuilder:validation:Required
+	Bundle BundleMetadata `json:"bundle"`
+
+	// reason is required and explains why the catalog connects the installed bundle to this
+	// candidate: Replaces, Skips, or SkipRange.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum:=Replaces;Skips;SkipRange
+	Reason UpgradeEdgeReason `json:"reason"`
+}
+
+// RevisionStatus defines the observed state of a ClusterExtensionRevision.
+type RevisionStatus struct {
+	// name of the ClusterExtensionRevision resource
+	Name string `json:"name"`
+	// conditions optionally expose Progressing and Available condition of the revision,
+	// in case when it is not yet marked as successfully installed (condition Succeeded is not set to True).
+	// Given that a ClusterExtension should remain available during upgrades, an observer may use these conditions
+	// to get more insights about reasons for its current state.
+	//
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ClusterExtensionStatus defines the observed state of a ClusterExtension.
+type ClusterExtensionStatus struct {
+	// conditions represents the current state of the ClusterExtension.
+	//
+	// The set of condition types which apply to all spec.source variations are Installed and Progressing.
+	//
+	// The Installed condition represents whether the bundle has been installed for this ClusterExtension:
+	//   - When Installed is True and the Reason is Succeeded, the bundle has been successfully installed.
+	//   - When Installed is False and the Reason is Failed, the bundle has failed to install.
+	//
+	// The Progressing condition represents whether or not the ClusterExtension is advancing towards a new state.
+	// When Progressing is True and the Reason is Succeeded, the ClusterExtension is making progress towards a new state.
+	// When Progressing is True and the Reason is Retrying, the ClusterExtension has encountered an error that could be resolved on subsequent reconciliation attempts.
+	// When Progressing is False and the Reason is Blocked, the ClusterExtension has encountered an error that requires manual intervention for recovery.
+	// <opcon:experimental:description>
+	// When Progressing is True and Reason is RollingOut, the ClusterExtension has one or more ClusterExtensionRevisions in active roll out.
+	// </opcon:experimental:description>
+	//
+	// When the ClusterExtension is sourced from a catalog, it surfaces deprecation conditions based on catalog metadata.
+	// These are indications from a package owner to guide users away from a particular package, channel, or bundle:
+	//   - BundleDeprecated is True if the installed bundle is marked deprecated, False if not deprecated, or Unknown if no bundle is installed yet or if catalog data is unavailable.
+	//   - ChannelDeprecated is True if any requested channel is marked deprecated, False if not deprecated, or Unknown if catalog data is unavailable.
+	//   - PackageDeprecated is True if the requested package is marked deprecated, False if not deprecated, or Unknown if catalog data is unavailable.
+	//   - Deprecated is a rollup condition that is True when any deprecation exists, False when none exist, or Unknown when catalog data is unavailable.
+	//
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// install is a representation of the current installation status for this ClusterExtension.
+	//
+	// +optional
+	Install *ClusterExtensionInstallStatus `json:"install,omitempty"`
+
+	// activeRevisions holds a list of currently active (non-archived) ClusterExtensionRevisions,
+	// including both installed and rolling out revisions.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	// <opcon:experimental>
+	ActiveRevisions []RevisionStatus `json:"activeRevisions,omitempty"`
+
+	// unhealthyObjects lists a bounded set of applied resources that are not
+	// currently healthy, formatted as "<kind> <namespace>/<name>: <reason>".
+	// It is only populated while the Healthy condition is False, and is
+	// truncated if there are more unhealthy resources than can be listed.
+	//
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=atomic
+	// +optional
+	UnhealthyObjects []string `json:"unhealthyObjects,omitempty"`
+
+	// applyErrors lists a bounded set of per-object errors encountered while
+	// applying the bundle's contents, formatted as "<kind> <namespace>/<name>:
+	// <error>", so that a partial apply failure (e.g. a single forbidden RBAC
+	// object) can be diagnosed without parsing the flattened Progressing
+	// message. It is only populated when the apply step fails, and is
+	// truncated if there are more errors than can be listed.
+	//
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=atomic
+	// +optional
+	ApplyErrors []string `json:"applyErrors,omitempty"`
+
+	// missingPermissions lists a bounded set of RBAC rules the configured
+	// ServiceAccount is missing to manage the bundle's contents, rendered as
+	// ready-to-apply Role/ClusterRole YAML documents. It is only populated
+	// when the apply step fails because of missing permissions, and is
+	// truncated if there are more missing rules than can be listed.
+	//
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=atomic
+	// +optional
+	MissingPermissions []string `json:"missingPermissions,omitempty"`
+
+	// availableUpgradeEdges lists a bounded set of bundles the installed bundle can move to
+	// according to the catalog's upgrade graph (replaces, skips, and skipRange entries),
+	// regardless of whether UpgradeConstraintPolicy or a ClusterExtensionUpgradeEdgeOverride
+	// would actually permit resolving to them. It is only populated when semver+replaces
+	// resolution found the installed bundle in the catalog, and is truncated, favoring the
+	// highest versions, if there are more edges than can be listed.
+	//
+	// +kubebuilder:validation:MaxItems=20
+	// +listType=atomic
+	// +optional
+	AvailableUpgradeEdges []AvailableUpgradeEdge `json:"availableUpgradeEdges,omitempty"`
+
+	// lastResolvedTime is the last time bundle resolution succeeded for this ClusterExtension.
+	// +optional
+	LastResolvedTime *metav1.Time `json:"lastResolvedTime,omitempty"`
+
+	// lastUnpackedTime is the last time the resolved bundle's contents were unpacked.
+	// +optional
+	LastUnpackedTime *metav1.Time `json:"lastUnpackedTime,omitempty"`
+
+	// lastInstallTime is the last time the bundle's contents were successfully applied to the cluster,
+	// whether that was the initial install or a subsequent upgrade.
+	// +optional
+	LastInstallTime *metav1.Time `json:"lastInstallTime,omitempty"`
+
+	// lastAutomaticUpgradeTime is the last time this ClusterExtension was upgraded by channel-head
+	// tracking, i.e. version does not pin an exact version and a newer bundle was applied without
+	// any spec change. It is unset for a ClusterExtension that pins an exact version, since pinning
+	// an exact version never produces an automatic upgrade.
+	// +optional
+	LastAutomaticUpgradeTime *metav1.Time `json:"lastAutomaticUpgradeTime,omitempty"`
+
+	// history records a bounded, most-recent-first log of the bundles that
+	// have been successfully applied to the cluster for this ClusterExtension,
+	// so an admin can see how it got to its current version without an
+	// external audit system. It is truncated if there are more entries than
+	// can be listed.
+	//
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=atomic
+	// +optional
+	History []ClusterExtensionHistoryEntry `json:"history,omitempty"`
+
+	// failureRepeatCount is the number of consecutive reconciles that have failed with the same
+	// error message as the current Progressing condition. It resets to zero whenever the error
+	// changes or reconciliation succeeds, letting an admin tell a fleeting blip apart from a hot
+	// failure loop without having to correlate controller log timestamps.
+	//
+	// +optional
+	FailureRepeatCount int32 `json:"failureRepeatCount,omitempty"`
+
+	// nextRetryTime is an estimate of when the controller will next retry reconciliation after the
+	// failure described by the current Progressing condition. It lets an admin decide whether to
+	// wait for the next automatic retry or intervene immediately, without having to know the
+	// controller's backoff algorithm. It is cleared whenever the error resolves or spec changes,
+	// since either restarts the backoff from its initial delay.
+	//
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
+}
+
+// ClusterExtensionHistoryEntry records a single bundle version that was
+// successfully applied to the cluster for a ClusterExtension.
+type ClusterExtensionHistoryEntry struct {
+	// bundle is required and represents the identifying attributes of the bundle that was applied.
+	//
+	// +kubebuilder:validation:Required
+	Bundle BundleMetadata `json:"bundle"`
+
+	// image is the resolved, content-addressable reference (including digest) of the bundle image that was applied.
+	//
+	// +kubebuilder:validation:Required
+	Image string `json:"image"`
+
+	// outcome describes how this bundle version relates to the one applied immediately before it:
+	// Install for the first bundle ever applied, Upgrade for a move to a newer version, and Rollback for a move to an older version.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum:=Install;Upgrade;Rollback
+	Outcome ClusterExtensionHistoryOutcome `json:"outcome"`
+
+	// installedAt is the time this bundle version was successfully applied.
+	//
+	// +kubebuilder:validation:Required
+	InstalledAt metav1.Time `json:"installedAt"`
+}
+
+// ClusterExtensionHistoryOutcome describes how a ClusterExtensionHistoryEntry's bundle version
+// relates to the one applied immediately before it.
+type ClusterExtensionHistoryOutcome string
+
+const (
+	// ClusterExtensionHistoryOutcomeInstall is used for the first bundle ever applied for a ClusterExtension.
+	ClusterExtensionHistoryOutcomeInstall ClusterExtensionHistoryOutcome = "Install"
+	// ClusterExtensionHistoryOutcomeUpgrade is used when a bundle version replaces an older previously installed version.
+	ClusterExtensionHistoryOutcomeUpgrade ClusterExtensionHistoryOutcome = "Upgrade"
+	// ClusterExtensionHistoryOutcomeRollback is used when a bundle version replaces a newer previously installed version.
+	ClusterExtensionHistoryOutcomeRollback ClusterExtensionHistoryOutcome = "Rollback"
+)
+
+// ClusterExtensionInstallStatus is a representation of the status of the identified bundle.
+type ClusterExtensionInstallStatus struct {
+	// bundle is required and represents the identifying attributes of a bundle.
+	//
+	// A "bundle" is a versioned set of content that represents the resources that need to be applied
+	// to a cluster to install a package.
+	//
+	// +kubebuilder:validation:Required
+	Bundle BundleMetadata `json:"bundle"`
+
+	// installedAt is the time this bundle version was first successfully installed.
+	// +optional
+	InstalledAt *metav1.Time `json:"installedAt,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Installed Bundle",type=string,JSONPath=`.status.install.bundle.name`
+// +kubebuilder:printcolumn:name=Version,type=string,JSONPath=`.status.install.bundle.version`
+// +kubebuilder:printcolumn:name="Installed",type=string,JSONPath=`.status.conditions[?(@.type=='Installed')].status`
+// +kubebuilder:printcolumn:name="Progressing",type=string,JSONPath=`.status.conditions[?(@.type=='Progressing')].status`
+// +kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterExtension is the Schema for the clusterextensions API
+type ClusterExtension struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is the standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is an optional field that defines the desired state of the ClusterExtension.
+	// +optional
+	Spec ClusterExtensionSpec `json:"spec,omitempty"`
+
+	// status is an optional field that defines the observed state of the ClusterExtension.
+	// +optional
+	Status ClusterExtensionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterExtensionList contains a list of ClusterExtension
+type ClusterExtensionList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// items is a required list of ClusterExtension objects.
+	//
+	// +kubebuilder:validation:Required
+	Items []ClusterExtension `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterExtension{}, &ClusterExtensionList{})
+}
@@ -319,6 +319,15 @@ func run(ctx context.Context) error {
 		cfg.systemNamespace = podNamespace()
 	}
 
+	// Report what was left on disk by the previous run before wiping it, so operators sizing the
+	// volume backing cfg.cacheDir can see actual usage instead of only ever observing an empty
+	// cache immediately after every restart.
+	if unpackBytes, err := fsutil.DirectorySize(filepath.Join(cfg.cacheDir, "unpack")); err != nil {
+		setupLog.Error(err, "unable to measure unpack cache usage from previous run")
+	} else {
+		setupLog.Info("unpack cache usage from previous run", "bytesOnDisk", unpackBytes)
+	}
+
 	if err := fsutil.EnsureEmptyDirectory(cfg.cacheDir, 0700); err != nil {
 		setupLog.Error(err, "unable to ensure empty cache directory")
 		return err
@@ -351,7 +360,14 @@ func run(ctx context.Context) error {
 	}
 
 	var localStorage storage.Instance
-	metrics.Registry.MustRegister(catalogdmetrics.RequestDurationMetric)
+	metrics.Registry.MustRegister(
+		catalogdmetrics.RequestDurationMetric,
+		imageutil.CacheHitsTotal,
+		imageutil.CacheMissesTotal,
+		imageutil.CacheGarbageCollectionsTotal,
+		imageutil.CacheEntries,
+		imageutil.CacheBytes,
+	)
 
 	storeDir := filepath.Join(cfg.cacheDir, storageDir)
 	if err := os.MkdirAll(storeDir, 0700); err != nil {
@@ -0,0 +1,117 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	mmsemver "github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+var approveFlags struct {
+	version string
+	wait    time.Duration
+}
+
+// approveCmd moves a ClusterExtension forward to a pending upgrade. OLMv1 has no InstallPlan-like
+// object to approve: the installed bundle's candidate upgrades are reported as structured data in
+// status.availableUpgradeEdges, and "approving" one means pinning spec.source.catalog.version to
+// it. With --version, that candidate is used as given; otherwise the highest-version candidate
+// bundle in status.availableUpgradeEdges is used.
+var approveCmd = &cobra.Command{
+	Use:   "approve <extension-name>",
+	Short: "Move a ClusterExtension forward to a pending upgrade reported in its status",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runApprove(cmd.Context(), args[0])
+	},
+}
+
+func init() {
+	flags := approveCmd.Flags()
+	flags.StringVar(&approveFlags.version, "version", "", "The candidate bundle version to approve. When omitted, the highest-version candidate in status.availableUpgradeEdges is used.")
+	flags.DurationVar(&approveFlags.wait, "wait", 0, "Wait up to this long for the ClusterExtension to report Installed at the approved version. Zero means don't wait.")
+
+	olmCmd.AddCommand(approveCmd)
+}
+
+func runApprove(ctx context.Context, name string) error {
+	cl, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	var ext ocv1.ClusterExtension
+	if err := cl.Get(ctx, client.ObjectKey{Name: name}, &ext); err != nil {
+		return fmt.Errorf("getting clusterextension %q: %w", name, err)
+	}
+	if ext.Spec.Source.Catalog == nil {
+		return fmt.Errorf("clusterextension %q is not sourced from a catalog", name)
+	}
+
+	version := approveFlags.version
+	if version == "" {
+		edge, err := highestAvailableUpgradeEdge(ext.Status.AvailableUpgradeEdges)
+		if err != nil {
+			return fmt.Errorf("clusterextension %q: %w", name, err)
+		}
+		version = edge.Bundle.Version
+	}
+
+	patch := client.MergeFrom(ext.DeepCopy())
+	ext.Spec.Source.Catalog.Version = version
+	if err := cl.Patch(ctx, &ext, patch); err != nil {
+		return fmt.Errorf("patching clusterextension %q: %w", name, err)
+	}
+	fmt.Printf("clusterextension.olm.operatorframework.io/%s approved for version %s\n", name, version)
+
+	if approveFlags.wait <= 0 {
+		return nil
+	}
+	return waitForInstalled(ctx, name, approveFlags.wait)
+}
+
+// highestAvailableUpgradeEdge returns the edge whose candidate bundle has the highest semver
+// version among edges, so that approving with no --version picks the furthest bundle the catalog
+// currently considers reachable from the installed one.
+func highestAvailableUpgradeEdge(edges []ocv1.AvailableUpgradeEdge) (ocv1.AvailableUpgradeEdge, error) {
+	if len(edges) == 0 {
+		return ocv1.AvailableUpgradeEdge{}, fmt.Errorf("no available upgrade edges reported in status")
+	}
+
+	best := edges[0]
+	bestVersion, err := mmsemver.NewVersion(best.Bundle.Version)
+	if err != nil {
+		return ocv1.AvailableUpgradeEdge{}, fmt.Errorf("parsing version %q for candidate %q: %w", best.Bundle.Version, best.Bundle.Name, err)
+	}
+	for _, edge := range edges[1:] {
+		version, err := mmsemver.NewVersion(edge.Bundle.Version)
+		if err != nil {
+			return ocv1.AvailableUpgradeEdge{}, fmt.Errorf("parsing version %q for candidate %q: %w", edge.Bundle.Version, edge.Bundle.Name, err)
+		}
+		if version.GreaterThan(bestVersion) {
+			best, bestVersion = edge, version
+		}
+	}
+	return best, nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"go.podman.io/image/v5/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/scheme"
+	imageutil "github.com/operator-framework/operator-controller/internal/shared/util/image"
+)
+
+// newClient builds a controller-runtime client against the currently configured cluster,
+// using the same scheme the operator-controller and catalogd controllers register their
+// types into.
+func newClient() (client.Client, error) {
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("loading kubeconfig: %w", err)
+	}
+	cl, err := client.New(restConfig, client.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		return nil, fmt.Errorf("building client: %w", err)
+	}
+	return cl, nil
+}
+
+// newWatchClient builds a controller-runtime client that additionally supports Watch, for
+// commands that implement --wait by watching a resource's conditions rather than polling it.
+func newWatchClient() (client.WithWatch, error) {
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("loading kubeconfig: %w", err)
+	}
+	cl, err := client.NewWithWatch(restConfig, client.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		return nil, fmt.Errorf("building watch client: %w", err)
+	}
+	return cl, nil
+}
+
+// newKubeClient builds a typed Kubernetes clientset against the currently configured cluster, for
+// commands that need APIs controller-runtime's client doesn't cover, such as streaming pod logs.
+func newKubeClient() (kubernetes.Interface, error) {
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("loading kubeconfig: %w", err)
+	}
+	cl, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("building kube client: %w", err)
+	}
+	return cl, nil
+}
+
+// newImagePuller builds an image puller for pulling bundle images directly from their registry,
+// using the local container credential/certificate configuration rather than the in-cluster
+// authentication the operator-controller manager uses.
+func newImagePuller(pullCasDir string) *imageutil.ContainersImagePuller {
+	return &imageutil.ContainersImagePuller{
+		SourceCtxFunc: func(context.Context) (*types.SystemContext, error) {
+			return &types.SystemContext{
+				DockerCertPath: pullCasDir,
+				OCICertPath:    pullCasDir,
+			}, nil
+		},
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+var deleteFlags struct {
+	wait time.Duration
+}
+
+// deleteCmd uninstalls a package by deleting its ClusterExtension.
+var deleteCmd = &cobra.Command{
+	Use:   "delete <extension-name>",
+	Short: "Uninstall a package by deleting its ClusterExtension",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDelete(cmd.Context(), args[0])
+	},
+}
+
+func init() {
+	flags := deleteCmd.Flags()
+	flags.DurationVar(&deleteFlags.wait, "wait", 0, "Wait up to this long for the ClusterExtension to be fully removed. Zero means don't wait.")
+
+	olmCmd.AddCommand(deleteCmd)
+}
+
+func runDelete(ctx context.Context, name string) error {
+	cl, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	ext := &ocv1.ClusterExtension{}
+	ext.SetName(name)
+	if err := cl.Delete(ctx, ext); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("clusterextension %q not found", name)
+		}
+		return fmt.Errorf("deleting clusterextension %q: %w", name, err)
+	}
+	fmt.Printf("clusterextension.olm.operatorframework.io/%s deleted\n", name)
+
+	if deleteFlags.wait <= 0 {
+		return nil
+	}
+	return waitForDeleted(ctx, name, deleteFlags.wait)
+}
+
+func waitForDeleted(ctx context.Context, name string, timeout time.Duration) error {
+	watchClient, err := newWatchClient()
+	if err != nil {
+		return err
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	fmt.Printf("waiting up to %s for clusterextension %q to be removed...\n", timeout, name)
+	if err := waitForClusterExtensionDeleted(waitCtx, watchClient, name); err != nil {
+		return err
+	}
+	fmt.Printf("clusterextension %q removed\n", name)
+	return nil
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/operator-framework/helm-operator-plugins/pkg/storage"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+var doctorFlags struct {
+	outputFile string
+}
+
+// doctorCmd gathers the cluster state most useful for diagnosing an OLMv1 issue - ClusterExtensions
+// and ClusterCatalogs with their status conditions, the operator-controller and catalogd controller
+// logs, and Helm release Secret metadata for installed extensions - into a single support bundle
+// archive, so it can be attached to a bug report without walking someone through collecting each
+// piece by hand.
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Collect a support bundle of ClusterExtension, ClusterCatalog, and controller diagnostics",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDoctor(cmd.Context())
+	},
+}
+
+func init() {
+	flags := doctorCmd.Flags()
+	flags.StringVar(&doctorFlags.outputFile, "output", "olm-support-bundle.tar.gz", "Path to write the support bundle archive to.")
+
+	olmCmd.AddCommand(doctorCmd)
+}
+
+func runDoctor(ctx context.Context) error {
+	cl, err := newClient()
+	if err != nil {
+		return err
+	}
+	kubeClient, err := newKubeClient()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(doctorFlags.outputFile)
+	if err != nil {
+		return fmt.Errorf("creating support bundle %q: %w", doctorFlags.outputFile, err)
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+
+	if err := collectSupportBundle(ctx, tw, cl, kubeClient); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("finalizing support bundle %q: %w", doctorFlags.outputFile, err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("finalizing support bundle %q: %w", doctorFlags.outputFile, err)
+	}
+
+	fmt.Printf("wrote support bundle to %s\n", doctorFlags.outputFile)
+	return nil
+}
+
+func collectSupportBundle(ctx context.Context, tw *tar.Writer, cl client.Client, kubeClient kubernetes.Interface) error {
+	var extensions ocv1.ClusterExtensionList
+	if err := cl.List(ctx, &extensions); err != nil {
+		return fmt.Errorf("listing clusterextensions: %w", err)
+	}
+	var extConditions bytes.Buffer
+	for _, ext := range extensions.Items {
+		if err := addYAMLFile(tw, "clusterextensions/"+ext.Name+".yaml", &ext); err != nil {
+			return err
+		}
+		appendConditionSummary(&extConditions, ext.Name, ext.Status.Conditions)
+	}
+	if err := addTextFile(tw, "clusterextensions/conditions.txt", extConditions.String()); err != nil {
+		return err
+	}
+
+	var catalogs ocv1.ClusterCatalogList
+	if err := cl.List(ctx, &catalogs); err != nil {
+		return fmt.Errorf("listing clustercatalogs: %w", err)
+	}
+	var catalogConditions bytes.Buffer
+	for _, catalog := range catalogs.Items {
+		if err := addYAMLFile(tw, "clustercatalogs/"+catalog.Name+".yaml", &catalog); err != nil {
+			return err
+		}
+		appendConditionSummary(&catalogConditions, catalog.Name, catalog.Status.Conditions)
+	}
+	if err := addTextFile(tw, "clustercatalogs/conditions.txt", catalogConditions.String()); err != nil {
+		return err
+	}
+
+	var controllerPods corev1.PodList
+	if err := cl.List(ctx, &controllerPods, client.HasLabels{"control-plane"}); err != nil {
+		return fmt.Errorf("listing controller pods: %w", err)
+	}
+	for _, pod := range controllerPods.Items {
+		for _, container := range pod.Spec.Containers {
+			if err := addPodLogFile(ctx, tw, kubeClient, pod, container.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	var secrets corev1.SecretList
+	if err := cl.List(ctx, &secrets); err != nil {
+		return fmt.Errorf("listing secrets: %w", err)
+	}
+	for _, secret := range secrets.Items {
+		if !isHelmReleaseSecret(secret.Type) {
+			continue
+		}
+		secret.Data = nil // the release manifest itself isn't useful for diagnosing cluster state, and can be large
+		if err := addYAMLFile(tw, "helm-releases/"+secret.Namespace+"/"+secret.Name+".yaml", &secret); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isHelmReleaseSecret(t corev1.SecretType) bool {
+	switch t {
+	case "helm.sh/release.v1", storage.SecretTypeChunkedIndex, storage.SecretTypeChunkedChunk:
+		return true
+	default:
+		return false
+	}
+}
+
+func appendConditionSummary(buf *bytes.Buffer, name string, conditions []metav1.Condition) {
+	fmt.Fprintf(buf, "%s:\n", name)
+	if len(conditions) == 0 {
+		buf.WriteString("  no conditions reported\n")
+		return
+	}
+	for _, cond := range conditions {
+		fmt.Fprintf(buf, "  %s=%s reason=%s message=%s\n", cond.Type, cond.Status, cond.Reason, cond.Message)
+	}
+}
+
+func addPodLogFile(ctx context.Context, tw *tar.Writer, kubeClient kubernetes.Interface, pod corev1.Pod, container string) error {
+	logs, err := kubeClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{Container: container}).Stream(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: failed to get logs for pod %s/%s container %s: %v\n", pod.Namespace, pod.Name, container, err)
+		return nil
+	}
+	defer logs.Close()
+
+	data, err := io.ReadAll(logs)
+	if err != nil {
+		return fmt.Errorf("reading logs for pod %s/%s container %s: %w", pod.Namespace, pod.Name, container, err)
+	}
+	return addTarFile(tw, fmt.Sprintf("controllers/%s/%s-%s.log", pod.Namespace, pod.Name, container), data)
+}
+
+func addYAMLFile(tw *tar.Writer, name string, obj any) error {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("marshaling %s: %w", name, err)
+	}
+	return addTarFile(tw, name, data)
+}
+
+func addTextFile(tw *tar.Writer, name string, content string) error {
+	return addTarFile(tw, name, []byte(content))
+}
+
+func addTarFile(tw *tar.Writer, name string, data []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0600,
+		Size: int64(len(data)),
+	}); err != nil {
+		return fmt.Errorf("writing archive header for %s: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("writing %s to archive: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+var installFlags struct {
+	packageName    string
+	namespace      string
+	serviceAccount string
+	channel        string
+	version        string
+	wait           time.Duration
+}
+
+// installCmd creates a ClusterExtension that installs the named package from a catalog. It's
+// the CLI equivalent of applying a minimal ClusterExtension manifest by hand.
+var installCmd = &cobra.Command{
+	Use:   "install <extension-name> --package <package-name>",
+	Short: "Install a package by creating a ClusterExtension for it",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runInstall(cmd.Context(), args[0])
+	},
+}
+
+func init() {
+	flags := installCmd.Flags()
+	flags.StringVar(&installFlags.packageName, "package", "", "The name of the package to install.")
+	flags.StringVar(&installFlags.namespace, "namespace", "", "The namespace in which the ServiceAccount used for installation exists, and the default namespace for the extension's namespace-scoped resources.")
+	flags.StringVar(&installFlags.serviceAccount, "service-account", "", "The name of the ServiceAccount to use for installation and management of the extension.")
+	flags.StringVar(&installFlags.channel, "channel", "", "Restrict installation to bundles in this channel.")
+	flags.StringVar(&installFlags.version, "version", "", "A version or version range to install. When omitted, the latest version available is installed.")
+	flags.DurationVar(&installFlags.wait, "wait", 0, "Wait up to this long for the ClusterExtension to report Installed. Zero means don't wait.")
+	for _, name := range []string{"package", "namespace", "service-account"} {
+		if err := installCmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	olmCmd.AddCommand(installCmd)
+}
+
+func runInstall(ctx context.Context, name string) error {
+	cl, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	ext := &ocv1.ClusterExtension{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: ocv1.ClusterExtensionSpec{
+			Namespace: installFlags.namespace,
+			ServiceAccount: ocv1.ServiceAccountReference{
+				Name: installFlags.serviceAccount,
+			},
+			Source: ocv1.SourceConfig{
+				SourceType: ocv1.SourceTypeCatalog,
+				Catalog: &ocv1.CatalogFilter{
+					PackageName: installFlags.packageName,
+					Version:     installFlags.version,
+				},
+			},
+		},
+	}
+	if installFlags.channel != "" {
+		ext.Spec.Source.Catalog.Channels = []string{installFlags.channel}
+	}
+
+	if err := cl.Create(ctx, ext); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("clusterextension %q already exists", name)
+		}
+		return fmt.Errorf("creating clusterextension %q: %w", name, err)
+	}
+	fmt.Printf("clusterextension.olm.operatorframework.io/%s created\n", name)
+
+	if installFlags.wait <= 0 {
+		return nil
+	}
+	return waitForInstalled(ctx, name, installFlags.wait)
+}
+
+func waitForInstalled(ctx context.Context, name string, timeout time.Duration) error {
+	watchClient, err := newWatchClient()
+	if err != nil {
+		return err
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	fmt.Printf("waiting up to %s for clusterextension %q to report Installed...\n", timeout, name)
+	if err := waitForClusterExtensionCondition(waitCtx, watchClient, name, ocv1.TypeInstalled, metav1.ConditionTrue); err != nil {
+		return err
+	}
+	fmt.Printf("clusterextension %q installed\n", name)
+	return nil
+}
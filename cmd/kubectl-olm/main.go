@@ -0,0 +1,54 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command kubectl-olm is a standalone CLI (usable as a kubectl plugin, invoked as
+// "kubectl olm <command>", or directly) for managing ClusterExtensions and ClusterCatalogs.
+// It wraps the same API types the operator-controller and catalogd controllers reconcile,
+// rather than reimplementing any installation or resolution logic of its own.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/operator-framework/operator-controller/internal/shared/version"
+)
+
+var olmCmd = &cobra.Command{
+	Use:   "olm",
+	Short: "olm manages Operator Lifecycle Manager (OLM) v1 ClusterExtensions and ClusterCatalogs",
+}
+
+var versionCommand = &cobra.Command{
+	Use:   "version",
+	Short: "Prints kubectl-olm version information",
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println(version.String())
+	},
+}
+
+func init() {
+	olmCmd.AddCommand(versionCommand)
+}
+
+func main() {
+	if err := olmCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+var migrateFlags struct {
+	namespace string
+	apply     bool
+}
+
+// migrateScheme knows the ClusterExtension types and the OLMv0 Subscription/ClusterServiceVersion
+// types this command reads. It's kept local to this command rather than added to the shared
+// internal/operator-controller/scheme.Scheme, which other commands use to build clients that have
+// no reason to know about OLMv0 types.
+var migrateScheme = func() *runtime.Scheme {
+	s := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(s))
+	utilruntime.Must(ocv1.AddToScheme(s))
+	utilruntime.Must(operatorsv1alpha1.AddToScheme(s))
+	return s
+}()
+
+// migrateCmd generates, for each OLMv0 Subscription it finds, the ClusterExtension that would
+// adopt its installed ClusterServiceVersion under OLMv1. It doesn't reimplement dependency
+// resolution, webhook handling, or any other part of installation: packages whose CSV depends on
+// OLMv1 features it doesn't support are reported instead of a generated ClusterExtension.
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Generate ClusterExtensions that adopt OLMv0 Subscriptions, reporting any that can't be migrated",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMigrate(cmd.Context())
+	},
+}
+
+func init() {
+	flags := migrateCmd.Flags()
+	flags.StringVar(&migrateFlags.namespace, "namespace", "", "Only migrate Subscriptions in this namespace. Defaults to all namespaces.")
+	flags.BoolVar(&migrateFlags.apply, "apply", false, "Create the generated ClusterExtensions on the cluster, instead of printing them.")
+
+	olmCmd.AddCommand(migrateCmd)
+}
+
+// migratedExtension is a ClusterExtension generated from an OLMv0 Subscription/CSV pair.
+type migratedExtension struct {
+	subscription string
+	ext          *ocv1.ClusterExtension
+}
+
+// unmigratable is a Subscription that migrate can't generate a ClusterExtension for, and why.
+type unmigratable struct {
+	subscription string
+	reasons      []string
+}
+
+func runMigrate(ctx context.Context) error {
+	cl, err := newMigrateClient()
+	if err != nil {
+		return err
+	}
+
+	var subs operatorsv1alpha1.SubscriptionList
+	listOpts := []client.ListOption{}
+	if migrateFlags.namespace != "" {
+		listOpts = append(listOpts, client.InNamespace(migrateFlags.namespace))
+	}
+	if err := cl.List(ctx, &subs, listOpts...); err != nil {
+		return fmt.Errorf("listing subscriptions: %w", err)
+	}
+
+	var extensions []migratedExtension
+	var unmigratables []unmigratable
+	for _, sub := range subs.Items {
+		ext, reasons, err := planMigration(ctx, cl, &sub)
+		if err != nil {
+			return fmt.Errorf("planning migration for subscription %s/%s: %w", sub.Namespace, sub.Name, err)
+		}
+		subKey := fmt.Sprintf("%s/%s", sub.Namespace, sub.Name)
+		if len(reasons) > 0 {
+			unmigratables = append(unmigratables, unmigratable{subscription: subKey, reasons: reasons})
+			continue
+		}
+		extensions = append(extensions, migratedExtension{subscription: subKey, ext: ext})
+	}
+
+	if migrateFlags.apply {
+		for _, m := range extensions {
+			if err := cl.Create(ctx, m.ext); err != nil {
+				if apierrors.IsAlreadyExists(err) {
+					fmt.Fprintf(os.Stderr, "clusterextension %q already exists, skipping\n", m.ext.Name)
+					continue
+				}
+				return fmt.Errorf("creating clusterextension %q for subscription %s: %w", m.ext.Name, m.subscription, err)
+			}
+			fmt.Printf("created clusterextension %q for subscription %s\n", m.ext.Name, m.subscription)
+		}
+	} else {
+		objs := make([]client.Object, 0, len(extensions))
+		for _, m := range extensions {
+			objs = append(objs, m.ext)
+		}
+		if err := writeManifestsToStdout(objs); err != nil {
+			return err
+		}
+	}
+
+	if len(unmigratables) > 0 {
+		fmt.Fprintln(os.Stderr, "\ncould not generate a ClusterExtension for the following subscriptions:")
+		for _, u := range unmigratables {
+			fmt.Fprintf(os.Stderr, "  %s:\n", u.subscription)
+			for _, reason := range u.reasons {
+				fmt.Fprintf(os.Stderr, "    - %s\n", reason)
+			}
+		}
+	}
+	return nil
+}
+
+// planMigration returns the ClusterExtension that would adopt sub's installed CSV, or a non-empty
+// list of reasons why it can't be migrated automatically.
+func planMigration(ctx context.Context, cl client.Client, sub *operatorsv1alpha1.Subscription) (*ocv1.ClusterExtension, []string, error) {
+	if sub.Spec.Package == "" {
+		return nil, []string{"subscription has no spec.name (package) set"}, nil
+	}
+
+	if sub.Status.InstalledCSV == "" {
+		return nil, []string{"subscription has no installed CSV yet"}, nil
+	}
+
+	var csv operatorsv1alpha1.ClusterServiceVersion
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: sub.Namespace, Name: sub.Status.InstalledCSV}, &csv); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, []string{fmt.Sprintf("installed CSV %q not found", sub.Status.InstalledCSV)}, nil
+		}
+		return nil, nil, fmt.Errorf("getting clusterserviceversion %q: %w", sub.Status.InstalledCSV, err)
+	}
+
+	if csv.Status.Phase != operatorsv1alpha1.CSVPhaseSucceeded {
+		return nil, []string{fmt.Sprintf("installed CSV %q is in phase %q, not Succeeded", csv.Name, csv.Status.Phase)}, nil
+	}
+
+	var reasons []string
+	if n := len(csv.Spec.CustomResourceDefinitions.Required) + len(csv.Spec.APIServiceDefinitions.Required); n > 0 {
+		reasons = append(reasons, fmt.Sprintf("CSV declares %d required CRD/APIService dependency(ies); OLMv1 has no dependency resolution, install dependencies first", n))
+	}
+	if n := len(csv.Spec.WebhookDefinitions); n > 0 {
+		reasons = append(reasons, fmt.Sprintf("CSV declares %d webhook definition(s); verify cert management before migrating, since OLMv0 provisions webhook CA bundles itself", n))
+	}
+	if !installModeMigratable(csv.Spec.InstallModes) {
+		reasons = append(reasons, "CSV only supports MultiNamespace install mode, which ClusterExtension doesn't support")
+	}
+	serviceAccountName := installServiceAccountName(&csv)
+	if serviceAccountName == "" {
+		reasons = append(reasons, "CSV install strategy has no serviceAccountName set")
+	}
+	if len(reasons) > 0 {
+		return nil, reasons, nil
+	}
+
+	ext := &ocv1.ClusterExtension{
+		// Name the generated ClusterExtension after the package it migrates, since
+		// ClusterExtension is cluster-scoped and OLMv1 already uses the package name as the
+		// conventional ClusterExtension name elsewhere (e.g. in the e2e suite).
+		ObjectMeta: metav1.ObjectMeta{Name: sub.Spec.Package},
+		Spec: ocv1.ClusterExtensionSpec{
+			Namespace:      sub.Namespace,
+			ServiceAccount: ocv1.ServiceAccountReference{Name: serviceAccountName},
+			Source: ocv1.SourceConfig{
+				SourceType: ocv1.SourceTypeCatalog,
+				Catalog: &ocv1.CatalogFilter{
+					PackageName: sub.Spec.Package,
+					Channels:    channelsFor(sub.Spec.Channel),
+				},
+			},
+			Install: &ocv1.ClusterExtensionInstallConfig{
+				AdoptionPolicy: ocv1.AdoptionPolicyAdoptOLMV0,
+			},
+		},
+	}
+	return ext, nil, nil
+}
+
+// installServiceAccountName returns the ServiceAccount csv's install strategy runs as. OLMv0
+// convention is a single ServiceAccount shared by every permission set the strategy declares, so
+// the first one found (preferring cluster-scoped permissions) is used.
+func installServiceAccountName(csv *operatorsv1alpha1.ClusterServiceVersion) string {
+	for _, p := range csv.Spec.InstallStrategy.StrategySpec.ClusterPermissions {
+		if p.ServiceAccountName != "" {
+			return p.ServiceAccountName
+		}
+	}
+	for _, p := range csv.Spec.InstallStrategy.StrategySpec.Permissions {
+		if p.ServiceAccountName != "" {
+			return p.ServiceAccountName
+		}
+	}
+	return ""
+}
+
+func channelsFor(channel string) []string {
+	if channel == "" {
+		return nil
+	}
+	return []string{channel}
+}
+
+// installModeMigratable reports whether modes includes a mode other than MultiNamespace, since
+// ClusterExtension has no equivalent of watching an explicit set of more than one namespace.
+func installModeMigratable(modes []operatorsv1alpha1.InstallMode) bool {
+	for _, mode := range modes {
+		if mode.Supported && mode.Type != operatorsv1alpha1.InstallModeTypeMultiNamespace {
+			return true
+		}
+	}
+	return len(modes) == 0
+}
+
+// newMigrateClient builds a controller-runtime client against migrateScheme, which additionally
+// knows the OLMv0 Subscription/ClusterServiceVersion types this command reads.
+func newMigrateClient() (client.Client, error) {
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("loading kubeconfig: %w", err)
+	}
+	cl, err := client.New(restConfig, client.Options{Scheme: migrateScheme})
+	if err != nil {
+		return nil, fmt.Errorf("building client: %w", err)
+	}
+	return cl, nil
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"go.podman.io/image/v5/docker/reference"
+	"go.podman.io/image/v5/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/bundle/imagesource"
+	imageutil "github.com/operator-framework/operator-controller/internal/shared/util/image"
+)
+
+var mirrorFlags struct {
+	extensions     []string
+	catalogs       []string
+	target         string
+	registriesConf string
+	pullCasDir     string
+	dryRun         bool
+}
+
+// mirrorCmd computes the full set of images a given set of ClusterExtensions and ClusterCatalogs
+// depend on - each catalog's own image, each extension's resolved bundle image, and the operand
+// images its CSV declares in relatedImages - copies them to a mirror registry, and emits a
+// registries.conf mapping each source registry to the mirror. Pointing the unpacker's
+// SystemRegistriesConfPath at that file is enough to make every later pull of any of those images,
+// by its original reference, resolve to the mirror instead, without the unpacker or resolver
+// needing to know a mirror is in play. This is the offline counterpart of "oc adm catalog mirror":
+// compute the image set once, on a machine with registry access, and carry only the mirror plus
+// the registries.conf across the air gap.
+var mirrorCmd = &cobra.Command{
+	Use:   "mirror (--extension <name> | --catalog <name>)...",
+	Short: "Mirror the images a set of ClusterExtensions/ClusterCatalogs depend on to a target registry",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMirror(cmd.Context())
+	},
+}
+
+func init() {
+	flags := mirrorCmd.Flags()
+	flags.StringSliceVar(&mirrorFlags.extensions, "extension", nil, "Name of a ClusterExtension whose bundle and related images should be mirrored. Repeatable.")
+	flags.StringSliceVar(&mirrorFlags.catalogs, "catalog", nil, "Name of a ClusterCatalog whose own image should be mirrored. Repeatable.")
+	flags.StringVar(&mirrorFlags.target, "target", "", "The mirror registry to copy images to, e.g. mirror.example.com/olm-mirror.")
+	flags.StringVar(&mirrorFlags.registriesConf, "registries-conf", "", "Path to write the registries.conf mapping each source registry to the mirror.")
+	flags.StringVar(&mirrorFlags.pullCasDir, "pull-cas-dir", "", "The directory of TLS certificate authorities to use for verifying HTTPS connections to registries and catalogd.")
+	flags.BoolVar(&mirrorFlags.dryRun, "dry-run", false, "Compute the image set and write registries.conf without actually copying any images.")
+	cobra.CheckErr(mirrorCmd.MarkFlagRequired("target"))
+	cobra.CheckErr(mirrorCmd.MarkFlagRequired("registries-conf"))
+
+	olmCmd.AddCommand(mirrorCmd)
+}
+
+func runMirror(ctx context.Context) error {
+	if len(mirrorFlags.extensions) == 0 && len(mirrorFlags.catalogs) == 0 {
+		return fmt.Errorf("at least one --extension or --catalog is required")
+	}
+
+	cl, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	images, err := mirrorImageSet(ctx, cl)
+	if err != nil {
+		return err
+	}
+	if len(images) == 0 {
+		fmt.Println("no images found to mirror")
+		return nil
+	}
+
+	mirrors := make(map[string]string, len(images))
+	for _, srcRef := range images {
+		named, err := reference.ParseNamed(srcRef)
+		if err != nil {
+			return fmt.Errorf("parsing image reference %q: %w", srcRef, err)
+		}
+		mirrors[reference.Domain(named)] = mirrorFlags.target + "/" + reference.Domain(named)
+	}
+
+	if mirrorFlags.dryRun {
+		for _, srcRef := range images {
+			fmt.Printf("would mirror: %s\n", srcRef)
+		}
+	} else {
+		srcCtx := &types.SystemContext{DockerCertPath: mirrorFlags.pullCasDir, OCICertPath: mirrorFlags.pullCasDir}
+		for _, srcRef := range images {
+			destRef, err := mirroredReference(srcRef, mirrorFlags.target)
+			if err != nil {
+				return err
+			}
+			if err := imageutil.CopyImage(ctx, srcCtx, nil, srcRef, destRef); err != nil {
+				return err
+			}
+			fmt.Printf("mirrored %s -> %s\n", srcRef, destRef)
+		}
+	}
+
+	if err := imageutil.WriteMirrorRegistriesConf(mirrorFlags.registriesConf, mirrors); err != nil {
+		return err
+	}
+	fmt.Printf("wrote %s\n", mirrorFlags.registriesConf)
+	return nil
+}
+
+// mirrorImageSet resolves every --extension and --catalog into the image references it depends
+// on: a catalog's own image, and an extension's resolved bundle image plus the operand images its
+// CSV declares in relatedImages. The result is deduplicated and sorted for a stable mirroring order.
+func mirrorImageSet(ctx context.Context, cl client.Client) ([]string, error) {
+	seen := map[string]struct{}{}
+	add := func(ref string) {
+		if ref != "" {
+			seen[ref] = struct{}{}
+		}
+	}
+
+	for _, name := range mirrorFlags.catalogs {
+		var catalog ocv1.ClusterCatalog
+		if err := cl.Get(ctx, client.ObjectKey{Name: name}, &catalog); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("clustercatalog %q not found", name)
+			}
+			return nil, fmt.Errorf("getting clustercatalog %q: %w", name, err)
+		}
+		if catalog.Status.ResolvedSource == nil || catalog.Status.ResolvedSource.Image == nil {
+			return nil, fmt.Errorf("clustercatalog %q has not resolved an image yet", name)
+		}
+		add(catalog.Status.ResolvedSource.Image.Ref)
+	}
+
+	if len(mirrorFlags.extensions) > 0 {
+		resolver, err := newCatalogResolver(cl, mirrorFlags.pullCasDir)
+		if err != nil {
+			return nil, err
+		}
+
+		cacheDir, err := os.MkdirTemp("", "kubectl-olm-mirror-cache-*")
+		if err != nil {
+			return nil, fmt.Errorf("creating image cache directory: %w", err)
+		}
+		defer os.RemoveAll(cacheDir)
+		puller := newImagePuller(mirrorFlags.pullCasDir)
+
+		for _, name := range mirrorFlags.extensions {
+			var ext ocv1.ClusterExtension
+			if err := cl.Get(ctx, client.ObjectKey{Name: name}, &ext); err != nil {
+				if apierrors.IsNotFound(err) {
+					return nil, fmt.Errorf("clusterextension %q not found", name)
+				}
+				return nil, fmt.Errorf("getting clusterextension %q: %w", name, err)
+			}
+
+			var installedBundle *ocv1.BundleMetadata
+			if ext.Status.Install != nil {
+				installedBundle = &ext.Status.Install.Bundle
+			}
+			resolvedBundle, _, _, err := resolver.Resolve(ctx, &ext, installedBundle)
+			if err != nil {
+				return nil, fmt.Errorf("resolving clusterextension %q: %w", name, err)
+			}
+			add(resolvedBundle.Image)
+
+			rv1, err := imagesource.Load(ctx, puller, imageutil.BundleCache(cacheDir), "kubectl-olm-mirror", resolvedBundle.Image)
+			if err != nil {
+				return nil, err
+			}
+			for _, related := range rv1.CSV.Spec.RelatedImages {
+				add(related.Image)
+			}
+		}
+	}
+
+	images := make([]string, 0, len(seen))
+	for ref := range seen {
+		images = append(images, ref)
+	}
+	sort.Strings(images)
+	return images, nil
+}
+
+// mirroredReference rewrites srcRef's registry to target while keeping its repository path and
+// tag or digest, matching the mapping WriteMirrorRegistriesConf records: an image copied to
+// mirroredReference(srcRef, target) is exactly what a pull of srcRef resolves to once
+// registries.conf redirects srcRef's registry to target.
+func mirroredReference(srcRef, target string) (string, error) {
+	named, err := reference.ParseNamed(srcRef)
+	if err != nil {
+		return "", fmt.Errorf("parsing image reference %q: %w", srcRef, err)
+	}
+
+	mirroredName, err := reference.WithName(target + "/" + reference.Domain(named) + "/" + reference.Path(named))
+	if err != nil {
+		return "", fmt.Errorf("building mirrored reference for %q: %w", srcRef, err)
+	}
+
+	switch v := named.(type) {
+	case reference.Canonical:
+		canonical, err := reference.WithDigest(mirroredName, v.Digest())
+		if err != nil {
+			return "", fmt.Errorf("building mirrored reference for %q: %w", srcRef, err)
+		}
+		return canonical.String(), nil
+	case reference.NamedTagged:
+		tagged, err := reference.WithTag(mirroredName, v.Tag())
+		if err != nil {
+			return "", fmt.Errorf("building mirrored reference for %q: %w", srcRef, err)
+		}
+		return tagged.String(), nil
+	default:
+		return mirroredName.String(), nil
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/bundle/imagesource"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/render"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/render/registryv1"
+	imageutil "github.com/operator-framework/operator-controller/internal/shared/util/image"
+)
+
+var renderFlags struct {
+	packageName      string
+	channel          string
+	version          string
+	installNamespace string
+	watchNamespace   string
+	pullCasDir       string
+	outputDir        string
+}
+
+// renderCmd prints the plain manifests the controller would apply for a bundle, without installing
+// anything. The bundle can be named directly by image reference, or resolved from a catalog on the
+// cluster by package (and optionally channel/version), letting the same resolution and rendering
+// logic the controller uses be reviewed offline.
+var renderCmd = &cobra.Command{
+	Use:   "render (<bundle-image> | --package <package-name>)",
+	Short: "Render the plain manifests a bundle would install",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var imageRef string
+		if len(args) == 1 {
+			imageRef = args[0]
+		}
+		return runRender(cmd.Context(), imageRef)
+	},
+}
+
+func init() {
+	flags := renderCmd.Flags()
+	flags.StringVar(&renderFlags.packageName, "package", "", "The package to resolve a bundle for, when not given a bundle image directly.")
+	flags.StringVar(&renderFlags.channel, "channel", "", "Restrict resolution to bundles in this channel. Only used with --package.")
+	flags.StringVar(&renderFlags.version, "version", "", "A version or version range to resolve against. Only used with --package.")
+	flags.StringVar(&renderFlags.installNamespace, "install-namespace", "", "The namespace the bundle would be installed into.")
+	flags.StringVar(&renderFlags.watchNamespace, "watch-namespace", "", "The namespace the installed operator would watch, for bundles supporting SingleNamespace/OwnNamespace install modes.")
+	flags.StringVar(&renderFlags.pullCasDir, "pull-cas-dir", "", "The directory of TLS certificate authorities to use for verifying HTTPS connections to registries and catalogd.")
+	flags.StringVar(&renderFlags.outputDir, "output-dir", "", "Directory to write one YAML manifest file per object to, instead of printing them to stdout.")
+	cobra.CheckErr(renderCmd.MarkFlagRequired("install-namespace"))
+
+	olmCmd.AddCommand(renderCmd)
+}
+
+func runRender(ctx context.Context, imageRef string) error {
+	if imageRef == "" && renderFlags.packageName == "" {
+		return fmt.Errorf("either a bundle image or --package is required")
+	}
+	if imageRef != "" && renderFlags.packageName != "" {
+		return fmt.Errorf("a bundle image and --package are mutually exclusive")
+	}
+
+	if imageRef == "" {
+		ref, err := resolveBundleImage(ctx)
+		if err != nil {
+			return err
+		}
+		imageRef = ref
+	}
+
+	cacheDir, err := os.MkdirTemp("", "kubectl-olm-render-cache-*")
+	if err != nil {
+		return fmt.Errorf("creating image cache directory: %w", err)
+	}
+	defer os.RemoveAll(cacheDir)
+
+	puller := newImagePuller(renderFlags.pullCasDir)
+	rv1, err := imagesource.Load(ctx, puller, imageutil.BundleCache(cacheDir), "kubectl-olm-render", imageRef)
+	if err != nil {
+		return err
+	}
+
+	objs, err := registryv1.Renderer.Render(rv1, renderFlags.installNamespace, render.WithTargetNamespaces(renderFlags.watchNamespace))
+	if err != nil {
+		return fmt.Errorf("rendering bundle %q: %w", imageRef, err)
+	}
+
+	if renderFlags.outputDir != "" {
+		return writeManifestFiles(renderFlags.outputDir, objs)
+	}
+	return writeManifestsToStdout(objs)
+}
+
+// resolveBundleImage resolves --package (and optionally --channel/--version) against the cluster's
+// catalogs and returns the image reference of the bundle that resolution lands on, reusing the same
+// CatalogResolver wiring as the why-not command.
+func resolveBundleImage(ctx context.Context) (string, error) {
+	cl, err := newClient()
+	if err != nil {
+		return "", err
+	}
+
+	catalog := &ocv1.CatalogFilter{
+		PackageName: renderFlags.packageName,
+		Version:     renderFlags.version,
+	}
+	if renderFlags.channel != "" {
+		catalog.Channels = []string{renderFlags.channel}
+	}
+	ext := &ocv1.ClusterExtension{
+		Spec: ocv1.ClusterExtensionSpec{
+			Source: ocv1.SourceConfig{
+				SourceType: ocv1.SourceTypeCatalog,
+				Catalog:    catalog,
+			},
+		},
+	}
+
+	resolver, err := newCatalogResolver(cl, renderFlags.pullCasDir)
+	if err != nil {
+		return "", err
+	}
+
+	resolvedBundle, _, _, err := resolver.Resolve(ctx, ext, nil)
+	if err != nil {
+		return "", fmt.Errorf("resolving package %q: %w", renderFlags.packageName, err)
+	}
+	return resolvedBundle.Image, nil
+}
+
+func writeManifestsToStdout(objs []client.Object) error {
+	for idx, obj := range slices.SortedFunc(slices.Values(objs), orderByKindNamespaceName) {
+		if idx > 0 {
+			fmt.Println("---")
+		}
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("marshaling %s %q: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		fmt.Print(string(data))
+	}
+	return nil
+}
+
+func writeManifestFiles(outputDir string, objs []client.Object) error {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("creating output directory %q: %w", outputDir, err)
+	}
+	for idx, obj := range slices.SortedFunc(slices.Values(objs), orderByKindNamespaceName) {
+		kind := obj.GetObjectKind().GroupVersionKind().Kind
+		fileName := fmt.Sprintf("%02d_%s_%s.yaml", idx, strings.ToLower(kind), obj.GetName())
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("marshaling %s %q: %w", kind, obj.GetName(), err)
+		}
+		if err := os.WriteFile(filepath.Join(outputDir, fileName), data, 0600); err != nil {
+			return fmt.Errorf("writing %s: %w", fileName, err)
+		}
+	}
+	return nil
+}
+
+func orderByKindNamespaceName(a, b client.Object) int {
+	return cmp.Or(
+		cmp.Compare(a.GetObjectKind().GroupVersionKind().Kind, b.GetObjectKind().GroupVersionKind().Kind),
+		cmp.Compare(a.GetNamespace(), b.GetNamespace()),
+		cmp.Compare(a.GetName(), b.GetName()),
+	)
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	bsemver "github.com/blang/semver/v4"
+	"github.com/spf13/cobra"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/bundleutil"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/catalogmetadata/cache"
+	catalogclient "github.com/operator-framework/operator-controller/internal/operator-controller/catalogmetadata/client"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/catalogmetadata/compare"
+	httputil "github.com/operator-framework/operator-controller/internal/shared/util/http"
+)
+
+var searchFlags struct {
+	catalog    string
+	channel    string
+	version    string
+	pullCasDir string
+}
+
+// searchCmd lists the packages, channels, and bundle versions available across a cluster's
+// ClusterCatalogs, using the same catalogd client the controller uses to fetch catalog content.
+// It replaces ad-hoc curl+jq against catalogd's content service for answering "what's out
+// there" questions.
+var searchCmd = &cobra.Command{
+	Use:   "search [package-name-substring]",
+	Short: "List packages, channels, and versions available across ClusterCatalogs",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var packageSubstring string
+		if len(args) == 1 {
+			packageSubstring = args[0]
+		}
+		return runSearch(cmd.Context(), packageSubstring)
+	},
+}
+
+func init() {
+	flags := searchCmd.Flags()
+	flags.StringVar(&searchFlags.catalog, "catalog", "", "Restrict the search to a single ClusterCatalog by name.")
+	flags.StringVar(&searchFlags.channel, "channel", "", "Restrict results to bundles in this channel.")
+	flags.StringVar(&searchFlags.version, "version", "", "Restrict results to bundles whose version satisfies this version or version range.")
+	flags.StringVar(&searchFlags.pullCasDir, "pull-cas-dir", "", "The directory of TLS certificate authorities to use for verifying HTTPS connections to catalogd.")
+
+	olmCmd.AddCommand(searchCmd)
+}
+
+// searchResult is a single catalog/package/channel/bundle match, printed as one row of output.
+type searchResult struct {
+	catalog string
+	pkg     string
+	channel string
+	version string
+}
+
+func runSearch(ctx context.Context, packageSubstring string) error {
+	cl, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	var versionRange bsemver.Range
+	if searchFlags.version != "" {
+		versionRange, err = compare.NewVersionRange(searchFlags.version)
+		if err != nil {
+			return fmt.Errorf("--version %q is invalid: %w", searchFlags.version, err)
+		}
+	}
+
+	catalogs, err := searchCatalogs(ctx, cl)
+	if err != nil {
+		return err
+	}
+
+	catalogClient, err := newSearchCatalogClient(searchFlags.pullCasDir)
+	if err != nil {
+		return err
+	}
+
+	var results []searchResult
+	for _, catalog := range catalogs {
+		catalogFsys, err := catalogClient.PopulateCache(ctx, &catalog)
+		if err != nil {
+			return fmt.Errorf("populating cache for catalog %q: %w", catalog.Name, err)
+		}
+
+		fbc, err := declcfg.LoadFS(ctx, catalogFsys)
+		if err != nil {
+			return fmt.Errorf("loading content for catalog %q: %w", catalog.Name, err)
+		}
+
+		results = append(results, matchBundles(catalog.Name, fbc, packageSubstring, searchFlags.channel, versionRange)...)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].pkg != results[j].pkg {
+			return results[i].pkg < results[j].pkg
+		}
+		if results[i].catalog != results[j].catalog {
+			return results[i].catalog < results[j].catalog
+		}
+		return results[i].version < results[j].version
+	})
+
+	for _, r := range results {
+		fmt.Printf("%s\t%s\t%s\t%s\n", r.catalog, r.pkg, r.channel, r.version)
+	}
+	return nil
+}
+
+// matchBundles returns a searchResult for every bundle in fbc whose package name contains
+// packageSubstring (matching all packages when empty) and, if set, is a member of channel and
+// satisfies versionRange.
+func matchBundles(catalogName string, fbc *declcfg.DeclarativeConfig, packageSubstring, channel string, versionRange bsemver.Range) []searchResult {
+	channelsByPackage := map[string][]declcfg.Channel{}
+	for _, ch := range fbc.Channels {
+		channelsByPackage[ch.Package] = append(channelsByPackage[ch.Package], ch)
+	}
+
+	var results []searchResult
+	for _, b := range fbc.Bundles {
+		if packageSubstring != "" && !strings.Contains(b.Package, packageSubstring) {
+			continue
+		}
+
+		vr, err := bundleutil.GetVersionAndRelease(b)
+		if err != nil {
+			continue
+		}
+		if versionRange != nil && !versionRange(vr.Version) {
+			continue
+		}
+
+		for _, ch := range channelsByPackage[b.Package] {
+			if channel != "" && ch.Name != channel {
+				continue
+			}
+			if !channelContainsBundle(ch, b.Name) {
+				continue
+			}
+			results = append(results, searchResult{catalog: catalogName, pkg: b.Package, channel: ch.Name, version: vr.Version.String()})
+		}
+	}
+	return results
+}
+
+func channelContainsBundle(ch declcfg.Channel, bundleName string) bool {
+	for _, entry := range ch.Entries {
+		if entry.Name == bundleName {
+			return true
+		}
+	}
+	return false
+}
+
+// searchCatalogs returns the ClusterCatalogs to search: all of them, or just the one named by
+// --catalog.
+func searchCatalogs(ctx context.Context, cl client.Client) ([]ocv1.ClusterCatalog, error) {
+	if searchFlags.catalog != "" {
+		var catalog ocv1.ClusterCatalog
+		if err := cl.Get(ctx, client.ObjectKey{Name: searchFlags.catalog}, &catalog); err != nil {
+			return nil, fmt.Errorf("getting clustercatalog %q: %w", searchFlags.catalog, err)
+		}
+		return []ocv1.ClusterCatalog{catalog}, nil
+	}
+
+	var catalogs ocv1.ClusterCatalogList
+	if err := cl.List(ctx, &catalogs); err != nil {
+		return nil, fmt.Errorf("listing clustercatalogs: %w", err)
+	}
+	return catalogs.Items, nil
+}
+
+// newSearchCatalogClient builds a catalogd client wired the same way newCatalogResolver does,
+// with its own short-lived cache directory rather than a configured, persistent one, since a
+// one-shot CLI invocation has no use for a cache that outlives it.
+func newSearchCatalogClient(pullCasDir string) (*catalogclient.Client, error) {
+	cacheDir, err := os.MkdirTemp("", "kubectl-olm-search-cache-*")
+	if err != nil {
+		return nil, fmt.Errorf("creating catalog cache directory: %w", err)
+	}
+
+	cpw, err := httputil.NewCertPoolWatcher(pullCasDir, ctrl.Log.WithName("catalogd-ca-pool"))
+	if err != nil {
+		return nil, fmt.Errorf("building certificate pool watcher: %w", err)
+	}
+	if err := cpw.Start(context.Background()); err != nil {
+		return nil, fmt.Errorf("starting certificate pool watcher: %w", err)
+	}
+
+	return catalogclient.New(cache.NewFilesystemCache(filepath.Clean(cacheDir)), func() (*http.Client, error) {
+		return httputil.BuildHTTPClient(cpw)
+	}), nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+var statusFlags struct {
+	catalog bool
+}
+
+// statusCmd prints the conditions of a ClusterExtension, or with --catalog, of a ClusterCatalog.
+var statusCmd = &cobra.Command{
+	Use:   "status <name>",
+	Short: "Print the status conditions of a ClusterExtension or, with --catalog, a ClusterCatalog",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runStatus(cmd.Context(), args[0])
+	},
+}
+
+func init() {
+	flags := statusCmd.Flags()
+	flags.BoolVar(&statusFlags.catalog, "catalog", false, "Print the status of a ClusterCatalog instead of a ClusterExtension.")
+
+	olmCmd.AddCommand(statusCmd)
+}
+
+func runStatus(ctx context.Context, name string) error {
+	cl, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	if statusFlags.catalog {
+		var catalog ocv1.ClusterCatalog
+		if err := cl.Get(ctx, client.ObjectKey{Name: name}, &catalog); err != nil {
+			return fmt.Errorf("getting clustercatalog %q: %w", name, err)
+		}
+		printConditions(catalog.GetName(), catalog.Status.Conditions)
+		return nil
+	}
+
+	var ext ocv1.ClusterExtension
+	if err := cl.Get(ctx, client.ObjectKey{Name: name}, &ext); err != nil {
+		return fmt.Errorf("getting clusterextension %q: %w", name, err)
+	}
+	if ext.Status.Install != nil {
+		fmt.Printf("installed bundle: %s (version %s)\n", ext.Status.Install.Bundle.Name, ext.Status.Install.Bundle.Version)
+	}
+	printConditions(ext.GetName(), ext.Status.Conditions)
+	for _, edge := range ext.Status.AvailableUpgradeEdges {
+		fmt.Printf("available upgrade: %s (version %s, reason %s)\n", edge.Bundle.Name, edge.Bundle.Version, edge.Reason)
+	}
+	return nil
+}
+
+func printConditions(name string, conditions []metav1.Condition) {
+	fmt.Printf("%s:\n", name)
+	if len(conditions) == 0 {
+		fmt.Println("  no conditions reported")
+		return
+	}
+	for _, cond := range conditions {
+		fmt.Printf("  %s=%s reason=%s message=%s\n", cond.Type, cond.Status, cond.Reason, cond.Message)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+var upgradeFlags struct {
+	version string
+	wait    time.Duration
+}
+
+// upgradeCmd moves a ClusterExtension to a different bundle version by updating its
+// source.catalog.version constraint. OLMv1 has no separate approval object to act on; the
+// ClusterExtension's spec is the only thing that needs to change.
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade <extension-name> --version <version>",
+	Short: "Move a ClusterExtension to a different bundle version",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runUpgrade(cmd.Context(), args[0])
+	},
+}
+
+func init() {
+	flags := upgradeCmd.Flags()
+	flags.StringVar(&upgradeFlags.version, "version", "", "The version or version range to upgrade to.")
+	flags.DurationVar(&upgradeFlags.wait, "wait", 0, "Wait up to this long for the ClusterExtension to report Installed at the new version. Zero means don't wait.")
+	if err := upgradeCmd.MarkFlagRequired("version"); err != nil {
+		panic(err)
+	}
+
+	olmCmd.AddCommand(upgradeCmd)
+}
+
+func runUpgrade(ctx context.Context, name string) error {
+	cl, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	var ext ocv1.ClusterExtension
+	if err := cl.Get(ctx, client.ObjectKey{Name: name}, &ext); err != nil {
+		return fmt.Errorf("getting clusterextension %q: %w", name, err)
+	}
+	if ext.Spec.Source.Catalog == nil {
+		return fmt.Errorf("clusterextension %q is not sourced from a catalog", name)
+	}
+
+	patch := client.MergeFrom(ext.DeepCopy())
+	ext.Spec.Source.Catalog.Version = upgradeFlags.version
+	if err := cl.Patch(ctx, &ext, patch); err != nil {
+		return fmt.Errorf("patching clusterextension %q: %w", name, err)
+	}
+	fmt.Printf("clusterextension.olm.operatorframework.io/%s updated\n", name)
+
+	if upgradeFlags.wait <= 0 {
+		return nil
+	}
+	return waitForInstalled(ctx, name, upgradeFlags.wait)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	imageutil "github.com/operator-framework/operator-controller/internal/shared/util/image"
+	"github.com/operator-framework/operator-controller/pkg/validate"
+)
+
+var validateFlags struct {
+	dir        string
+	pullCasDir string
+}
+
+// validateCmd checks a catalog image or an FBC directory against operator-controller's
+// expectations, for running in catalog-build CI before a catalog is published. Unlike the rest of
+// kubectl-olm, it never talks to a cluster.
+var validateCmd = &cobra.Command{
+	Use:   "validate (<catalog-image> | --dir <fbc-directory>)",
+	Short: "Validate a catalog image or FBC directory against operator-controller's expectations",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var imageRef string
+		if len(args) == 1 {
+			imageRef = args[0]
+		}
+		return runValidate(cmd.Context(), imageRef)
+	},
+}
+
+func init() {
+	flags := validateCmd.Flags()
+	flags.StringVar(&validateFlags.dir, "dir", "", "Validate an FBC directory on disk instead of a catalog image.")
+	flags.StringVar(&validateFlags.pullCasDir, "pull-cas-dir", "", "The directory of TLS certificate authorities to use for verifying HTTPS connections to registries. Only used without --dir.")
+
+	olmCmd.AddCommand(validateCmd)
+}
+
+func runValidate(ctx context.Context, imageRef string) error {
+	if imageRef == "" && validateFlags.dir == "" {
+		return fmt.Errorf("either a catalog image or --dir is required")
+	}
+	if imageRef != "" && validateFlags.dir != "" {
+		return fmt.Errorf("a catalog image and --dir are mutually exclusive")
+	}
+
+	fsys, cleanup, err := catalogFSForValidate(ctx, imageRef)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	report, err := validate.Catalog(ctx, fsys)
+	if err != nil {
+		return fmt.Errorf("validating catalog: %w", err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return fmt.Errorf("encoding report: %w", err)
+	}
+
+	if !report.Passed() {
+		return fmt.Errorf("catalog failed validation")
+	}
+	return nil
+}
+
+// catalogFSForValidate returns the FBC to validate, and a cleanup function the caller must run
+// once it's done reading from it.
+func catalogFSForValidate(ctx context.Context, imageRef string) (fs.FS, func(), error) {
+	if validateFlags.dir != "" {
+		return os.DirFS(validateFlags.dir), func() {}, nil
+	}
+
+	cacheDir, err := os.MkdirTemp("", "kubectl-olm-validate-cache-*")
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating image cache directory: %w", err)
+	}
+	cleanup := func() { os.RemoveAll(cacheDir) }
+
+	puller := newImagePuller(validateFlags.pullCasDir)
+	fsys, _, _, err := puller.Pull(ctx, "kubectl-olm-validate", imageRef, imageutil.CatalogCache(cacheDir))
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("pulling catalog image %q: %w", imageRef, err)
+	}
+	return fsys, cleanup, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+// waitForClusterExtensionCondition watches the named ClusterExtension until its condition of
+// the given type reports the given status, the context is canceled, or the ClusterExtension is
+// deleted while waiting on anything other than absence itself.
+func waitForClusterExtensionCondition(ctx context.Context, cl client.WithWatch, name, conditionType string, conditionStatus metav1.ConditionStatus) error {
+	watcher, err := cl.Watch(ctx, &ocv1.ClusterExtensionList{}, client.MatchingFieldsSelector{
+		Selector: fields.OneTermEqualSelector("metadata.name", name),
+	})
+	if err != nil {
+		return fmt.Errorf("watching clusterextension %q: %w", name, err)
+	}
+	defer watcher.Stop()
+
+	var existing ocv1.ClusterExtension
+	if err := cl.Get(ctx, client.ObjectKey{Name: name}, &existing); err == nil {
+		if conditionMatches(&existing, conditionType, conditionStatus) {
+			return nil
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for clusterextension %q condition %s=%s: %w", name, conditionType, conditionStatus, ctx.Err())
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return fmt.Errorf("watch closed while waiting for clusterextension %q condition %s=%s", name, conditionType, conditionStatus)
+			}
+			ext, ok := event.Object.(*ocv1.ClusterExtension)
+			if !ok {
+				continue
+			}
+			if conditionMatches(ext, conditionType, conditionStatus) {
+				return nil
+			}
+		}
+	}
+}
+
+func conditionMatches(ext *ocv1.ClusterExtension, conditionType string, conditionStatus metav1.ConditionStatus) bool {
+	cond := apimeta.FindStatusCondition(ext.Status.Conditions, conditionType)
+	return cond != nil && cond.Status == conditionStatus
+}
+
+// waitForClusterExtensionDeleted watches the named ClusterExtension until it no longer exists,
+// or the context is canceled.
+func waitForClusterExtensionDeleted(ctx context.Context, cl client.WithWatch, name string) error {
+	var existing ocv1.ClusterExtension
+	if err := cl.Get(ctx, client.ObjectKey{Name: name}, &existing); apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	watcher, err := cl.Watch(ctx, &ocv1.ClusterExtensionList{}, client.MatchingFieldsSelector{
+		Selector: fields.OneTermEqualSelector("metadata.name", name),
+	})
+	if err != nil {
+		return fmt.Errorf("watching clusterextension %q: %w", name, err)
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for clusterextension %q to be removed: %w", name, ctx.Err())
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return fmt.Errorf("watch closed while waiting for clusterextension %q to be removed", name)
+			}
+			if event.Type == watch.Deleted {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/catalogmetadata/cache"
+	catalogclient "github.com/operator-framework/operator-controller/internal/operator-controller/catalogmetadata/client"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/resolve"
+	httputil "github.com/operator-framework/operator-controller/internal/shared/util/http"
+)
+
+var whyNotFlags struct {
+	name        string
+	packageName string
+	channel     string
+	version     string
+	pullCasDir  string
+}
+
+// whyNotCmd runs the same resolution the controller would and reports why it didn't pick the
+// bundle the caller expected, without needing to go read controller logs.
+var whyNotCmd = &cobra.Command{
+	Use:   "why-not (<extension-name> | --package <package-name>)",
+	Short: "Explain why resolution did, or didn't, select a particular bundle version",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 1 {
+			whyNotFlags.name = args[0]
+		}
+		return runWhyNot(cmd.Context())
+	},
+}
+
+func init() {
+	flags := whyNotCmd.Flags()
+	flags.StringVar(&whyNotFlags.packageName, "package", "", "The package to resolve, when not resolving against an existing ClusterExtension.")
+	flags.StringVar(&whyNotFlags.channel, "channel", "", "Restrict resolution to bundles in this channel.")
+	flags.StringVar(&whyNotFlags.version, "version", "", "A version or version range to resolve against.")
+	flags.StringVar(&whyNotFlags.pullCasDir, "pull-cas-dir", "", "The directory of TLS certificate authorities to use for verifying HTTPS connections to catalogd.")
+
+	olmCmd.AddCommand(whyNotCmd)
+}
+
+func runWhyNot(ctx context.Context) error {
+	cl, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	ext, err := whyNotClusterExtension(ctx, cl)
+	if err != nil {
+		return err
+	}
+
+	resolver, err := newCatalogResolver(cl, whyNotFlags.pullCasDir)
+	if err != nil {
+		return err
+	}
+
+	var installedBundle *ocv1.BundleMetadata
+	if ext.Status.Install != nil {
+		installedBundle = &ext.Status.Install.Bundle
+	}
+
+	resolvedBundle, resolvedVersion, _, resolveErr := resolver.Resolve(ctx, ext, installedBundle)
+	if resolveErr == nil {
+		fmt.Printf("resolved: %s (version %s)\n", resolvedBundle.Name, resolvedVersion.Version)
+		return nil
+	}
+
+	var resErr resolve.ResolutionError
+	if !errors.As(resolveErr, &resErr) {
+		return fmt.Errorf("resolution failed: %w", resolveErr)
+	}
+
+	fmt.Println(resErr.Error())
+	fmt.Println()
+	fmt.Println("per-catalog breakdown:")
+	for _, stat := range resErr.CatalogStats {
+		switch {
+		case !stat.PackageFound:
+			fmt.Printf("  %s: package %q not found\n", stat.CatalogName, resErr.PackageName)
+		case stat.MatchedBundles == 0:
+			fmt.Printf("  %s: package found with %d bundle(s), none matched the channel/version/upgrade-edge constraints\n", stat.CatalogName, stat.TotalBundles)
+		default:
+			fmt.Printf("  %s: %d of %d bundle(s) matched\n", stat.CatalogName, stat.MatchedBundles, stat.TotalBundles)
+		}
+	}
+	return nil
+}
+
+// whyNotClusterExtension returns the ClusterExtension to resolve against: the named one if
+// given, or a throwaway one built from --package/--channel/--version for resolving a package
+// that doesn't have a ClusterExtension yet.
+func whyNotClusterExtension(ctx context.Context, cl client.Client) (*ocv1.ClusterExtension, error) {
+	if whyNotFlags.name != "" {
+		var ext ocv1.ClusterExtension
+		if err := cl.Get(ctx, client.ObjectKey{Name: whyNotFlags.name}, &ext); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("clusterextension %q not found", whyNotFlags.name)
+			}
+			return nil, fmt.Errorf("getting clusterextension %q: %w", whyNotFlags.name, err)
+		}
+		return &ext, nil
+	}
+
+	if whyNotFlags.packageName == "" {
+		return nil, fmt.Errorf("either an extension name or --package is required")
+	}
+
+	catalog := &ocv1.CatalogFilter{
+		PackageName: whyNotFlags.packageName,
+		Version:     whyNotFlags.version,
+	}
+	if whyNotFlags.channel != "" {
+		catalog.Channels = []string{whyNotFlags.channel}
+	}
+	return &ocv1.ClusterExtension{
+		Spec: ocv1.ClusterExtensionSpec{
+			Source: ocv1.SourceConfig{
+				SourceType: ocv1.SourceTypeCatalog,
+				Catalog:    catalog,
+			},
+		},
+	}, nil
+}
+
+// newCatalogResolver builds a resolve.CatalogResolver wired the same way
+// cmd/operator-controller does: walking the cluster's ClusterCatalogs and fetching package
+// metadata from catalogd. Its cache lives under the OS temp directory for the life of this
+// process rather than a configured, persistent cache path, since a one-shot CLI invocation has
+// no use for a cache that outlives it.
+func newCatalogResolver(cl client.Client, pullCasDir string) (*resolve.CatalogResolver, error) {
+	cacheDir, err := os.MkdirTemp("", "kubectl-olm-catalog-cache-*")
+	if err != nil {
+		return nil, fmt.Errorf("creating catalog cache directory: %w", err)
+	}
+
+	cpw, err := httputil.NewCertPoolWatcher(pullCasDir, ctrl.Log.WithName("catalogd-ca-pool"))
+	if err != nil {
+		return nil, fmt.Errorf("building certificate pool watcher: %w", err)
+	}
+	if err := cpw.Start(context.Background()); err != nil {
+		return nil, fmt.Errorf("starting certificate pool watcher: %w", err)
+	}
+
+	catalogClient := catalogclient.New(cache.NewFilesystemCache(filepath.Clean(cacheDir)), func() (*http.Client, error) {
+		return httputil.BuildHTTPClient(cpw)
+	})
+
+	return &resolve.CatalogResolver{
+		WalkCatalogsFunc: resolve.CatalogWalker(
+			func(ctx context.Context, opts ...client.ListOption) ([]ocv1.ClusterCatalog, error) {
+				var catalogs ocv1.ClusterCatalogList
+				if err := cl.List(ctx, &catalogs, opts...); err != nil {
+					return nil, err
+				}
+				return catalogs.Items, nil
+			},
+			catalogClient.GetPackage,
+		),
+	}, nil
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// debugFlagsVHandler serves and updates klog's "-v" verbosity flag at
+// runtime, so a busy controller's log volume can be turned up to diagnose
+// a stuck reconcile without a restart (which would lose in-memory state
+// like informer caches). GET returns the current level; PUT/POST set it
+// to the level given in the request body (e.g. "4").
+func debugFlagsVHandler(w http.ResponseWriter, r *http.Request) {
+	vFlag := flag.Lookup("v")
+	if vFlag == nil {
+		http.Error(w, "v flag not registered", http.StatusInternalServerError)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		fmt.Fprintln(w, vFlag.Value.String())
+	case http.MethodPut, http.MethodPost:
+		body, err := io.ReadAll(io.LimitReader(r.Body, 32))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("error reading request body: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := vFlag.Value.Set(string(body)); err != nil {
+			http.Error(w, fmt.Sprintf("error setting log level: %v", err), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintln(w, vFlag.Value.String())
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
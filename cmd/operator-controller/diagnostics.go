@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+// clusterExtensionDiagnostics is the debug/clusterextensions/<name> response
+// body. It gathers, in one place, the ClusterExtension status fields and
+// on-disk cache location an admin would otherwise have to piece together
+// from `kubectl get -o yaml`, `kubectl get clusterextensionrevision`, and a
+// shell on the controller pod - the same information a failed e2e run
+// collects into artifacts by hand.
+type clusterExtensionDiagnostics struct {
+	Name               string                              `json:"name"`
+	Conditions         []metav1.Condition                  `json:"conditions,omitempty"`
+	Install            *ocv1.ClusterExtensionInstallStatus `json:"install,omitempty"`
+	ActiveRevisions    []ocv1.RevisionStatus               `json:"activeRevisions,omitempty"`
+	History            []ocv1.ClusterExtensionHistoryEntry `json:"history,omitempty"`
+	UnhealthyObjects   []string                            `json:"unhealthyObjects,omitempty"`
+	ApplyErrors        []string                            `json:"applyErrors,omitempty"`
+	MissingPermissions []string                            `json:"missingPermissions,omitempty"`
+	LastResolvedTime   *metav1.Time                        `json:"lastResolvedTime,omitempty"`
+	LastUnpackedTime   *metav1.Time                        `json:"lastUnpackedTime,omitempty"`
+	LastInstallTime    *metav1.Time                        `json:"lastInstallTime,omitempty"`
+	// BundleCachePath is the on-disk directory the unpacked bundle content
+	// for this ClusterExtension is cached under (one subdirectory per bundle
+	// digest seen), for correlating with a `kubectl debug` shell on the
+	// controller pod.
+	BundleCachePath string `json:"bundleCachePath"`
+}
+
+// diagnosticsHandler serves clusterExtensionDiagnostics for the
+// ClusterExtension named by the request path
+// (/debug/clusterextensions/<name>), productizing what an e2e failure's
+// artifact collection scrapes by hand into an always-available dump, so a
+// stuck install can be triaged from `kubectl get --raw` without a live
+// debugging session.
+func diagnosticsHandler(cl client.Client, bundleCacheBasePath string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		name := strings.TrimPrefix(r.URL.Path, "/debug/clusterextensions/")
+		if name == "" {
+			http.Error(w, "clusterextension name is required", http.StatusBadRequest)
+			return
+		}
+
+		var ext ocv1.ClusterExtension
+		if err := cl.Get(r.Context(), client.ObjectKey{Name: name}, &ext); err != nil {
+			if apierrors.IsNotFound(err) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		diagnostics := clusterExtensionDiagnostics{
+			Name:               ext.Name,
+			Conditions:         ext.Status.Conditions,
+			Install:            ext.Status.Install,
+			ActiveRevisions:    ext.Status.ActiveRevisions,
+			History:            ext.Status.History,
+			UnhealthyObjects:   ext.Status.UnhealthyObjects,
+			ApplyErrors:        ext.Status.ApplyErrors,
+			MissingPermissions: ext.Status.MissingPermissions,
+			LastResolvedTime:   ext.Status.LastResolvedTime,
+			LastUnpackedTime:   ext.Status.LastUnpackedTime,
+			LastInstallTime:    ext.Status.LastInstallTime,
+			BundleCachePath:    filepath.Join(bundleCacheBasePath, ext.Name),
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(diagnostics)
+	}
+}
@@ -19,20 +19,27 @@ package main
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
+	"expvar"
 	"flag"
 	"fmt"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/spf13/cobra"
 	"go.podman.io/image/v5/types"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsv1client "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8slabels "k8s.io/apimachinery/pkg/labels"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	apimachineryrand "k8s.io/apimachinery/pkg/util/rand"
@@ -40,6 +47,8 @@ import (
 	"k8s.io/client-go/discovery/cached/memory"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	"pkg.package-operator.run/boxcutter/managedcache"
@@ -47,12 +56,16 @@ import (
 	crcache "sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlconfig "sigs.k8s.io/controller-runtime/pkg/config"
 	crfinalizer "sigs.k8s.io/controller-runtime/pkg/finalizer"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	crwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	helmclient "github.com/operator-framework/helm-operator-plugins/pkg/client"
 
@@ -68,17 +81,27 @@ import (
 	"github.com/operator-framework/operator-controller/internal/operator-controller/controllers"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/features"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/finalizers"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/labels"
+	ocmetrics "github.com/operator-framework/operator-controller/internal/operator-controller/metrics"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/notify"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/resolve"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/preflights/crdupgradesafety"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/preflights/hook"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/preflights/ownership"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/preflights/podsecurity"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/preflights/rbacescalation"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/render"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/render/certproviders"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/render/registryv1"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/scheme"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/tracing"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/webhook"
 	sharedcontrollers "github.com/operator-framework/operator-controller/internal/shared/controllers"
 	cacheutil "github.com/operator-framework/operator-controller/internal/shared/util/cache"
 	fsutil "github.com/operator-framework/operator-controller/internal/shared/util/fs"
 	httputil "github.com/operator-framework/operator-controller/internal/shared/util/http"
 	imageutil "github.com/operator-framework/operator-controller/internal/shared/util/image"
+	"github.com/operator-framework/operator-controller/internal/shared/util/image/provenance"
 	"github.com/operator-framework/operator-controller/internal/shared/util/pullsecretcache"
 	sautil "github.com/operator-framework/operator-controller/internal/shared/util/sa"
 	"github.com/operator-framework/operator-controller/internal/shared/util/tlsprofiles"
@@ -92,18 +115,126 @@ var (
 	cfg                    = &config{}
 )
 
+// defaultUnpackTimeout is the amount of time a bundle unpack is allowed to
+// run before it is aborted, when not overridden by
+// ClusterExtension.spec.install.unpackTimeout.
+const defaultUnpackTimeout = 10 * time.Minute
+
+// defaultUnpackMaxConcurrentPulls is the number of bundle image pulls the unpack worker pool will
+// run at once, when not overridden by --unpack-max-concurrent-pulls.
+const defaultUnpackMaxConcurrentPulls = 5
+
+// defaultCatalogCacheMaxSizeBytes is the cap on total on-disk size of the catalog metadata
+// cache, when not overridden by --catalog-cache-max-size-bytes. A value of zero leaves the
+// cache unbounded.
+const defaultCatalogCacheMaxSizeBytes int64 = 0
+
+// defaultHelmMaxHistory is the maximum number of Helm release revisions
+// retained per ClusterExtension when not overridden by --helm-max-history.
+const defaultHelmMaxHistory = 10
+
+// defaultServiceAccountTokenExpiration is the validity duration requested for per-ClusterExtension
+// ServiceAccount tokens, when not overridden by --service-account-token-expiration.
+const defaultServiceAccountTokenExpiration = 1 * time.Hour
+
+// defaultClusterExtensionMaxConcurrentReconciles is the number of ClusterExtensions the
+// ClusterExtension controller will reconcile at once when not overridden by
+// --cluster-extension-max-concurrent-reconciles. Kept at 1 for compatibility with existing
+// deployments; operators installing many ClusterExtensions can raise it for higher throughput.
+const defaultClusterExtensionMaxConcurrentReconciles = 1
+
+// defaultHelmClientQPS and defaultHelmClientBurst are the rate limits applied
+// to the rest.Config used for per-ClusterExtension Helm actions, when not
+// overridden by --helm-client-qps and --helm-client-burst. client-go's own
+// defaults (5 QPS, 10 burst) are too conservative for bundles that apply
+// many objects in a single install/upgrade.
+const (
+	defaultHelmClientQPS   = 50
+	defaultHelmClientBurst = 100
+)
+
+// defaultLeaderElectionLeaseDuration, defaultLeaderElectionRenewDeadline, and
+// defaultGracefulShutdownTimeout are the recommended values for handling
+// kube-apiserver disruption; see
+// https://github.com/openshift/enhancements/blob/61581dcd985130357d6e4b0e72b87ee35394bf6e/CONVENTIONS.md#handling-kube-apiserver-disruption
+const (
+	defaultLeaderElectionLeaseDuration = 137 * time.Second
+	defaultLeaderElectionRenewDeadline = 107 * time.Second
+	defaultGracefulShutdownTimeout     = 30 * time.Second
+)
+
+// defaultCacheSyncTimeout matches controller-runtime's own default; it's
+// exposed as a flag so operators managing large or flaky clusters can raise
+// it if their controllers legitimately need longer to perform their initial
+// cache sync.
+const defaultCacheSyncTimeout = 2 * time.Minute
+
+// defaultKubeAPIQPS and defaultKubeAPIBurst match client-go's own defaults (rest.DefaultQPS,
+// rest.DefaultBurst). They're exposed as flags so operators fronted by a rate-limited or
+// otherwise constrained kube-apiserver can tune the manager's shared client (used for watches,
+// the reconcile loop's own reads/writes, and leader election) without a restart-free default
+// change affecting every deployment.
+const (
+	defaultKubeAPIQPS   = rest.DefaultQPS
+	defaultKubeAPIBurst = rest.DefaultBurst
+)
+
+// defaultClusterExtensionRetryBaseDelay and defaultClusterExtensionRetryMaxDelay are the
+// exponential backoff bounds for requeuing a failed ClusterExtension reconcile, when not
+// overridden by --cluster-extension-retry-base-delay and --cluster-extension-retry-max-delay.
+// They match workqueue.DefaultControllerRateLimiter()'s own exponential component, the rate
+// limiter controller-runtime uses when none is configured.
+const (
+	defaultClusterExtensionRetryBaseDelay = 5 * time.Millisecond
+	defaultClusterExtensionRetryMaxDelay  = 1000 * time.Second
+)
+
 type config struct {
-	metricsAddr          string
-	pprofAddr            string
-	certFile             string
-	keyFile              string
-	enableLeaderElection bool
-	probeAddr            string
-	cachePath            string
-	systemNamespace      string
-	catalogdCasDir       string
-	pullCasDir           string
-	globalPullSecret     string
+	metricsAddr                             string
+	pprofAddr                               string
+	certFile                                string
+	keyFile                                 string
+	enableLeaderElection                    bool
+	leaderElectionNamespace                 string
+	leaderElectionLeaseDuration             time.Duration
+	leaderElectionRenewDeadline             time.Duration
+	gracefulShutdownTimeout                 time.Duration
+	cacheSyncTimeout                        time.Duration
+	probeAddr                               string
+	cachePath                               string
+	unpackMaxConcurrentPulls                int
+	catalogCacheMaxSizeBytes                int64
+	systemNamespace                         string
+	catalogdCasDir                          string
+	pullCasDir                              string
+	globalPullSecret                        string
+	unpackTimeout                           time.Duration
+	helmMaxHistory                          int
+	helmClientQPS                           float32
+	helmClientBurst                         int
+	helmClientTimeout                       time.Duration
+	helmDisableOpenAPIValidation            bool
+	serviceAccountTokenAudiences            []string
+	serviceAccountTokenExpiration           time.Duration
+	webhookPort                             int
+	clusterExtensionMaxConcurrentReconciles int
+	clusterExtensionRetryBaseDelay          time.Duration
+	clusterExtensionRetryMaxDelay           time.Duration
+	kubeAPIQPS                              float32
+	kubeAPIBurst                            int
+	installNamespaceSelector                string
+	notifyWebhookURL                        string
+	notifyWebhookSlackFormat                bool
+	maintenanceMode                         bool
+	maintenanceModeConfigMap                string
+	clusterTrustBundleConfigMap             string
+	propagateProxyEnv                       bool
+	defaultNodeSelector                     map[string]string
+	defaultTolerations                      string
+	imageMirror                             map[string]string
+	otelOTLPEndpoint                        string
+	provenanceBuilderID                     string
+	provenanceSourceRepository              string
 }
 
 type reconcilerConfigurator interface {
@@ -111,24 +242,42 @@ type reconcilerConfigurator interface {
 }
 
 type boxcutterReconcilerConfigurator struct {
-	mgr                   manager.Manager
-	preflights            []applier.Preflight
-	regv1ManifestProvider applier.ManifestProvider
-	resolver              resolve.Resolver
-	imageCache            imageutil.Cache
-	imagePuller           imageutil.Puller
-	finalizers            crfinalizer.Finalizers
+	mgr                      manager.Manager
+	preflights               []applier.Preflight
+	regv1ManifestProvider    applier.ManifestProvider
+	resolver                 resolve.Resolver
+	imageCache               imageutil.Cache
+	imagePuller              imageutil.Puller
+	unpackQueue              *controllers.UnpackQueue
+	finalizers               crfinalizer.Finalizers
+	installNamespaceSelector k8slabels.Selector
+	maintenanceModeChecker   controllers.MaintenanceModeChecker
 }
 
 type helmReconcilerConfigurator struct {
-	mgr                   manager.Manager
-	preflights            []applier.Preflight
-	regv1ManifestProvider applier.ManifestProvider
-	resolver              resolve.Resolver
-	imageCache            imageutil.Cache
-	imagePuller           imageutil.Puller
-	finalizers            crfinalizer.Finalizers
-	watcher               cmcache.Watcher
+	mgr                      manager.Manager
+	preflights               []applier.Preflight
+	regv1ManifestProvider    applier.ManifestProvider
+	resolver                 resolve.Resolver
+	imageCache               imageutil.Cache
+	imagePuller              imageutil.Puller
+	unpackQueue              *controllers.UnpackQueue
+	finalizers               crfinalizer.Finalizers
+	watcher                  cmcache.Watcher
+	installNamespaceSelector k8slabels.Selector
+	maintenanceModeChecker   controllers.MaintenanceModeChecker
+}
+
+type gitOpsReconcilerConfigurator struct {
+	mgr                      manager.Manager
+	regv1ManifestProvider    applier.ManifestProvider
+	resolver                 resolve.Resolver
+	imageCache               imageutil.Cache
+	imagePuller              imageutil.Puller
+	unpackQueue              *controllers.UnpackQueue
+	finalizers               crfinalizer.Finalizers
+	installNamespaceSelector k8slabels.Selector
+	maintenanceModeChecker   controllers.MaintenanceModeChecker
 }
 
 const (
@@ -175,19 +324,56 @@ func init() {
 	flags.StringVar(&cfg.probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flags.StringVar(&cfg.catalogdCasDir, "catalogd-cas-dir", "", "The directory of TLS certificate authorities to use for verifying HTTPS connections to the Catalogd web service.")
 	flags.StringVar(&cfg.pullCasDir, "pull-cas-dir", "", "The directory of TLS certificate authorities to use for verifying HTTPS connections to image registries.")
-	flags.StringVar(&cfg.certFile, "tls-cert", "", "The certificate file used for the metrics server. Required to enable the metrics server. Requires tls-key.")
-	flags.StringVar(&cfg.keyFile, "tls-key", "", "The key file used for the metrics server. Required to enable the metrics server. Requires tls-cert")
+	flags.StringVar(&cfg.certFile, "tls-cert", "", "The certificate file used for the metrics server and, if the ClusterExtensionValidatingWebhook feature gate is enabled, the validating webhook server. Required to enable the metrics server. Requires tls-key.")
+	flags.StringVar(&cfg.keyFile, "tls-key", "", "The key file used for the metrics server and, if the ClusterExtensionValidatingWebhook feature gate is enabled, the validating webhook server. Required to enable the metrics server. Requires tls-cert")
+	flags.IntVar(&cfg.webhookPort, "webhook-server-port", 9443, "Webhook server port")
 	flags.BoolVar(&cfg.enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flags.StringVar(&cfg.leaderElectionNamespace, "leader-election-namespace", "", "The namespace in which the leader election resource will be created. If empty, defaults to the system namespace.")
+	flags.DurationVar(&cfg.leaderElectionLeaseDuration, "leader-election-lease-duration", defaultLeaderElectionLeaseDuration, "The duration that non-leader candidates will wait to force acquire leadership.")
+	flags.DurationVar(&cfg.leaderElectionRenewDeadline, "leader-election-renew-deadline", defaultLeaderElectionRenewDeadline, "The duration that the acting leader will retry refreshing leadership before giving up.")
+	flags.DurationVar(&cfg.gracefulShutdownTimeout, "graceful-shutdown-timeout", defaultGracefulShutdownTimeout, "The duration given to running controllers to stop before the manager exits. A value of 0 disables graceful shutdown, and a negative value means no timeout.")
+	flags.DurationVar(&cfg.cacheSyncTimeout, "cache-sync-timeout", defaultCacheSyncTimeout, "The time limit to wait for the controllers' caches to sync before timing out reconciliation.")
 	flags.StringVar(&cfg.cachePath, "cache-path", "/var/cache", "The local directory path used for filesystem based caching")
 	flags.StringVar(&cfg.systemNamespace, "system-namespace", "", "Configures the namespace that gets used to deploy system resources.")
 	flags.StringVar(&cfg.globalPullSecret, "global-pull-secret", "", "The <namespace>/<name> of the global pull secret that is going to be used to pull bundle images.")
+	flags.DurationVar(&cfg.unpackTimeout, "unpack-timeout", defaultUnpackTimeout, "The default maximum amount of time allowed for unpacking a ClusterExtension's bundle content, unless overridden by spec.install.unpackTimeout.")
+	flags.IntVar(&cfg.unpackMaxConcurrentPulls, "unpack-max-concurrent-pulls", defaultUnpackMaxConcurrentPulls, "The maximum number of bundle image pulls that may run at once in the background, independent of how many ClusterExtensions are being reconciled concurrently.")
+	flags.Int64Var(&cfg.catalogCacheMaxSizeBytes, "catalog-cache-max-size-bytes", defaultCatalogCacheMaxSizeBytes, "The maximum total size, in bytes, of cached catalog metadata kept on disk. Least-recently-used catalogs are evicted once this is exceeded. A value of 0 leaves the cache unbounded.")
+	flags.IntVar(&cfg.helmMaxHistory, "helm-max-history", defaultHelmMaxHistory, "The maximum number of Helm release revisions retained per ClusterExtension.")
+	flags.Float32Var(&cfg.helmClientQPS, "helm-client-qps", defaultHelmClientQPS, "The maximum queries-per-second of the Kubernetes client used for per-ClusterExtension Helm actions.")
+	flags.IntVar(&cfg.helmClientBurst, "helm-client-burst", defaultHelmClientBurst, "The maximum burst for throttling the Kubernetes client used for per-ClusterExtension Helm actions.")
+	flags.DurationVar(&cfg.helmClientTimeout, "helm-client-timeout", 0, "The request timeout of the Kubernetes client used for per-ClusterExtension Helm actions. Zero means no timeout is set.")
+	flags.StringSliceVar(&cfg.serviceAccountTokenAudiences, "service-account-token-audiences", nil, "The audiences to request for per-ClusterExtension ServiceAccount tokens. If unset, tokens are valid for the audience of the API server.")
+	flags.DurationVar(&cfg.serviceAccountTokenExpiration, "service-account-token-expiration", defaultServiceAccountTokenExpiration, "The validity duration to request for per-ClusterExtension ServiceAccount tokens. The token getter proactively refreshes a cached token once it's within 10% of this duration from expiring.")
+	flags.BoolVar(&cfg.helmDisableOpenAPIValidation, "helm-disable-openapi-validation", false, "Disable OpenAPI schema validation of rendered manifests during Helm install/upgrade.")
+	flags.StringVar(&cfg.installNamespaceSelector, "install-namespace-label-selector", "", "A label selector that a ClusterExtension's install namespace (spec.namespace) must match. ClusterExtensions targeting a namespace that doesn't match are rejected. If empty, any namespace is permitted.")
+	flags.StringVar(&cfg.notifyWebhookURL, "notify-webhook-url", "", "A webhook URL to POST lifecycle notifications to on UpgradeAvailable, InstallFailed, RolledBack, and Deprecated ClusterExtension status transitions. If empty, notifications are disabled.")
+	flags.BoolVar(&cfg.notifyWebhookSlackFormat, "notify-webhook-slack-format", false, "POST lifecycle notifications to notify-webhook-url as a Slack incoming-webhook compatible payload instead of raw JSON.")
+	flags.BoolVar(&cfg.maintenanceMode, "maintenance-mode", false, "Pause installs and upgrades for every ClusterExtension, for cluster maintenance windows. Status reporting and drift detection keep running. Combined with maintenance-mode-configmap: either source enables maintenance mode.")
+	flags.StringVar(&cfg.maintenanceModeConfigMap, "maintenance-mode-configmap", "", "The <namespace>/<name> of a ConfigMap whose \"maintenanceMode\" data key, when set to \"true\", pauses installs and upgrades for every ClusterExtension. Lets maintenance mode be toggled without restarting the controller. If empty, only the maintenance-mode flag applies.")
+	flags.StringVar(&cfg.clusterTrustBundleConfigMap, "cluster-trust-bundle-configmap", "", "The name of a ConfigMap, expected to exist in every ClusterExtension's install namespace with a ca-bundle.crt data key, that every rendered operator Deployment mounts as its trusted CA bundle, mirroring what OpenShift's OLMv0 does. If empty, no trust bundle is mounted.")
+	flags.BoolVar(&cfg.propagateProxyEnv, "propagate-proxy-env", false, "Propagate this controller's own HTTP_PROXY, HTTPS_PROXY, and NO_PROXY environment variables into every container of every rendered operator Deployment, mirroring what OpenShift's OLMv0 does, so operators work behind a MITM proxy.")
+	flags.StringToStringVar(&cfg.defaultNodeSelector, "default-node-selector", nil, "A default key=value node selector applied to every rendered operator Deployment's Pod template, so platform teams can steer every operator onto infra nodes in one place. A key the bundle's own Deployment spec already sets keeps the bundle's value. Can be specified multiple times.")
+	flags.StringVar(&cfg.defaultTolerations, "default-tolerations", "", "A JSON-encoded list of Tolerations applied to every rendered operator Deployment's Pod template, e.g. '[{\"key\":\"infra\",\"operator\":\"Exists\",\"effect\":\"NoSchedule\"}]'. A toleration the bundle's own Deployment spec already sets is not duplicated. If empty, no default tolerations are applied.")
+	flags.StringToStringVar(&cfg.imageMirror, "image-mirror", nil, "A source=target registry mirror mapping, e.g. quay.io=mirror.example.com/quay.io, matching the kubectl-olm mirror command's registries.conf output. Every rendered operator Deployment's container images and RELATED_IMAGE_* environment variables whose registry host matches a source are rewritten to the mirror, so operand images resolve disconnected too. Can be specified multiple times.")
+	flags.IntVar(&cfg.clusterExtensionMaxConcurrentReconciles, "cluster-extension-max-concurrent-reconciles", defaultClusterExtensionMaxConcurrentReconciles, "The maximum number of ClusterExtensions the ClusterExtension controller will reconcile concurrently.")
+	flags.DurationVar(&cfg.clusterExtensionRetryBaseDelay, "cluster-extension-retry-base-delay", defaultClusterExtensionRetryBaseDelay, "The initial delay before retrying a failed ClusterExtension reconcile. Doubles on each consecutive failure up to cluster-extension-retry-max-delay.")
+	flags.DurationVar(&cfg.clusterExtensionRetryMaxDelay, "cluster-extension-retry-max-delay", defaultClusterExtensionRetryMaxDelay, "The maximum delay between retries of a failed ClusterExtension reconcile.")
+	flags.Float32Var(&cfg.kubeAPIQPS, "kube-api-qps", defaultKubeAPIQPS, "The maximum queries-per-second of the Kubernetes client used by the manager, shared by the controller's watches, reads, and writes, and by leader election.")
+	flags.IntVar(&cfg.kubeAPIBurst, "kube-api-burst", defaultKubeAPIBurst, "The maximum burst for throttling the Kubernetes client used by the manager.")
+	flags.StringVar(&cfg.otelOTLPEndpoint, "otel-otlp-endpoint", "", "The host:port of an OTLP/gRPC collector to export ClusterExtension reconcile traces (resolve, unpack, render, preflight, and apply spans) to. If empty, tracing is disabled.")
+	flags.StringVar(&cfg.provenanceBuilderID, "provenance-builder-id", "", "When the ProvenancePreflight feature gate is enabled, the builder ID a bundle image's SLSA provenance attestation must match, e.g. a specific trusted CI/CD builder URI. If empty, the builder ID is not checked.")
+	flags.StringVar(&cfg.provenanceSourceRepository, "provenance-source-repository", "", "When the ProvenancePreflight feature gate is enabled, the source repository URI a bundle image's SLSA provenance attestation must match. If empty, the source repository is not checked.")
 
 	//adds version sub command
 	operatorControllerCmd.AddCommand(versionCommand)
 
 	//add klog flags to flagset
+	// -v sets the initial log verbosity, and can be raised or lowered at
+	// runtime (without a restart) via the metrics server's
+	// /debug/flags/v endpoint; see debugFlagsVHandler.
 	klog.InitFlags(flag.CommandLine)
 	flags.AddGoFlagSet(flag.CommandLine)
 
@@ -241,15 +427,30 @@ func run() error {
 		cfg.systemNamespace = podNamespace()
 	}
 
+	var installNamespaceSelector k8slabels.Selector
+	if cfg.installNamespaceSelector != "" {
+		parsedSelector, err := metav1.ParseToLabelSelector(cfg.installNamespaceSelector)
+		if err != nil {
+			setupLog.Error(err, "invalid install-namespace-label-selector")
+			return err
+		}
+		installNamespaceSelector, err = metav1.LabelSelectorAsSelector(parsedSelector)
+		if err != nil {
+			setupLog.Error(err, "invalid install-namespace-label-selector")
+			return err
+		}
+	}
+
 	setupLog.Info("set up manager")
 	cacheOptions := crcache.Options{
 		ByObject: map[client.Object]crcache.ByObject{
-			&ocv1.ClusterExtension{}:     {Label: k8slabels.Everything()},
-			&ocv1.ClusterCatalog{}:       {Label: k8slabels.Everything()},
-			&rbacv1.ClusterRole{}:        {Label: k8slabels.Everything()},
-			&rbacv1.ClusterRoleBinding{}: {Label: k8slabels.Everything()},
-			&rbacv1.Role{}:               {Namespaces: map[string]crcache.Config{}, Label: k8slabels.Everything()},
-			&rbacv1.RoleBinding{}:        {Namespaces: map[string]crcache.Config{}, Label: k8slabels.Everything()},
+			&ocv1.ClusterExtension{}:              {Label: k8slabels.Everything()},
+			&ocv1.ClusterExtensionUpgradePolicy{}: {Label: k8slabels.Everything()},
+			&ocv1.ClusterCatalog{}:                {Label: k8slabels.Everything()},
+			&rbacv1.ClusterRole{}:                 {Label: k8slabels.Everything()},
+			&rbacv1.ClusterRoleBinding{}:          {Label: k8slabels.Everything()},
+			&rbacv1.Role{}:                        {Namespaces: map[string]crcache.Config{}, Label: k8slabels.Everything()},
+			&rbacv1.RoleBinding{}:                 {Namespaces: map[string]crcache.Config{}, Label: k8slabels.Everything()},
 		},
 		DefaultNamespaces: map[string]crcache.Config{
 			cfg.systemNamespace: {LabelSelector: k8slabels.Everything()},
@@ -279,6 +480,9 @@ func run() error {
 		return err
 	}
 
+	// The metrics server is only served over TLS, with authn/authz enforced via
+	// FilterProvider (equivalent to kube-rbac-proxy), and certificates are
+	// reloaded from cfg.certFile/cfg.keyFile on change via certWatcher below.
 	metricsServerOptions := server.Options{}
 	if len(cfg.certFile) > 0 && len(cfg.keyFile) > 0 {
 		setupLog.Info("Starting metrics server with TLS enabled", "addr", cfg.metricsAddr, "tls-cert", cfg.certFile, "tls-key", cfg.keyFile)
@@ -286,6 +490,15 @@ func run() error {
 		metricsServerOptions.BindAddress = cfg.metricsAddr
 		metricsServerOptions.SecureServing = true
 		metricsServerOptions.FilterProvider = filters.WithAuthenticationAndAuthorization
+		metricsServerOptions.ExtraHandlers = map[string]http.Handler{
+			"/debug/flags/v": http.HandlerFunc(debugFlagsVHandler),
+			// expvar.Handler publishes the runtime's published variables
+			// (memstats, cmdline, and anything registered via expvar.Publish)
+			// as JSON, alongside the CPU/heap profiles already available via
+			// --pprof-bind-address, so both are reachable without exposing an
+			// unauthenticated port.
+			"/debug/vars": expvar.Handler(),
+		}
 
 		// If the certificate files change, the watcher will reload them.
 		var err error
@@ -324,21 +537,50 @@ func run() error {
 			"Metrics will not be served since the TLS certificate and key file are not provided.")
 	}
 
+	var webhookServer crwebhook.Server
+	if features.OperatorControllerFeatureGate.Enabled(features.ClusterExtensionValidatingWebhook) {
+		webhookServerOptions := crwebhook.Options{Port: cfg.webhookPort}
+		if len(cfg.certFile) > 0 && len(cfg.keyFile) > 0 {
+			if certWatcher == nil {
+				var err error
+				certWatcher, err = certwatcher.New(cfg.certFile, cfg.keyFile)
+				if err != nil {
+					setupLog.Error(err, "Failed to initialize certificate watcher")
+					return err
+				}
+			}
+			webhookServerOptions.TLSOpts = append(webhookServerOptions.TLSOpts, func(config *tls.Config) {
+				config.GetCertificate = certWatcher.GetCertificate
+				config.NextProtos = []string{"http/1.1"}
+			})
+		}
+		webhookServer = crwebhook.NewServer(webhookServerOptions)
+	}
+
 	restConfig := ctrl.GetConfigOrDie()
+	restConfig.QPS = cfg.kubeAPIQPS
+	restConfig.Burst = cfg.kubeAPIBurst
 	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
 		Scheme:                        scheme.Scheme,
 		Metrics:                       metricsServerOptions,
+		WebhookServer:                 webhookServer,
 		PprofBindAddress:              cfg.pprofAddr,
 		HealthProbeBindAddress:        cfg.probeAddr,
 		LeaderElection:                cfg.enableLeaderElection,
 		LeaderElectionID:              "9c4404e7.operatorframework.io",
+		LeaderElectionNamespace:       cfg.leaderElectionNamespace,
 		LeaderElectionReleaseOnCancel: true,
 		// Recommended Leader Election values
 		// https://github.com/openshift/enhancements/blob/61581dcd985130357d6e4b0e72b87ee35394bf6e/CONVENTIONS.md#handling-kube-apiserver-disruption
-		LeaseDuration: ptr.To(137 * time.Second),
-		RenewDeadline: ptr.To(107 * time.Second),
+		LeaseDuration: ptr.To(cfg.leaderElectionLeaseDuration),
+		RenewDeadline: ptr.To(cfg.leaderElectionRenewDeadline),
 		RetryPeriod:   ptr.To(26 * time.Second),
 
+		GracefulShutdownTimeout: ptr.To(cfg.gracefulShutdownTimeout),
+		Controller: ctrlconfig.Controller{
+			CacheSyncTimeout: cfg.cacheSyncTimeout,
+		},
+
 		Cache: cacheOptions,
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
@@ -357,6 +599,41 @@ func run() error {
 		return err
 	}
 
+	tp, err := tracing.NewTracerProvider(context.Background(), cfg.otelOTLPEndpoint, "operator-controller")
+	if err != nil {
+		setupLog.Error(err, "unable to set up OTel tracing")
+		return err
+	}
+	if tp != nil {
+		if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			return tp.Shutdown(context.Background())
+		})); err != nil {
+			setupLog.Error(err, "unable to add OTel tracer provider shutdown to manager")
+			return err
+		}
+	}
+
+	crmetrics.Registry.MustRegister(
+		ocmetrics.ClusterExtensionStatus,
+		ocmetrics.InstallsTotal,
+		ocmetrics.UpgradesTotal,
+		ocmetrics.AutomaticUpgradesTotal,
+		ocmetrics.RollbacksTotal,
+		ocmetrics.ResolutionFailuresTotal,
+		ocmetrics.UpgradeConstraintsBlockedTotal,
+		ocmetrics.InstalledVersionMissingFromCatalogTotal,
+		ocmetrics.ReconcileStageSkippedTotal,
+		ocmetrics.ReconcileStageExecutedTotal,
+		ocmetrics.ManagedContentInformersActive,
+		ocmetrics.ClusterExtensionConditionReason,
+		imageutil.CacheHitsTotal,
+		imageutil.CacheMissesTotal,
+		imageutil.CacheGarbageCollectionsTotal,
+		imageutil.CacheEntries,
+		imageutil.CacheBytes,
+	)
+
 	cpwCatalogd, err := httputil.NewCertPoolWatcher(cfg.catalogdCasDir, ctrl.Log.WithName("catalogd-ca-pool"))
 	if err != nil {
 		setupLog.Error(err, "unable to create catalogd-ca-pool watcher")
@@ -388,17 +665,71 @@ func run() error {
 		}
 	}
 
-	if err := fsutil.EnsureEmptyDirectory(cfg.cachePath, 0700); err != nil {
-		setupLog.Error(err, "unable to ensure empty cache directory")
+	if features.OperatorControllerFeatureGate.Enabled(features.ClusterExtensionValidatingWebhook) {
+		if err = (&webhook.ClusterExtension{
+			Client:                        mgr.GetClient(),
+			InstallNamespaceLabelSelector: installNamespaceSelector,
+		}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ClusterExtension")
+			return err
+		}
+
+		if err = webhook.SetupClusterExtensionConversionWebhook(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ClusterExtension", "type", "conversion")
+			return err
+		}
+	}
+
+	// Report what was left on disk by the previous run before wiping it, so operators sizing the
+	// volume backing cfg.cachePath can see actual usage instead of only ever observing an empty
+	// cache immediately after every restart.
+	if unpackBytes, err := fsutil.DirectorySize(filepath.Join(cfg.cachePath, "unpack")); err != nil {
+		setupLog.Error(err, "unable to measure unpack cache usage from previous run")
+	} else {
+		setupLog.Info("unpack cache usage from previous run", "bytesOnDisk", unpackBytes)
+	}
+
+	// Only the unpack cache is wiped on startup; it's repopulated on demand and isn't worth
+	// reusing across restarts. The catalog metadata cache, handled separately below, is left
+	// alone so a restart can reuse catalogs it already pulled.
+	if err := fsutil.EnsureEmptyDirectory(filepath.Join(cfg.cachePath, "unpack"), 0700); err != nil {
+		setupLog.Error(err, "unable to ensure empty unpack cache directory")
 		return err
 	}
 
 	imageCache := imageutil.BundleCache(filepath.Join(cfg.cachePath, "unpack"))
+
+	var (
+		workloadIdentityOnce sync.Once
+		workloadIdentityConf string
+	)
+	registriesConfPath := func(ctx context.Context) string {
+		workloadIdentityOnce.Do(func() {
+			if !features.OperatorControllerFeatureGate.Enabled(features.WorkloadIdentityRegistryAuth) {
+				return
+			}
+			logger := log.FromContext(ctx)
+			helpers := imageutil.DetectWorkloadIdentityCredentialHelpers()
+			if len(helpers) == 0 {
+				logger.Info("no workload identity credential helpers found on PATH, skipping")
+				return
+			}
+			path := filepath.Join(cfg.cachePath, "workload-identity-registries.conf")
+			if err := imageutil.WriteWorkloadIdentityRegistriesConf(path, helpers); err != nil {
+				logger.Error(err, "failed to configure workload identity credential helpers")
+				return
+			}
+			logger.Info("configured workload identity credential helpers", "helpers", helpers)
+			workloadIdentityConf = path
+		})
+		return workloadIdentityConf
+	}
 	imagePuller := &imageutil.ContainersImagePuller{
 		SourceCtxFunc: func(ctx context.Context) (*types.SystemContext, error) {
 			srcContext := &types.SystemContext{
-				DockerCertPath: cfg.pullCasDir,
-				OCICertPath:    cfg.pullCasDir,
+				DockerCertPath:           cfg.pullCasDir,
+				OCICertPath:              cfg.pullCasDir,
+				SystemRegistriesConfPath: registriesConfPath(ctx),
 			}
 			logger := log.FromContext(ctx)
 			if _, err := os.Stat(authFilePath); err == nil {
@@ -412,6 +743,15 @@ func run() error {
 			return srcContext, nil
 		},
 	}
+	if features.OperatorControllerFeatureGate.Enabled(features.ProvenancePreflight) {
+		imagePuller.ProvenanceFetcher = &provenance.CosignTagFetcher{}
+		imagePuller.ProvenancePolicy = provenance.Policy{
+			BuilderID:        cfg.provenanceBuilderID,
+			SourceRepository: cfg.provenanceSourceRepository,
+		}
+	}
+
+	unpackQueue := controllers.NewUnpackQueue(imagePuller, imageCache, cfg.unpackMaxConcurrentPulls)
 
 	clusterExtensionFinalizers := crfinalizer.NewFinalizers()
 	if err := clusterExtensionFinalizers.Register(controllers.ClusterExtensionCleanupUnpackCacheFinalizer, finalizers.FinalizerFunc(func(ctx context.Context, obj client.Object) (crfinalizer.Result, error) {
@@ -423,12 +763,28 @@ func run() error {
 
 	cl := mgr.GetClient()
 
+	maintenanceModeChecker := controllers.AnyMaintenanceModeChecker{
+		controllers.StaticMaintenanceModeChecker(cfg.maintenanceMode),
+	}
+	if cfg.maintenanceModeConfigMap != "" {
+		configMapParts := strings.Split(cfg.maintenanceModeConfigMap, "/")
+		if len(configMapParts) != 2 {
+			err := fmt.Errorf("incorrect number of components")
+			setupLog.Error(err, "Value of maintenance-mode-configmap should be of the format <namespace>/<name>")
+			return err
+		}
+		maintenanceModeChecker = append(maintenanceModeChecker, controllers.ConfigMapMaintenanceModeChecker{
+			Client:       cl,
+			ConfigMapKey: client.ObjectKey{Namespace: configMapParts[0], Name: configMapParts[1]},
+		})
+	}
+
 	catalogsCachePath := filepath.Join(cfg.cachePath, "catalogs")
 	if err := os.MkdirAll(catalogsCachePath, 0700); err != nil {
 		setupLog.Error(err, "unable to create catalogs cache directory")
 		return err
 	}
-	catalogClientBackend := cache.NewFilesystemCache(catalogsCachePath)
+	catalogClientBackend := cache.NewFilesystemCache(catalogsCachePath, cache.WithMaxCacheSizeBytes(cfg.catalogCacheMaxSizeBytes))
 	catalogClient := catalogclient.New(catalogClientBackend, func() (*http.Client, error) {
 		return httputil.BuildHTTPClient(cpwCatalogd)
 	})
@@ -447,6 +803,25 @@ func run() error {
 		Validations: []resolve.ValidationFunc{
 			resolve.NoDependencyValidation,
 		},
+		NamespaceCatalogSelectorFunc: controllers.NamespaceCatalogSelector(cl),
+		EdgeOverridesFunc: func(ctx context.Context, packageName string) ([]ocv1.UpgradeEdgeOverride, error) {
+			var overrides ocv1.ClusterExtensionUpgradeEdgeOverrideList
+			if err := cl.List(ctx, &overrides); err != nil {
+				return nil, err
+			}
+			var edges []ocv1.UpgradeEdgeOverride
+			for _, override := range overrides.Items {
+				if override.Spec.PackageName != packageName {
+					continue
+				}
+				if !apimeta.IsStatusConditionTrue(override.Status.Conditions, ocv1.TypeValid) {
+					continue
+				}
+				edges = append(edges, override.Spec.Edges...)
+			}
+			return edges, nil
+		},
+		ForceSemverUpgradeConstraints: features.OperatorControllerFeatureGate.Enabled(features.ForceSemverUpgradeConstraints),
 	}
 
 	aeClient, err := apiextensionsv1client.NewForConfig(mgr.GetConfig())
@@ -458,14 +833,41 @@ func run() error {
 	preflights := []applier.Preflight{
 		crdupgradesafety.NewPreflight(aeClient.CustomResourceDefinitions()),
 	}
+	if features.OperatorControllerFeatureGate.Enabled(features.PodSecurityPreflight) {
+		coreClient, err := corev1client.NewForConfig(mgr.GetConfig())
+		if err != nil {
+			setupLog.Error(err, "unable to create core client")
+			return err
+		}
+		preflights = append(preflights, podsecurity.NewPreflight(coreClient.Namespaces()))
+	}
+	if features.OperatorControllerFeatureGate.Enabled(features.OwnershipConflictPreflight) {
+		preflights = append(preflights, ownership.NewPreflight(cl))
+	}
+	if features.OperatorControllerFeatureGate.Enabled(features.RBACEscalationPreflight) {
+		preflights = append(preflights, rbacescalation.NewPreflight())
+	}
+	if features.OperatorControllerFeatureGate.Enabled(features.PreUpgradeHookPreflight) {
+		preflights = append(preflights, hook.NewPreflight(cl))
+	}
 
 	var ctrlBuilderOpts []controllers.ControllerBuilderOption
 	if features.OperatorControllerFeatureGate.Enabled(features.BoxcutterRuntime) {
 		ctrlBuilderOpts = append(ctrlBuilderOpts, controllers.WithOwns(&ocv1.ClusterExtensionRevision{}))
 	}
+	ctrlBuilderOpts = append(ctrlBuilderOpts, controllers.WithMaxConcurrentReconciles(cfg.clusterExtensionMaxConcurrentReconciles))
+	ctrlBuilderOpts = append(ctrlBuilderOpts, controllers.WithRateLimiter(workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](cfg.clusterExtensionRetryBaseDelay, cfg.clusterExtensionRetryMaxDelay)))
+
+	var notifier notify.Notifier
+	if cfg.notifyWebhookURL != "" {
+		notifier = notify.NewWebhookNotifier(cfg.notifyWebhookURL, cfg.notifyWebhookSlackFormat)
+	}
 
 	ceReconciler := &controllers.ClusterExtensionReconciler{
-		Client: cl,
+		Client:         cl,
+		Notifier:       notifier,
+		RetryBaseDelay: cfg.clusterExtensionRetryBaseDelay,
+		RetryMaxDelay:  cfg.clusterExtensionRetryMaxDelay,
 	}
 	ceController, err := ceReconciler.SetupWithManager(mgr, ctrlBuilderOpts...)
 	if err != nil {
@@ -474,33 +876,65 @@ func run() error {
 	}
 
 	certProvider := getCertificateProvider()
+	var proxyEnv []corev1.EnvVar
+	if cfg.propagateProxyEnv {
+		proxyEnv = getProxyEnv()
+	}
+	defaultTolerations, err := parseDefaultTolerations(cfg.defaultTolerations)
+	if err != nil {
+		setupLog.Error(err, "invalid value for default-tolerations")
+		return err
+	}
 	regv1ManifestProvider := &applier.RegistryV1ManifestProvider{
 		BundleRenderer:              registryv1.Renderer,
 		CertificateProvider:         certProvider,
 		IsWebhookSupportEnabled:     certProvider != nil,
 		IsSingleOwnNamespaceEnabled: features.OperatorControllerFeatureGate.Enabled(features.SingleOwnNamespaceInstallSupport),
+		TrustedCABundleConfigMap:    cfg.clusterTrustBundleConfigMap,
+		ProxyEnv:                    proxyEnv,
+		DefaultNodeSelector:         cfg.defaultNodeSelector,
+		DefaultTolerations:          defaultTolerations,
+		ImageMirror:                 cfg.imageMirror,
 	}
 	var cerCfg reconcilerConfigurator
 	if features.OperatorControllerFeatureGate.Enabled(features.BoxcutterRuntime) {
 		cerCfg = &boxcutterReconcilerConfigurator{
-			mgr:                   mgr,
-			preflights:            preflights,
-			regv1ManifestProvider: regv1ManifestProvider,
-			resolver:              resolver,
-			imageCache:            imageCache,
-			imagePuller:           imagePuller,
-			finalizers:            clusterExtensionFinalizers,
+			mgr:                      mgr,
+			preflights:               preflights,
+			regv1ManifestProvider:    regv1ManifestProvider,
+			resolver:                 resolver,
+			imageCache:               imageCache,
+			imagePuller:              imagePuller,
+			unpackQueue:              unpackQueue,
+			finalizers:               clusterExtensionFinalizers,
+			installNamespaceSelector: installNamespaceSelector,
+			maintenanceModeChecker:   maintenanceModeChecker,
+		}
+	} else if features.OperatorControllerFeatureGate.Enabled(features.GitOpsManifestExport) {
+		cerCfg = &gitOpsReconcilerConfigurator{
+			mgr:                      mgr,
+			regv1ManifestProvider:    regv1ManifestProvider,
+			resolver:                 resolver,
+			imageCache:               imageCache,
+			imagePuller:              imagePuller,
+			unpackQueue:              unpackQueue,
+			finalizers:               clusterExtensionFinalizers,
+			installNamespaceSelector: installNamespaceSelector,
+			maintenanceModeChecker:   maintenanceModeChecker,
 		}
 	} else {
 		cerCfg = &helmReconcilerConfigurator{
-			mgr:                   mgr,
-			preflights:            preflights,
-			regv1ManifestProvider: regv1ManifestProvider,
-			resolver:              resolver,
-			imageCache:            imageCache,
-			imagePuller:           imagePuller,
-			finalizers:            clusterExtensionFinalizers,
-			watcher:               ceController,
+			mgr:                      mgr,
+			preflights:               preflights,
+			regv1ManifestProvider:    regv1ManifestProvider,
+			resolver:                 resolver,
+			imageCache:               imageCache,
+			imagePuller:              imagePuller,
+			unpackQueue:              unpackQueue,
+			finalizers:               clusterExtensionFinalizers,
+			watcher:                  ceController,
+			installNamespaceSelector: installNamespaceSelector,
+			maintenanceModeChecker:   maintenanceModeChecker,
 		}
 	}
 	if err := cerCfg.Configure(ceReconciler); err != nil {
@@ -517,6 +951,20 @@ func run() error {
 		return err
 	}
 
+	if err = (&controllers.ClusterExtensionUpgradePolicyReconciler{
+		Client: cl,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterExtensionUpgradePolicy")
+		return err
+	}
+
+	if err = (&controllers.ClusterExtensionUpgradeEdgeOverrideReconciler{
+		Client: cl,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterExtensionUpgradeEdgeOverride")
+		return err
+	}
+
 	setupLog.Info("creating SecretSyncer controller for watching secret", "Secret", cfg.globalPullSecret)
 	err = (&sharedcontrollers.PullSecretReconciler{
 		Client:            mgr.GetClient(),
@@ -535,10 +983,35 @@ func run() error {
 		setupLog.Error(err, "unable to set up health check")
 		return err
 	}
-	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+	// readyz reports whether the controller's dependencies are usable, so a
+	// stuck rollout is diagnosable from `kubectl get --raw /readyz?verbose=1`
+	// without digging through logs. These are readyz (not healthz) checks:
+	// a dependency outage should hold the controller out of the endpoints
+	// list, not restart it.
+	if err := mgr.AddReadyzCheck("ping", healthz.Ping); err != nil {
+		setupLog.Error(err, "unable to set up ready check")
+		return err
+	}
+	if err := mgr.AddReadyzCheck("apiserver", apiServerReadyzCheck(cl)); err != nil {
+		setupLog.Error(err, "unable to set up ready check")
+		return err
+	}
+	if err := mgr.AddReadyzCheck("catalogd", catalogdReadyzCheck(cl)); err != nil {
 		setupLog.Error(err, "unable to set up ready check")
 		return err
 	}
+	if err := mgr.AddReadyzCheck("catalog-cache", catalogCacheReadyzCheck(catalogsCachePath)); err != nil {
+		setupLog.Error(err, "unable to set up ready check")
+		return err
+	}
+
+	// /debug/clusterextensions/<name> productizes what an e2e failure's
+	// artifact collection scrapes by hand into an always-available dump of
+	// one ClusterExtension's diagnostic state, so a stuck install can be
+	// triaged from `kubectl get --raw` without a live debugging session.
+	if metricsServerOptions.ExtraHandlers != nil {
+		metricsServerOptions.ExtraHandlers["/debug/clusterextensions/"] = diagnosticsHandler(cl, filepath.Join(cfg.cachePath, "unpack"))
+	}
 
 	setupLog.Info("starting manager")
 	ctx := ctrl.SetupSignalHandler()
@@ -553,11 +1026,41 @@ func run() error {
 	return nil
 }
 
+// getProxyEnv returns the controller's own HTTP_PROXY, HTTPS_PROXY, and NO_PROXY environment
+// variables (and their lowercase equivalents), for propagation into rendered operator
+// Deployments when cfg.propagateProxyEnv is set. A variable that isn't set in the controller's
+// own environment is omitted rather than propagated as empty.
+func getProxyEnv() []corev1.EnvVar {
+	var env []corev1.EnvVar
+	for _, name := range []string{"HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy"} {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, corev1.EnvVar{Name: name, Value: value})
+		}
+	}
+	return env
+}
+
+// parseDefaultTolerations unmarshals raw, the value of the default-tolerations flag, as a JSON
+// list of corev1.Tolerations. An empty raw value is not an error; it simply means no default
+// tolerations are configured.
+func parseDefaultTolerations(raw string) ([]corev1.Toleration, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	var tolerations []corev1.Toleration
+	if err := json.Unmarshal([]byte(raw), &tolerations); err != nil {
+		return nil, fmt.Errorf("parsing default-tolerations as a JSON list of Tolerations: %w", err)
+	}
+	return tolerations, nil
+}
+
 func getCertificateProvider() render.CertificateProvider {
 	if features.OperatorControllerFeatureGate.Enabled(features.WebhookProviderCertManager) {
 		return certproviders.CertManagerCertificateProvider{}
 	} else if features.OperatorControllerFeatureGate.Enabled(features.WebhookProviderOpenshiftServiceCA) {
 		return certproviders.OpenshiftServiceCaCertificateProvider{}
+	} else if features.OperatorControllerFeatureGate.Enabled(features.WebhookProviderSelfSigned) {
+		return &certproviders.SelfSignedCertificateProvider{}
 	}
 	return nil
 }
@@ -625,14 +1128,26 @@ func (c *boxcutterReconcilerConfigurator) Configure(ceReconciler *controllers.Cl
 		ActionClientGetter: acg,
 		RevisionGenerator:  rg,
 	}
-	ceReconciler.ReconcileSteps = []controllers.ReconcileStepFunc{
+	reconcileSteps := []controllers.ReconcileStepFunc{
 		controllers.HandleFinalizers(c.finalizers),
+		controllers.CheckInstallNamespacePolicy(c.mgr.GetClient(), c.installNamespaceSelector),
+		controllers.CheckDependencies(c.mgr.GetClient()),
+	}
+	if features.OperatorControllerFeatureGate.Enabled(features.OLMv0ConflictPreflight) {
+		reconcileSteps = append(reconcileSteps, controllers.CheckOLMv0PackageConflict(c.mgr.GetClient()))
+	}
+	if features.OperatorControllerFeatureGate.Enabled(features.PackageSingletonPreflight) {
+		reconcileSteps = append(reconcileSteps, controllers.CheckPackageSingleton(c.mgr.GetClient()))
+	}
+	reconcileSteps = append(reconcileSteps,
 		controllers.MigrateStorage(storageMigrator),
 		controllers.RetrieveRevisionStates(revisionStatesGetter),
-		controllers.ResolveBundle(c.resolver, c.mgr.GetClient()),
-		controllers.UnpackBundle(c.imagePuller, c.imageCache),
-		controllers.ApplyBundleWithBoxcutter(appl.Apply),
-	}
+		controllers.TraceStage("resolve", controllers.ResolveBundle(c.resolver, c.mgr.GetClient())),
+		controllers.CheckMaintenanceMode(c.maintenanceModeChecker),
+		controllers.TraceStage("unpack", controllers.UnpackBundleAsync(c.unpackQueue, cfg.unpackTimeout)),
+		controllers.TraceStage("apply", controllers.ApplyBundleWithBoxcutter(appl.Apply)),
+	)
+	ceReconciler.ReconcileSteps = reconcileSteps
 
 	baseDiscoveryClient, err := discovery.NewDiscoveryClientForConfig(c.mgr.GetConfig())
 	if err != nil {
@@ -660,7 +1175,7 @@ func (c *boxcutterReconcilerConfigurator) Configure(ceReconciler *controllers.Cl
 	if err != nil {
 		return fmt.Errorf("unable to create client for ClusterExtensionRevision controller: %w", err)
 	}
-	cerTokenGetter := authentication.NewTokenGetter(cerCoreClient, authentication.WithExpirationDuration(1*time.Hour))
+	cerTokenGetter := authentication.NewTokenGetter(cerCoreClient, authentication.WithExpirationDuration(cfg.serviceAccountTokenExpiration), authentication.WithAudiences(cfg.serviceAccountTokenAudiences))
 
 	revisionEngineFactory, err := controllers.NewDefaultRevisionEngineFactory(
 		c.mgr.GetScheme(),
@@ -690,13 +1205,18 @@ func (c *helmReconcilerConfigurator) Configure(ceReconciler *controllers.Cluster
 	if err != nil {
 		return fmt.Errorf("unable to create core client: %w", err)
 	}
-	tokenGetter := authentication.NewTokenGetter(coreClient, authentication.WithExpirationDuration(1*time.Hour))
-	clientRestConfigMapper := action.ServiceAccountRestConfigMapper(tokenGetter)
+	tokenGetter := authentication.NewTokenGetter(coreClient, authentication.WithExpirationDuration(cfg.serviceAccountTokenExpiration), authentication.WithAudiences(cfg.serviceAccountTokenAudiences))
+	clientRestConfigMapper := action.UserImpersonationRestConfigMapper(action.ServiceAccountRestConfigMapper(tokenGetter))
 	if features.OperatorControllerFeatureGate.Enabled(features.SyntheticPermissions) {
 		clientRestConfigMapper = action.SyntheticUserRestConfigMapper(clientRestConfigMapper)
 	}
 
-	cfgGetter, err := helmclient.NewActionConfigGetter(c.mgr.GetConfig(), c.mgr.GetRESTMapper(),
+	helmRestConfig := rest.CopyConfig(c.mgr.GetConfig())
+	helmRestConfig.QPS = cfg.helmClientQPS
+	helmRestConfig.Burst = cfg.helmClientBurst
+	helmRestConfig.Timeout = cfg.helmClientTimeout
+
+	cfgGetter, err := helmclient.NewActionConfigGetter(helmRestConfig, c.mgr.GetRESTMapper(),
 		helmclient.StorageDriverMapper(action.ChunkedStorageDriverMapper(coreClient, c.mgr.GetAPIReader(), cfg.systemNamespace)),
 		helmclient.ClientNamespaceMapper(func(obj client.Object) (string, error) {
 			ext := obj.(*ocv1.ClusterExtension)
@@ -743,15 +1263,115 @@ func (c *helmReconcilerConfigurator) Configure(ceReconciler *controllers.Cluster
 		PreAuthorizer:                 preAuth,
 		Watcher:                       c.watcher,
 		Manager:                       cm,
+		MaxHistory:                    cfg.helmMaxHistory,
+		Client:                        c.mgr.GetClient(),
+		DisableOpenAPIValidation:      cfg.helmDisableOpenAPIValidation,
 	}
+
+	err = c.finalizers.Register(controllers.ClusterExtensionCleanupReleaseFinalizer, finalizers.FinalizerFunc(func(ctx context.Context, obj client.Object) (crfinalizer.Result, error) {
+		ext := obj.(*ocv1.ClusterExtension)
+		return crfinalizer.Result{}, appl.Uninstall(ctx, ext)
+	}))
+	if err != nil {
+		setupLog.Error(err, "unable to register release cleanup finalizer")
+		return err
+	}
+
+	err = c.finalizers.Register(controllers.ClusterExtensionCleanupInstallNamespaceFinalizer, finalizers.FinalizerFunc(func(ctx context.Context, obj client.Object) (crfinalizer.Result, error) {
+		ext := obj.(*ocv1.ClusterExtension)
+		if ext.Spec.Install == nil || ext.Spec.Install.CreateNamespace == nil ||
+			ext.Spec.Install.CreateNamespace.DeletionPolicy != ocv1.NamespaceDeletionPolicyDelete {
+			return crfinalizer.Result{}, nil
+		}
+		ns := &corev1.Namespace{}
+		if err := c.mgr.GetClient().Get(ctx, client.ObjectKey{Name: ext.Spec.Namespace}, ns); err != nil {
+			if apierrors.IsNotFound(err) {
+				return crfinalizer.Result{}, nil
+			}
+			return crfinalizer.Result{}, err
+		}
+		// Only delete the namespace this ClusterExtension created, never one
+		// that pre-dated it and was merely reused.
+		if ns.Labels[labels.OwnerKindKey] != ocv1.ClusterExtensionKind || ns.Labels[labels.OwnerNameKey] != ext.GetName() {
+			return crfinalizer.Result{}, nil
+		}
+		if err := c.mgr.GetClient().Delete(ctx, ns); err != nil && !apierrors.IsNotFound(err) {
+			return crfinalizer.Result{}, err
+		}
+		return crfinalizer.Result{}, nil
+	}))
+	if err != nil {
+		setupLog.Error(err, "unable to register install namespace cleanup finalizer")
+		return err
+	}
+
 	revisionStatesGetter := &controllers.HelmRevisionStatesGetter{ActionClientGetter: acg}
-	ceReconciler.ReconcileSteps = []controllers.ReconcileStepFunc{
+	skipCache := controllers.NewSkipCache()
+	reconcileSteps := []controllers.ReconcileStepFunc{
 		controllers.HandleFinalizers(c.finalizers),
+		controllers.CheckInstallNamespacePolicy(c.mgr.GetClient(), c.installNamespaceSelector),
+		controllers.CheckDependencies(c.mgr.GetClient()),
+	}
+	if features.OperatorControllerFeatureGate.Enabled(features.OLMv0ConflictPreflight) {
+		reconcileSteps = append(reconcileSteps, controllers.CheckOLMv0PackageConflict(c.mgr.GetClient()))
+	}
+	if features.OperatorControllerFeatureGate.Enabled(features.PackageSingletonPreflight) {
+		reconcileSteps = append(reconcileSteps, controllers.CheckPackageSingleton(c.mgr.GetClient()))
+	}
+	reconcileSteps = append(reconcileSteps,
 		controllers.RetrieveRevisionStates(revisionStatesGetter),
-		controllers.ResolveBundle(c.resolver, c.mgr.GetClient()),
-		controllers.UnpackBundle(c.imagePuller, c.imageCache),
-		controllers.ApplyBundle(appl),
+		controllers.TraceStage("resolve", controllers.ResolveBundle(c.resolver, c.mgr.GetClient())),
+		controllers.CheckMaintenanceMode(c.maintenanceModeChecker),
+		controllers.SkipUnchangedBundle(appl, skipCache),
+		controllers.TraceStage("unpack", controllers.UnpackBundleAsync(c.unpackQueue, cfg.unpackTimeout)),
+		controllers.TraceStage("apply", controllers.ApplyBundle(appl, controllers.WithSkipCache(skipCache))),
+	)
+	ceReconciler.ReconcileSteps = reconcileSteps
+
+	return nil
+}
+
+// Configure wires a ClusterExtension reconciler that exports a resolved bundle's rendered
+// manifests into a ConfigMap instead of applying them to the cluster, for a GitOps tool to sync
+// onward. It needs none of the Helm runtime's action-client or content-manager machinery, since
+// it never creates the bundle's own objects itself.
+func (c *gitOpsReconcilerConfigurator) Configure(ceReconciler *controllers.ClusterExtensionReconciler) error {
+	appl := &applier.GitOpsExport{
+		ManifestProvider: c.regv1ManifestProvider,
+		Client:           c.mgr.GetClient(),
+	}
+
+	err := c.finalizers.Register(controllers.ClusterExtensionCleanupReleaseFinalizer, finalizers.FinalizerFunc(func(ctx context.Context, obj client.Object) (crfinalizer.Result, error) {
+		ext := obj.(*ocv1.ClusterExtension)
+		return crfinalizer.Result{}, appl.Uninstall(ctx, ext)
+	}))
+	if err != nil {
+		setupLog.Error(err, "unable to register release cleanup finalizer")
+		return err
+	}
+
+	revisionStatesGetter := &controllers.GitOpsRevisionStatesGetter{Client: c.mgr.GetClient()}
+	skipCache := controllers.NewSkipCache()
+	reconcileSteps := []controllers.ReconcileStepFunc{
+		controllers.HandleFinalizers(c.finalizers),
+		controllers.CheckInstallNamespacePolicy(c.mgr.GetClient(), c.installNamespaceSelector),
+		controllers.CheckDependencies(c.mgr.GetClient()),
+	}
+	if features.OperatorControllerFeatureGate.Enabled(features.OLMv0ConflictPreflight) {
+		reconcileSteps = append(reconcileSteps, controllers.CheckOLMv0PackageConflict(c.mgr.GetClient()))
+	}
+	if features.OperatorControllerFeatureGate.Enabled(features.PackageSingletonPreflight) {
+		reconcileSteps = append(reconcileSteps, controllers.CheckPackageSingleton(c.mgr.GetClient()))
 	}
+	reconcileSteps = append(reconcileSteps,
+		controllers.RetrieveRevisionStates(revisionStatesGetter),
+		controllers.TraceStage("resolve", controllers.ResolveBundle(c.resolver, c.mgr.GetClient())),
+		controllers.CheckMaintenanceMode(c.maintenanceModeChecker),
+		controllers.SkipUnchangedBundle(appl, skipCache),
+		controllers.TraceStage("unpack", controllers.UnpackBundleAsync(c.unpackQueue, cfg.unpackTimeout)),
+		controllers.TraceStage("apply", controllers.ApplyBundle(appl, controllers.WithSkipCache(skipCache))),
+	)
+	ceReconciler.ReconcileSteps = reconcileSteps
 
 	return nil
 }
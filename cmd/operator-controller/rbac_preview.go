@@ -0,0 +1,113 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apiserver/pkg/authentication/user"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/authorization"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/scheme"
+)
+
+var rbacPreviewFlags struct {
+	manifestPath       string
+	namespace          string
+	serviceAccountName string
+}
+
+// rbacPreviewCmd computes the least-privilege RBAC a ServiceAccount needs to
+// install a bundle's rendered manifest, so that it can be provisioned ahead
+// of ever creating a ClusterExtension, instead of by trial-and-error. It
+// works by pre-authorizing the ServiceAccount - which doesn't need to exist
+// yet - against the currently configured cluster and reporting every rule
+// it's missing: for a ServiceAccount with no RBAC bindings at all, that's
+// every rule it needs.
+var rbacPreviewCmd = &cobra.Command{
+	Use:   "rbac-preview",
+	Short: "Print the Role/ClusterRole YAML a ServiceAccount needs to install a bundle's rendered manifest",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runRBACPreview(cmd.Context())
+	},
+}
+
+func init() {
+	flags := rbacPreviewCmd.Flags()
+	flags.StringVar(&rbacPreviewFlags.manifestPath, "manifest", "", "Path to the bundle's rendered manifest YAML. Use '-' to read from stdin.")
+	flags.StringVar(&rbacPreviewFlags.namespace, "namespace", "", "The namespace of the ServiceAccount to preview permissions for.")
+	flags.StringVar(&rbacPreviewFlags.serviceAccountName, "service-account", "", "The name of the ServiceAccount to preview permissions for.")
+	for _, name := range []string{"manifest", "namespace", "service-account"} {
+		if err := rbacPreviewCmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+
+	operatorControllerCmd.AddCommand(rbacPreviewCmd)
+}
+
+func runRBACPreview(ctx context.Context) error {
+	manifest, err := openManifest(rbacPreviewFlags.manifestPath)
+	if err != nil {
+		return err
+	}
+	defer manifest.Close()
+
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("loading kubeconfig: %w", err)
+	}
+	cl, err := client.New(restConfig, client.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		return fmt.Errorf("building client: %w", err)
+	}
+
+	manifestManager := &user.DefaultInfo{
+		Name: fmt.Sprintf("system:serviceaccount:%s:%s", rbacPreviewFlags.namespace, rbacPreviewFlags.serviceAccountName),
+	}
+	missingRules, err := authorization.NewRBACPreAuthorizer(cl).PreAuthorize(ctx, manifestManager, manifest)
+	if err != nil {
+		return fmt.Errorf("computing required permissions: %w", err)
+	}
+
+	docs, err := authorization.RenderMissingRulesYAML(rbacPreviewFlags.serviceAccountName, missingRules)
+	if err != nil {
+		return fmt.Errorf("rendering required permissions: %w", err)
+	}
+	for _, doc := range docs {
+		fmt.Println("---")
+		fmt.Print(doc)
+	}
+	return nil
+}
+
+func openManifest(path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening manifest: %w", err)
+	}
+	return f, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+// dependencyCheckTimeout bounds how long a readyz sub-check may take, so a
+// hung dependency shows up as a failed check instead of a hung /readyz
+// request.
+const dependencyCheckTimeout = 5 * time.Second
+
+// apiServerReadyzCheck reports whether the Kubernetes API server is
+// reachable, by listing a single ClusterCatalog. It is a readyz (not
+// healthz) check: a control plane blip shouldn't restart the controller,
+// only mark it temporarily not ready.
+func apiServerReadyzCheck(cl client.Client) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), dependencyCheckTimeout)
+		defer cancel()
+		var catalogs ocv1.ClusterCatalogList
+		if err := cl.List(ctx, &catalogs, client.Limit(1)); err != nil {
+			return fmt.Errorf("api server unreachable: %w", err)
+		}
+		return nil
+	}
+}
+
+// catalogdReadyzCheck reports whether catalogd's content web service is
+// reachable, using each ClusterCatalog's own Serving condition (set by the
+// ClusterCatalog controller from catalogd) rather than making a redundant
+// HTTP call of our own. A cluster with no ClusterCatalogs configured has
+// nothing to check, so it's reported as ready.
+func catalogdReadyzCheck(cl client.Client) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), dependencyCheckTimeout)
+		defer cancel()
+		var catalogs ocv1.ClusterCatalogList
+		if err := cl.List(ctx, &catalogs); err != nil {
+			return fmt.Errorf("unable to list ClusterCatalogs: %w", err)
+		}
+		var unavailable []string
+		for _, catalog := range catalogs.Items {
+			cond := apimeta.FindStatusCondition(catalog.Status.Conditions, ocv1.TypeServing)
+			if cond == nil || cond.Status != metav1.ConditionTrue {
+				unavailable = append(unavailable, catalog.Name)
+			}
+		}
+		if len(unavailable) > 0 {
+			return fmt.Errorf("catalogd not serving content for ClusterCatalog(s): %v", unavailable)
+		}
+		return nil
+	}
+}
+
+// catalogCacheReadyzCheck reports whether the on-disk catalog metadata cache
+// directory is present and writable, so a permissions or disk problem that
+// would otherwise only surface as an obscure resolution failure shows up
+// directly in /readyz.
+func catalogCacheReadyzCheck(cachePath string) healthz.Checker {
+	return func(_ *http.Request) error {
+		probe := filepath.Join(cachePath, ".readyz-probe")
+		if err := os.WriteFile(probe, nil, 0600); err != nil {
+			return fmt.Errorf("catalog cache directory %q not writable: %w", cachePath, err)
+		}
+		return os.Remove(probe)
+	}
+}
@@ -58,7 +58,10 @@ func main() {
 func runGenerator(args ...string) {
 	outputDir := "config/crd"
 	ctVer := ""
-	crdRoot := "github.com/operator-framework/operator-controller/api/v1"
+	crdRoots := []string{
+		"github.com/operator-framework/operator-controller/api/v1",
+		"github.com/operator-framework/operator-controller/api/v1alpha2",
+	}
 	if len(args) >= 1 {
 		// Get the output directory
 		outputDir = args[0]
@@ -68,13 +71,11 @@ func runGenerator(args ...string) {
 		ctVer = args[1]
 	}
 	if len(args) >= 3 {
-		crdRoot = args[2]
+		crdRoots = []string{args[2]}
 	}
 
-	roots, err := loader.LoadRoots(
-		"k8s.io/apimachinery/pkg/runtime/schema", // Needed to parse generated register functions.
-		crdRoot,
-	)
+	// "k8s.io/apimachinery/pkg/runtime/schema" is needed to parse generated register functions.
+	roots, err := loader.LoadRoots(append([]string{"k8s.io/apimachinery/pkg/runtime/schema"}, crdRoots...)...)
 	if err != nil {
 		log.Fatalf("failed to load package roots: %s", err)
 	}
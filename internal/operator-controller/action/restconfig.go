@@ -36,6 +36,29 @@ func SyntheticUserRestConfigMapper(defaultAuthMapper func(ctx context.Context, o
 	}
 }
 
+// UserImpersonationRestConfigMapper returns an AuthConfigMapper that impersonates the user and groups configured in
+// o's spec.install.impersonate, when set. o is expected to be a ClusterExtension. If spec.install.impersonate isn't
+// set, the defaultAuthMapper is used instead.
+func UserImpersonationRestConfigMapper(defaultAuthMapper func(ctx context.Context, o client.Object, c *rest.Config) (*rest.Config, error)) func(ctx context.Context, o client.Object, c *rest.Config) (*rest.Config, error) {
+	return func(ctx context.Context, o client.Object, c *rest.Config) (*rest.Config, error) {
+		cExt, err := validate(o, c)
+		if err != nil {
+			return nil, err
+		}
+		if cExt.Spec.Install == nil || cExt.Spec.Install.Impersonate == nil {
+			return defaultAuthMapper(ctx, cExt, c)
+		}
+		cc := rest.CopyConfig(c)
+		cc.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return transport.NewImpersonatingRoundTripper(transport.ImpersonationConfig{
+				UserName: cExt.Spec.Install.Impersonate.Username,
+				Groups:   cExt.Spec.Install.Impersonate.Groups,
+			}, rt)
+		})
+		return cc, nil
+	}
+}
+
 // ServiceAccountRestConfigMapper returns an AuthConfigMapper scoped to the service account defined in o, which is expected to
 // be a ClusterExtension
 func ServiceAccountRestConfigMapper(tokenGetter *authentication.TokenGetter) func(ctx context.Context, o client.Object, c *rest.Config) (*rest.Config, error) {
@@ -170,6 +170,62 @@ func Test_SyntheticUserRestConfigMapper_UsesSyntheticAuthMapper(t *testing.T) {
 	})).RoundTrip(&http.Request{})
 }
 
+func Test_UserImpersonationRestConfigMapper_UsesDefaultConfigMapper(t *testing.T) {
+	isDefaultRequestMapperUsed := false
+	defaultServiceMapper := func(ctx context.Context, o client.Object, c *rest.Config) (*rest.Config, error) {
+		isDefaultRequestMapperUsed = true
+		return c, nil
+	}
+	impersonationMapper := action.UserImpersonationRestConfigMapper(defaultServiceMapper)
+	obj := &ocv1.ClusterExtension{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-clusterextension",
+		},
+		Spec: ocv1.ClusterExtensionSpec{
+			ServiceAccount: ocv1.ServiceAccountReference{
+				Name: "my-service-account",
+			},
+			Namespace: "my-namespace",
+		},
+	}
+	actualCfg, err := impersonationMapper(context.Background(), obj, &rest.Config{})
+	require.NoError(t, err)
+	require.NotNil(t, actualCfg)
+	require.True(t, isDefaultRequestMapperUsed)
+}
+
+func Test_UserImpersonationRestConfigMapper_UsesImpersonation(t *testing.T) {
+	impersonationMapper := action.UserImpersonationRestConfigMapper(func(ctx context.Context, o client.Object, c *rest.Config) (*rest.Config, error) {
+		return c, nil
+	})
+	obj := &ocv1.ClusterExtension{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-clusterextension",
+		},
+		Spec: ocv1.ClusterExtensionSpec{
+			Namespace: "my-namespace",
+			Install: &ocv1.ClusterExtensionInstallConfig{
+				Impersonate: &ocv1.ImpersonationConfig{
+					Username: "jane",
+					Groups:   []string{"admins"},
+				},
+			},
+		},
+	}
+	actualCfg, err := impersonationMapper(context.Background(), obj, &rest.Config{})
+	require.NoError(t, err)
+	require.NotNil(t, actualCfg)
+
+	// test that the impersonation headers are appropriately injected into the request
+	// by wrapping a fake round tripper around the returned configurations transport
+	// nolint:bodyclose
+	_, _ = actualCfg.WrapTransport(fakeRoundTripper(func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, "jane", req.Header.Get("Impersonate-User"))
+		require.Equal(t, "admins", req.Header.Get("Impersonate-Group"))
+		return &http.Response{}, nil
+	})).RoundTrip(&http.Request{})
+}
+
 type fakeRoundTripper func(req *http.Request) (*http.Response, error)
 
 func (f fakeRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
@@ -19,6 +19,11 @@ import (
 	"github.com/operator-framework/helm-operator-plugins/pkg/storage"
 )
 
+// ChunkedStorageDriverMapper returns a storage driver that splits a Helm release across multiple
+// Secrets, keyed off a single index Secret, instead of the single Secret Helm's default driver
+// uses. Bundles that render hundreds of objects can produce release data well past the 1MiB Secret
+// size limit once ClusterServiceVersion manifests, CRDs, and RBAC are all included; chunking avoids
+// ever hitting that limit instead of having to compress harder or drop data to stay under it.
 func ChunkedStorageDriverMapper(secretsGetter clientcorev1.SecretsGetter, reader client.Reader, namespace string) helmclient.ObjectToStorageDriverMapper {
 	secretsClient := newSecretsDelegatingClient(secretsGetter, reader, namespace)
 	return func(ctx context.Context, object client.Object, config *rest.Config) (driver.Driver, error) {
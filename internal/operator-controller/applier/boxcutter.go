@@ -32,6 +32,7 @@ import (
 	ocv1 "github.com/operator-framework/operator-controller/api/v1"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/authorization"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/labels"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/tracing"
 	"github.com/operator-framework/operator-controller/internal/shared/util/cache"
 )
 
@@ -337,7 +338,10 @@ func (bc *Boxcutter) Apply(ctx context.Context, contentFS fs.FS, ext *ocv1.Clust
 	}
 
 	// Generate desired revision
-	desiredRevision, err := bc.RevisionGenerator.GenerateRevision(ctx, contentFS, ext, objectLabels, revisionAnnotations)
+	renderCtx, renderSpan := tracing.StartStage(ctx, "render", ext.GetName())
+	desiredRevision, err := bc.RevisionGenerator.GenerateRevision(renderCtx, contentFS, ext, objectLabels, revisionAnnotations)
+	tracing.RecordError(renderSpan, err)
+	renderSpan.End()
 	if err != nil {
 		return false, "", err
 	}
@@ -371,14 +375,17 @@ func (bc *Boxcutter) Apply(ctx context.Context, contentFS fs.FS, ext *ocv1.Clust
 
 	// Preflights
 	plainObjs := getObjects(desiredRevision)
+	preflightCtx, preflightSpan := tracing.StartStage(ctx, "preflight", ext.GetName())
 	for _, preflight := range bc.Preflights {
-		if shouldSkipPreflight(ctx, preflight, ext, state) {
+		if shouldSkipPreflight(preflightCtx, preflight, ext, state) {
 			continue
 		}
 		switch state {
 		case StateNeedsInstall:
-			err := preflight.Install(ctx, plainObjs)
+			err := preflight.Install(preflightCtx, plainObjs)
 			if err != nil {
+				tracing.RecordError(preflightSpan, err)
+				preflightSpan.End()
 				return false, "", err
 			}
 		// TODO: jlanford's IDE says that "StateNeedsUpgrade" condition is always true, but
@@ -386,12 +393,15 @@ func (bc *Boxcutter) Apply(ctx context.Context, contentFS fs.FS, ext *ocv1.Clust
 		//   always greater than 0 (seems unlikely), or shouldSkipPreflight always returns
 		//   true (and we continue) when state == StateNeedsInstall?
 		case StateNeedsUpgrade:
-			err := preflight.Upgrade(ctx, plainObjs)
+			err := preflight.Upgrade(preflightCtx, plainObjs)
 			if err != nil {
+				tracing.RecordError(preflightSpan, err)
+				preflightSpan.End()
 				return false, "", err
 			}
 		}
 	}
+	preflightSpan.End()
 
 	if state != StateUnchanged {
 		// need to create new revision
@@ -0,0 +1,124 @@
+package applier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/health"
+)
+
+// manifestConfigMapDataKey is the ConfigMap data key GitOpsExport writes the rendered manifests
+// under, as a single multi-document YAML stream.
+const manifestConfigMapDataKey = "manifests.yaml"
+
+// GitOpsExport is an Applier that, instead of applying a resolved bundle's rendered manifests to
+// the cluster itself, writes them into a ConfigMap in the ClusterExtension's install namespace for
+// a GitOps tool such as Argo CD or Flux to sync onward. It turns operator-controller into a
+// resolution-and-rendering engine for GitOps pipelines rather than the thing that applies the
+// result.
+//
+// GitOpsExport never creates, updates, or deletes any of the bundle's own objects, so it never has
+// anything to evaluate the health of: Apply always returns the zero health.Result. It also doesn't
+// manage the install namespace or a ServiceAccount/RBAC for the bundle the way Helm and Boxcutter
+// do - the exported ConfigMap is its only responsibility.
+//
+// Exporting to an OCI artifact, the other format GitOps tooling commonly syncs from, isn't
+// implemented yet; ConfigMap is the only supported export target today.
+type GitOpsExport struct {
+	ManifestProvider ManifestProvider
+	Client           client.Client
+}
+
+func (e *GitOpsExport) Apply(ctx context.Context, contentFS fs.FS, ext *ocv1.ClusterExtension, objectLabels, revisionAnnotations map[string]string) (bool, string, health.Result, error) {
+	if contentFS == nil {
+		// We're maintaining current state without catalog access; the ConfigMap we already
+		// exported, if any, is still accurate.
+		return false, "", health.Result{}, nil
+	}
+
+	objs, err := e.ManifestProvider.Get(contentFS, ext)
+	if err != nil {
+		return false, "", health.Result{}, fmt.Errorf("rendering manifests: %w", err)
+	}
+
+	manifest, err := marshalManifests(objs)
+	if err != nil {
+		return false, "", health.Result{}, fmt.Errorf("marshaling rendered manifests: %w", err)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GitOpsManifestConfigMapName(ext),
+			Namespace: ext.Spec.Namespace,
+		},
+	}
+	if _, err := controllerutil.CreateOrPatch(ctx, e.Client, cm, func() error {
+		cm.Labels = objectLabels
+		cm.Annotations = revisionAnnotations
+		cm.Data = map[string]string{manifestConfigMapDataKey: manifest}
+		return nil
+	}); err != nil {
+		return false, "", health.Result{}, fmt.Errorf("exporting rendered manifests to ConfigMap %q: %w", client.ObjectKeyFromObject(cm), err)
+	}
+
+	return true, fmt.Sprintf("exported %d object(s) to ConfigMap %q", len(objs), client.ObjectKeyFromObject(cm)), health.Result{}, nil
+}
+
+// Uninstall deletes the ConfigMap ext's rendered manifests were exported to, if any.
+func (e *GitOpsExport) Uninstall(ctx context.Context, ext *ocv1.ClusterExtension) error {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GitOpsManifestConfigMapName(ext),
+			Namespace: ext.Spec.Namespace,
+		},
+	}
+	if err := e.Client.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("deleting manifest ConfigMap %q: %w", client.ObjectKeyFromObject(cm), err)
+	}
+	return nil
+}
+
+// GitOpsManifestConfigMapName returns the name of the ConfigMap GitOpsExport writes a
+// ClusterExtension's rendered manifests to.
+func GitOpsManifestConfigMapName(ext *ocv1.ClusterExtension) string {
+	return ext.GetName() + "-manifests"
+}
+
+// marshalManifests renders objs as a single multi-document YAML stream, sorted by kind, namespace,
+// and name so that repeated exports of unchanged content produce an identical ConfigMap - otherwise
+// every reconcile would look like a change to a GitOps tool watching it.
+func marshalManifests(objs []client.Object) (string, error) {
+	sorted := make([]client.Object, len(objs))
+	copy(sorted, objs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return objectSortKey(sorted[i]) < objectSortKey(sorted[j])
+	})
+
+	var buf bytes.Buffer
+	for i, obj := range sorted {
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		out, err := yaml.Marshal(obj)
+		if err != nil {
+			return "", fmt.Errorf("marshaling %s: %w", objectSortKey(obj), err)
+		}
+		buf.Write(out)
+	}
+	return buf.String(), nil
+}
+
+func objectSortKey(obj client.Object) string {
+	return fmt.Sprintf("%s/%s/%s", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetNamespace(), obj.GetName())
+}
@@ -0,0 +1,104 @@
+package applier_test
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/applier"
+)
+
+type mockManifestProvider struct {
+	objs []client.Object
+	err  error
+}
+
+func (m *mockManifestProvider) Get(_ fs.FS, _ *ocv1.ClusterExtension) ([]client.Object, error) {
+	return m.objs, m.err
+}
+
+func testClusterExtension(name, namespace string) *ocv1.ClusterExtension {
+	return &ocv1.ClusterExtension{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       ocv1.ClusterExtensionSpec{Namespace: namespace},
+	}
+}
+
+func TestGitOpsExportWritesManifestsToConfigMap(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-operator", Namespace: "install-namespace"},
+	}
+	provider := &mockManifestProvider{objs: []client.Object{deployment}}
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	e := &applier.GitOpsExport{ManifestProvider: provider, Client: cl}
+
+	ext := testClusterExtension("my-ext", "install-namespace")
+	fsys := fstest.MapFS{}
+
+	succeeded, msg, healthResult, err := e.Apply(context.Background(), fsys, ext, map[string]string{"k": "v"}, nil)
+	require.NoError(t, err)
+	require.True(t, succeeded)
+	require.Contains(t, msg, "my-ext-manifests")
+	require.False(t, healthResult.Evaluated)
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKey{Name: "my-ext-manifests", Namespace: "install-namespace"}, cm))
+	require.Equal(t, "v", cm.Labels["k"])
+	require.Contains(t, cm.Data["manifests.yaml"], "name: my-operator")
+	require.Contains(t, cm.Data["manifests.yaml"], "kind: Deployment")
+}
+
+func TestGitOpsExportUpdatesExistingConfigMap(t *testing.T) {
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-ext-manifests", Namespace: "install-namespace"},
+		Data:       map[string]string{"manifests.yaml": "stale"},
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing).Build()
+	provider := &mockManifestProvider{objs: []client.Object{}}
+	e := &applier.GitOpsExport{ManifestProvider: provider, Client: cl}
+
+	ext := testClusterExtension("my-ext", "install-namespace")
+	_, _, _, err := e.Apply(context.Background(), fstest.MapFS{}, ext, nil, nil)
+	require.NoError(t, err)
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKey{Name: "my-ext-manifests", Namespace: "install-namespace"}, cm))
+	require.Equal(t, "", cm.Data["manifests.yaml"])
+}
+
+func TestGitOpsExportNilContentFSKeepsExistingConfigMap(t *testing.T) {
+	provider := &mockManifestProvider{objs: []client.Object{&appsv1.Deployment{}}}
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	e := &applier.GitOpsExport{ManifestProvider: provider, Client: cl}
+
+	ext := testClusterExtension("my-ext", "install-namespace")
+	succeeded, msg, _, err := e.Apply(context.Background(), nil, ext, nil, nil)
+	require.NoError(t, err)
+	require.False(t, succeeded)
+	require.Empty(t, msg)
+
+	require.Error(t, cl.Get(context.Background(), client.ObjectKey{Name: "my-ext-manifests", Namespace: "install-namespace"}, &corev1.ConfigMap{}))
+}
+
+func TestGitOpsExportPropagatesRenderError(t *testing.T) {
+	provider := &mockManifestProvider{err: errors.New("render failed")}
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	e := &applier.GitOpsExport{ManifestProvider: provider, Client: cl}
+
+	ext := testClusterExtension("my-ext", "install-namespace")
+	succeeded, _, _, err := e.Apply(context.Background(), fstest.MapFS{}, ext, nil, nil)
+	require.False(t, succeeded)
+	require.ErrorContains(t, err, "render failed")
+}
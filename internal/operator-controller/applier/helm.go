@@ -13,11 +13,19 @@ import (
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/kube"
 	"helm.sh/helm/v3/pkg/postrender"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	apimachyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
@@ -31,7 +39,10 @@ import (
 	"github.com/operator-framework/operator-controller/internal/operator-controller/contentmanager"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/contentmanager/cache"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/features"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/health"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/preflights/hook"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/util"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/tracing"
 	imageutil "github.com/operator-framework/operator-controller/internal/shared/util/image"
 )
 
@@ -66,10 +77,31 @@ type Helm struct {
 	HelmChartProvider             HelmChartProvider
 	HelmReleaseToObjectsConverter HelmReleaseToObjectsConverterInterface
 
+	// MaxHistory is the maximum number of Helm release revisions retained
+	// per ClusterExtension. When zero, maxHelmReleaseHistory is used.
+	MaxHistory int
+
+	// Client is used to fetch the live state of applied resources in order
+	// to evaluate their health after a release is installed or upgraded.
+	Client client.Client
+
+	// DisableOpenAPIValidation disables OpenAPI schema validation of rendered
+	// manifests during install and upgrade.
+	DisableOpenAPIValidation bool
+
 	Manager contentmanager.Manager
 	Watcher cache.Watcher
 }
 
+// maxHistory returns the configured Helm release history limit, falling back
+// to maxHelmReleaseHistory when MaxHistory is unset.
+func (h *Helm) maxHistory() int {
+	if h.MaxHistory > 0 {
+		return h.MaxHistory
+	}
+	return maxHelmReleaseHistory
+}
+
 // runPreAuthorizationChecks performs pre-authorization checks for a Helm release
 // it renders a client-only release, checks permissions using the PreAuthorizer
 // and returns an error if authorization fails or required permissions are missing
@@ -83,128 +115,438 @@ func (h *Helm) runPreAuthorizationChecks(ctx context.Context, ext *ocv1.ClusterE
 	return formatPreAuthorizerOutput(h.PreAuthorizer.PreAuthorize(ctx, manifestManager, strings.NewReader(tmplRel.Manifest), extManagementPerms(ext)))
 }
 
-func (h *Helm) Apply(ctx context.Context, contentFS fs.FS, ext *ocv1.ClusterExtension, objectLabels map[string]string, storageLabels map[string]string) (bool, string, error) {
+// ensureNamespace creates the ClusterExtension's install namespace when
+// ext.Spec.Install.CreateNamespace is set and the namespace doesn't already
+// exist. The namespace is labeled with objectLabels (the same owner labels
+// applied to every other object this ClusterExtension manages) so that a
+// namespace this call creates can later be told apart, for deletion, from
+// one that already existed.
+func (h *Helm) ensureNamespace(ctx context.Context, ext *ocv1.ClusterExtension, objectLabels map[string]string) error {
+	if ext.Spec.Install == nil || ext.Spec.Install.CreateNamespace == nil {
+		return nil
+	}
+	if h.Client == nil {
+		return errors.New("creating the install namespace requires a configured client")
+	}
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   ext.Spec.Namespace,
+			Labels: util.MergeMaps(ext.Spec.Install.CreateNamespace.Labels, objectLabels),
+		},
+	}
+	if err := h.Client.Create(ctx, ns); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating install namespace %q: %w", ext.Spec.Namespace, err)
+	}
+	return nil
+}
+
+// Uninstall deletes the Helm release backing ext, if one exists, and
+// reports whether any of its objects are still present in the cluster.
+// Helm's own uninstall deletes each object in the reverse of the order it
+// was installed in, so the only thing left for the caller to do is keep
+// calling Uninstall - once per reconcile, from a finalizer - until it
+// returns a nil error, which means every object is gone and the release's
+// history has been purged.
+func (h *Helm) Uninstall(ctx context.Context, ext *ocv1.ClusterExtension) error {
+	if h.Client == nil {
+		return errors.New("uninstalling a release requires a configured client")
+	}
+	ac, err := h.ActionClientGetter.ActionClientFor(ctx, ext)
+	if err != nil {
+		return err
+	}
+
+	rel, err := ac.Get(ext.GetName())
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting current release: %w", err)
+	}
+
+	if rel.Info.Status != release.StatusUninstalled {
+		relObjects, err := h.HelmReleaseToObjectsConverter.GetObjectsFromRelease(rel)
+		if err != nil {
+			return fmt.Errorf("parsing release objects: %w", err)
+		}
+		if ext.Spec.Install == nil || ext.Spec.Install.ForceDeletion == nil || !*ext.Spec.Install.ForceDeletion {
+			if err := h.blockIfOperandsExist(ctx, relObjects); err != nil {
+				return err
+			}
+		}
+		if err := hook.RunPreUninstallHooks(ctx, h.Client, ext, relObjects); err != nil {
+			return fmt.Errorf("running pre-uninstall hooks: %w", err)
+		}
+		if _, err := ac.Uninstall(ext.GetName(), func(u *action.Uninstall) error {
+			u.KeepHistory = true
+			return nil
+		}); err != nil {
+			return fmt.Errorf("uninstalling release: %w", err)
+		}
+		return fmt.Errorf("waiting for release %q objects to be deleted", ext.GetName())
+	}
+
+	relObjects, err := h.HelmReleaseToObjectsConverter.GetObjectsFromRelease(rel)
+	if err != nil {
+		return fmt.Errorf("parsing release objects: %w", err)
+	}
+	if err := h.waitForObjectsDeleted(ctx, relObjects); err != nil {
+		return err
+	}
+
+	if _, err := ac.Uninstall(ext.GetName(), func(u *action.Uninstall) error {
+		u.KeepHistory = false
+		return nil
+	}); err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+		return fmt.Errorf("purging release history: %w", err)
+	}
+	return nil
+}
+
+// waitForObjectsDeleted checks, in the reverse of the order Helm installs
+// objects in, whether any of objs is still present in the cluster. It
+// returns a nil error only once every object is gone; otherwise the error
+// names how many objects remain and, for the first one found, whether it's
+// blocked from deleting by its own finalizers, so a caller reporting this
+// error can surface real deletion progress instead of hanging silently.
+func (h *Helm) waitForObjectsDeleted(ctx context.Context, objs []client.Object) error {
+	var remaining int
+	var blocker string
+	for i := len(objs) - 1; i >= 0; i-- {
+		obj := objs[i]
+		live := obj.DeepCopyObject().(client.Object) //nolint:forcetypeassert
+		err := h.Client.Get(ctx, client.ObjectKeyFromObject(obj), live)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("checking deletion of %s %q: %w", live.GetObjectKind().GroupVersionKind().Kind, client.ObjectKeyFromObject(obj), err)
+		}
+		remaining++
+		if blocker == "" {
+			blocker = fmt.Sprintf("%s %q", live.GetObjectKind().GroupVersionKind().Kind, client.ObjectKeyFromObject(obj))
+			if fin := live.GetFinalizers(); len(fin) > 0 {
+				blocker += fmt.Sprintf(" (blocked by finalizers %v)", fin)
+			}
+		}
+	}
+	if remaining == 0 {
+		return nil
+	}
+	return fmt.Errorf("waiting for %d release object(s) to be deleted, e.g. %s", remaining, blocker)
+}
+
+// blockIfOperandsExist refuses to proceed if objs includes a
+// CustomResourceDefinition that still has custom resources on the cluster.
+// Deleting a release that owns a CRD deletes the CRD along with it, which
+// cascades to delete every custom resource of that CRD across every
+// namespace, so this check exists to prevent that data loss from happening
+// by accident. It can be bypassed by setting ext.Spec.Install.ForceDeletion.
+func (h *Helm) blockIfOperandsExist(ctx context.Context, objs []client.Object) error {
+	for _, obj := range objs {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok || u.GroupVersionKind().GroupKind() != apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinition").GroupKind() {
+			continue
+		}
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, crd); err != nil {
+			return fmt.Errorf("parsing CustomResourceDefinition %q: %w", obj.GetName(), err)
+		}
+		for _, v := range crd.Spec.Versions {
+			gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: v.Name, Kind: crd.Spec.Names.ListKind}
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk)
+			if err := h.Client.List(ctx, list, client.Limit(1)); err != nil {
+				if apimeta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+					continue
+				}
+				return fmt.Errorf("checking for existing %q custom resources: %w", crd.Spec.Names.Kind, err)
+			}
+			if len(list.Items) > 0 {
+				return fmt.Errorf("refusing to delete release: custom resource(s) of CRD %q still exist; set spec.install.forceDeletion to delete anyway", crd.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// ensureServiceAccountAndRBAC creates ext's configured ServiceAccount, along
+// with a Role/ClusterRole and RoleBinding/ClusterRoleBinding granting it the
+// least-privilege permissions the bundle's rendered manifest needs, when the
+// ControllerManagedRBAC feature gate is enabled and the ServiceAccount
+// doesn't already exist. This lets single-tenant clusters skip the stricter
+// pre-provisioned-ServiceAccount model, at the cost of the controller itself
+// being trusted to create and grant whatever RBAC an extension asks for. If
+// the ServiceAccount already exists, it's assumed to be pre-provisioned and
+// is left untouched.
+func (h *Helm) ensureServiceAccountAndRBAC(ctx context.Context, ext *ocv1.ClusterExtension, chrt *chart.Chart, values chartutil.Values, post postrender.PostRenderer, objectLabels map[string]string) error {
+	if !features.OperatorControllerFeatureGate.Enabled(features.ControllerManagedRBAC) {
+		return nil
+	}
+	if ext.Spec.Install != nil && ext.Spec.Install.Impersonate != nil {
+		// Controller-managed RBAC only applies to the ServiceAccount identity model.
+		return nil
+	}
+	if h.Client == nil || h.PreAuthorizer == nil {
+		return errors.New("controller-managed RBAC requires a configured client and pre-authorizer")
+	}
+
+	saKey := client.ObjectKey{Namespace: ext.Spec.Namespace, Name: ext.Spec.ServiceAccount.Name}
+	if err := h.Client.Get(ctx, saKey, &corev1.ServiceAccount{}); err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("checking for ServiceAccount %q: %w", saKey, err)
+	}
+
+	tmplRel, err := h.renderClientOnlyRelease(ctx, ext, chrt, values, post)
+	if err != nil {
+		return fmt.Errorf("rendering content to compute managed RBAC: %w", err)
+	}
+	// A ServiceAccount that doesn't exist yet has no bindings, so every rule
+	// PreAuthorize reports missing for it is a rule the bundle actually needs.
+	requiredRules, err := h.PreAuthorizer.PreAuthorize(ctx, getUserInfo(ext), strings.NewReader(tmplRel.Manifest), extManagementPerms(ext))
+	if err != nil {
+		return fmt.Errorf("computing managed RBAC: %w", err)
+	}
+
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: saKey.Name, Namespace: saKey.Namespace, Labels: objectLabels}}
+	if err := h.Client.Create(ctx, sa); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating ServiceAccount %q: %w", saKey, err)
+	}
+
+	roleName := saKey.Name + "-managed-permissions"
+	subject := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: saKey.Name, Namespace: saKey.Namespace}
+	for _, scoped := range requiredRules {
+		if len(scoped.MissingRules) == 0 {
+			continue
+		}
+		if scoped.Namespace == "" {
+			clusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: roleName, Labels: objectLabels}, Rules: scoped.MissingRules}
+			if err := h.Client.Create(ctx, clusterRole); err != nil && !apierrors.IsAlreadyExists(err) {
+				return fmt.Errorf("creating ClusterRole %q: %w", roleName, err)
+			}
+			binding := &rbacv1.ClusterRoleBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: roleName, Labels: objectLabels},
+				RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: roleName},
+				Subjects:   []rbacv1.Subject{subject},
+			}
+			if err := h.Client.Create(ctx, binding); err != nil && !apierrors.IsAlreadyExists(err) {
+				return fmt.Errorf("creating ClusterRoleBinding %q: %w", roleName, err)
+			}
+			continue
+		}
+		role := &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: roleName, Namespace: scoped.Namespace, Labels: objectLabels}, Rules: scoped.MissingRules}
+		if err := h.Client.Create(ctx, role); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("creating Role %q: %w", client.ObjectKeyFromObject(role), err)
+		}
+		binding := &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: roleName, Namespace: scoped.Namespace, Labels: objectLabels},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: roleName},
+			Subjects:   []rbacv1.Subject{subject},
+		}
+		if err := h.Client.Create(ctx, binding); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("creating RoleBinding %q: %w", client.ObjectKeyFromObject(binding), err)
+		}
+	}
+	return nil
+}
+
+func (h *Helm) Apply(ctx context.Context, contentFS fs.FS, ext *ocv1.ClusterExtension, objectLabels map[string]string, storageLabels map[string]string) (bool, string, health.Result, error) {
 	// If contentFS is nil, we're maintaining the current state without catalog access.
 	// In this case, reconcile the existing Helm release if it exists.
 	if contentFS == nil {
 		ac, err := h.ActionClientGetter.ActionClientFor(ctx, ext)
 		if err != nil {
-			return false, "", err
+			return false, "", health.Result{}, err
 		}
 		return h.reconcileExistingRelease(ctx, ac, ext)
 	}
 
+	if err := h.ensureNamespace(ctx, ext, objectLabels); err != nil {
+		return false, "", health.Result{}, err
+	}
+
+	renderCtx, renderSpan := tracing.StartStage(ctx, "render", ext.GetName())
 	chrt, err := h.buildHelmChart(contentFS, ext)
 	if err != nil {
-		return false, "", err
+		tracing.RecordError(renderSpan, err)
+		renderSpan.End()
+		return false, "", health.Result{}, err
 	}
 	values := chartutil.Values{}
 
 	post := &postrenderer{
-		labels: objectLabels,
+		labels:        objectLabels,
+		orphanRemoved: ext.Spec.Install != nil && ext.Spec.Install.PruneBehavior == ocv1.PruneBehaviorOrphan,
+		excluded:      installExclusions(ext),
+	}
+
+	if err := h.ensureServiceAccountAndRBAC(renderCtx, ext, chrt, values, post, objectLabels); err != nil {
+		tracing.RecordError(renderSpan, err)
+		renderSpan.End()
+		return false, "", health.Result{}, err
 	}
 
 	if h.PreAuthorizer != nil {
-		err := h.runPreAuthorizationChecks(ctx, ext, chrt, values, post)
+		err := h.runPreAuthorizationChecks(renderCtx, ext, chrt, values, post)
 		if err != nil {
 			// Return the pre-authorization error directly
-			return false, "", err
+			tracing.RecordError(renderSpan, err)
+			renderSpan.End()
+			return false, "", health.Result{}, err
 		}
 	}
 
-	ac, err := h.ActionClientGetter.ActionClientFor(ctx, ext)
+	ac, err := h.ActionClientGetter.ActionClientFor(renderCtx, ext)
 	if err != nil {
-		return false, "", err
+		tracing.RecordError(renderSpan, err)
+		renderSpan.End()
+		return false, "", health.Result{}, err
 	}
 
 	rel, desiredRel, state, err := h.getReleaseState(ac, ext, chrt, values, post)
 	if err != nil {
-		return false, "", fmt.Errorf("failed to get release state using server-side dry-run: %w", err)
+		err = fmt.Errorf("failed to get release state using server-side dry-run: %w", err)
+		tracing.RecordError(renderSpan, err)
+		renderSpan.End()
+		return false, "", health.Result{}, err
 	}
 	objs, err := h.HelmReleaseToObjectsConverter.GetObjectsFromRelease(desiredRel)
+	renderSpan.End()
 	if err != nil {
-		return false, "", err
+		return false, "", health.Result{}, err
 	}
 
+	preflightCtx, preflightSpan := tracing.StartStage(ctx, "preflight", ext.GetName())
 	for _, preflight := range h.Preflights {
-		if shouldSkipPreflight(ctx, preflight, ext, state) {
+		if shouldSkipPreflight(preflightCtx, preflight, ext, state) {
 			continue
 		}
 		switch state {
 		case StateNeedsInstall:
-			err := preflight.Install(ctx, objs)
+			err := preflight.Install(preflightCtx, objs)
 			if err != nil {
-				return false, "", err
+				tracing.RecordError(preflightSpan, err)
+				preflightSpan.End()
+				return false, "", health.Result{}, err
 			}
 		case StateNeedsUpgrade:
-			err := preflight.Upgrade(ctx, objs)
+			err := preflight.Upgrade(preflightCtx, objs)
 			if err != nil {
-				return false, "", err
+				tracing.RecordError(preflightSpan, err)
+				preflightSpan.End()
+				return false, "", health.Result{}, err
 			}
 		}
 	}
+	preflightSpan.End()
+
+	if state == StateNeedsInstall && ext.Spec.Install != nil && ext.Spec.Install.AdoptionPolicy == ocv1.AdoptionPolicyAdoptOLMV0 {
+		if h.Client == nil {
+			return false, "", health.Result{}, errors.New("adopting OLMv0 resources requires a configured client")
+		}
+		if err := AdoptOLMv0Resources(ctx, h.Client, objs, chrt.Metadata.Name, ext.Spec.Namespace); err != nil {
+			return false, "", health.Result{}, fmt.Errorf("adopting resources from OLMv0: %w", err)
+		}
+	}
 
 	switch state {
 	case StateNeedsInstall:
 		rel, err = ac.Install(ext.GetName(), ext.Spec.Namespace, chrt, values, func(install *action.Install) error {
 			install.CreateNamespace = false
 			install.Labels = storageLabels
+			install.DisableOpenAPIValidation = h.DisableOpenAPIValidation
 			return nil
 		}, helmclient.AppendInstallPostRenderer(post))
 		if err != nil {
-			return false, "", err
+			return false, "", health.Result{}, err
 		}
 	case StateNeedsUpgrade:
 		rel, err = ac.Upgrade(ext.GetName(), ext.Spec.Namespace, chrt, values, func(upgrade *action.Upgrade) error {
-			upgrade.MaxHistory = maxHelmReleaseHistory
+			upgrade.MaxHistory = h.maxHistory()
 			upgrade.Labels = storageLabels
+			upgrade.DisableOpenAPIValidation = h.DisableOpenAPIValidation
 			return nil
 		}, helmclient.AppendUpgradePostRenderer(post))
 		if err != nil {
-			return false, "", err
+			return false, "", health.Result{}, err
 		}
 	case StateUnchanged:
 		if err := ac.Reconcile(rel); err != nil {
-			return false, "", err
+			return false, "", health.Result{}, err
 		}
 	default:
-		return false, "", fmt.Errorf("unexpected release state %q", state)
+		return false, "", health.Result{}, fmt.Errorf("unexpected release state %q", state)
 	}
 
 	relObjects, err := util.ManifestObjects(strings.NewReader(rel.Manifest), fmt.Sprintf("%s-release-manifest", rel.Name))
 	if err != nil {
-		return true, "", err
+		return true, "", health.Result{}, err
 	}
 	klog.FromContext(ctx).Info("watching managed objects")
 	cache, err := h.Manager.Get(ctx, ext)
 	if err != nil {
-		return true, "", err
+		return true, "", health.Result{}, err
 	}
 
 	if err := cache.Watch(ctx, h.Watcher, relObjects...); err != nil {
-		return true, "", err
+		return true, "", health.Result{}, err
 	}
 
-	return true, "", nil
+	healthResult := h.evaluateHealth(ctx, relObjects)
+
+	return true, "", healthResult, nil
+}
+
+// RefreshHealth implements controllers.HealthRefresher. It re-evaluates the aggregate health of
+// the currently installed release's objects by reading the release and the live cluster state,
+// without rendering a chart, diffing against the cluster, or performing any apply. Callers use
+// this when they've already determined nothing relevant (bundle, config, release) has changed
+// since the last apply, so there's no need to pay for Apply's full render/diff/apply cycle just to
+// refresh the Healthy condition.
+func (h *Helm) RefreshHealth(ctx context.Context, ext *ocv1.ClusterExtension) (health.Result, error) {
+	ac, err := h.ActionClientGetter.ActionClientFor(ctx, ext)
+	if err != nil {
+		return health.Result{}, err
+	}
+	rel, err := ac.Get(ext.GetName())
+	if err != nil {
+		return health.Result{}, fmt.Errorf("failed to get current release: %w", err)
+	}
+	relObjects, err := h.HelmReleaseToObjectsConverter.GetObjectsFromRelease(rel)
+	if err != nil {
+		return health.Result{}, err
+	}
+	return h.evaluateHealth(ctx, relObjects), nil
+}
+
+// evaluateHealth computes the aggregate kstatus health of objs, using
+// h.Client to fetch each object's live state. If no Client is configured,
+// health is not evaluated.
+func (h *Helm) evaluateHealth(ctx context.Context, objs []client.Object) health.Result {
+	if h.Client == nil {
+		return health.Result{}
+	}
+	return health.Evaluate(ctx, h.Client, objs)
 }
 
 // reconcileExistingRelease reconciles an existing Helm release without catalog access.
 // This is used when the catalog is unavailable but we need to maintain the current installation.
 // It reconciles the release to actively maintain resources, and sets up watchers for monitoring/observability.
-func (h *Helm) reconcileExistingRelease(ctx context.Context, ac helmclient.ActionInterface, ext *ocv1.ClusterExtension) (bool, string, error) {
+func (h *Helm) reconcileExistingRelease(ctx context.Context, ac helmclient.ActionInterface, ext *ocv1.ClusterExtension) (bool, string, health.Result, error) {
 	rel, err := ac.Get(ext.GetName())
 	if errors.Is(err, driver.ErrReleaseNotFound) {
-		return false, "", fmt.Errorf("catalog content unavailable and no release installed")
+		return false, "", health.Result{}, fmt.Errorf("catalog content unavailable and no release installed")
 	}
 	if err != nil {
-		return false, "", fmt.Errorf("failed to get current release: %w", err)
+		return false, "", health.Result{}, fmt.Errorf("failed to get current release: %w", err)
 	}
 
 	// Reconcile the existing release to ensure resources are maintained
 	if err := ac.Reconcile(rel); err != nil {
 		// Reconcile failed - resources NOT maintained
 		// Return false (rollout failed) with error
-		return false, "", err
+		return false, "", health.Result{}, err
 	}
 
 	// At this point: Reconcile succeeded - resources ARE maintained (applied to cluster via Server-Side Apply)
@@ -216,7 +558,7 @@ func (h *Helm) reconcileExistingRelease(ctx context.Context, ac helmclient.Actio
 	relObjects, err := util.ManifestObjects(strings.NewReader(rel.Manifest), fmt.Sprintf("%s-release-manifest", rel.Name))
 	if err != nil {
 		logger.Error(err, "failed to parse manifest objects, cannot set up drift detection watches (resources are applied but drift detection disabled)")
-		return true, "", nil
+		return true, "", health.Result{}, nil
 	}
 
 	logger.V(1).Info("setting up drift detection watches on managed objects")
@@ -224,24 +566,24 @@ func (h *Helm) reconcileExistingRelease(ctx context.Context, ac helmclient.Actio
 	// Defensive nil checks to prevent panics if Manager or Watcher not properly initialized
 	if h.Manager == nil {
 		logger.Error(fmt.Errorf("manager is nil"), "Manager not initialized, cannot set up drift detection watches (resources are applied but drift detection disabled)")
-		return true, "", nil
+		return true, "", h.evaluateHealth(ctx, relObjects), nil
 	}
 	cache, err := h.Manager.Get(ctx, ext)
 	if err != nil {
 		logger.Error(err, "failed to get managed content cache, cannot set up drift detection watches (resources are applied but drift detection disabled)")
-		return true, "", nil
+		return true, "", h.evaluateHealth(ctx, relObjects), nil
 	}
 
 	if h.Watcher == nil {
 		logger.Error(fmt.Errorf("watcher is nil"), "Watcher not initialized, cannot set up drift detection watches (resources are applied but drift detection disabled)")
-		return true, "", nil
+		return true, "", h.evaluateHealth(ctx, relObjects), nil
 	}
 	if err := cache.Watch(ctx, h.Watcher, relObjects...); err != nil {
 		logger.Error(err, "failed to set up drift detection watches (resources are applied but drift detection disabled)")
-		return true, "", nil
+		return true, "", h.evaluateHealth(ctx, relObjects), nil
 	}
 
-	return true, "", nil
+	return true, "", h.evaluateHealth(ctx, relObjects), nil
 }
 
 func (h *Helm) buildHelmChart(bundleFS fs.FS, ext *ocv1.ClusterExtension) (*chart.Chart, error) {
@@ -289,12 +631,22 @@ func (h *Helm) renderClientOnlyRelease(ctx context.Context, ext *ocv1.ClusterExt
 	}, helmclient.AppendInstallPostRenderer(post))
 }
 
+// getReleaseState determines the current and desired release, and the
+// action needed to reconcile them, by rendering the desired release with a
+// server-side dry-run (DryRunOption "server"). The rendered manifests are
+// submitted to the API server's admission chain (OpenAPI schema validation,
+// webhooks, CRD schemas) without being persisted, so a change that the
+// cluster would reject is caught here and returned as an error before
+// Apply ever calls Install/Upgrade for real. This keeps a rejected change
+// from leaving the release half-applied: either every object passes the
+// dry-run, or nothing is touched.
 func (h *Helm) getReleaseState(cl helmclient.ActionInterface, ext *ocv1.ClusterExtension, chrt *chart.Chart, values chartutil.Values, post postrender.PostRenderer) (*release.Release, *release.Release, string, error) {
 	currentRelease, err := cl.Get(ext.GetName())
 	if errors.Is(err, driver.ErrReleaseNotFound) {
 		desiredRelease, err := cl.Install(ext.GetName(), ext.Spec.Namespace, chrt, values, func(i *action.Install) error {
 			i.DryRun = true
 			i.DryRunOption = "server"
+			i.DisableOpenAPIValidation = h.DisableOpenAPIValidation
 			return nil
 		}, helmclient.AppendInstallPostRenderer(post))
 		if err != nil {
@@ -307,9 +659,10 @@ func (h *Helm) getReleaseState(cl helmclient.ActionInterface, ext *ocv1.ClusterE
 	}
 
 	desiredRelease, err := cl.Upgrade(ext.GetName(), ext.Spec.Namespace, chrt, values, func(upgrade *action.Upgrade) error {
-		upgrade.MaxHistory = maxHelmReleaseHistory
+		upgrade.MaxHistory = h.maxHistory()
 		upgrade.DryRun = true
 		upgrade.DryRunOption = "server"
+		upgrade.DisableOpenAPIValidation = h.DisableOpenAPIValidation
 		return nil
 	}, helmclient.AppendUpgradePostRenderer(post))
 	if err != nil {
@@ -327,6 +680,24 @@ func (h *Helm) getReleaseState(cl helmclient.ActionInterface, ext *ocv1.ClusterE
 type postrenderer struct {
 	labels  map[string]string
 	cascade postrender.PostRenderer
+
+	// orphanRemoved marks every object with Helm's "keep" resource policy so
+	// that objects removed from a future revision of the bundle are left in
+	// the cluster instead of being deleted by Helm's upgrade pruning.
+	//
+	// This only annotates objects present in the *current* render. Helm's own
+	// prune step (kube.Client.Update) decides whether to keep an object being
+	// removed by checking the annotation already present on the live object
+	// from the *previous* apply, not on anything this postrenderer produces
+	// this revision. So an object removed in the very revision that first
+	// turns this on was never annotated by the prior apply and is still
+	// deleted; orphaning only takes effect starting the following upgrade.
+	// See the pruneBehavior doc comment on ClusterExtensionInstallConfig.
+	orphanRemoved bool
+
+	// excluded lists the objects that must be dropped from the rendered
+	// manifests before they reach the dry-run/apply steps.
+	excluded []ocv1.ResourceExclusion
 }
 
 func (p *postrenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
@@ -341,7 +712,13 @@ func (p *postrenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, erro
 		if err != nil {
 			return nil, err
 		}
+		if isExcluded(obj, p.excluded) {
+			continue
+		}
 		obj.SetLabels(util.MergeMaps(obj.GetLabels(), p.labels))
+		if p.orphanRemoved {
+			obj.SetAnnotations(util.MergeMaps(obj.GetAnnotations(), map[string]string{kube.ResourcePolicyAnno: kube.KeepPolicy}))
+		}
 		b, err := obj.MarshalJSON()
 		if err != nil {
 			return nil, err
@@ -354,6 +731,30 @@ func (p *postrenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, erro
 	return &buf, nil
 }
 
+// installExclusions returns the resource exclusion list configured on ext,
+// or nil if none is set.
+func installExclusions(ext *ocv1.ClusterExtension) []ocv1.ResourceExclusion {
+	if ext.Spec.Install == nil {
+		return nil
+	}
+	return ext.Spec.Install.Exclude
+}
+
+// isExcluded reports whether obj matches one of the configured exclusions by
+// group/kind, and optionally name.
+func isExcluded(obj unstructured.Unstructured, exclusions []ocv1.ResourceExclusion) bool {
+	gvk := obj.GroupVersionKind()
+	for _, excl := range exclusions {
+		if excl.Group != gvk.Group || excl.Kind != gvk.Kind {
+			continue
+		}
+		if excl.Name == "" || excl.Name == obj.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
 func ruleDescription(ns string, rule rbacv1.PolicyRule) string {
 	var sb strings.Builder
 	sb.WriteString(fmt.Sprintf("Namespace:%q", ns))
@@ -396,12 +797,19 @@ func formatPreAuthorizerOutput(missingRules []authorization.ScopedPolicyRules, a
 	}
 	if len(preAuthErrors) > 0 {
 		// This phrase is explicitly checked by external testing
-		return fmt.Errorf("pre-authorization failed: %v", errors.Join(preAuthErrors...))
+		err := fmt.Errorf("pre-authorization failed: %v", errors.Join(preAuthErrors...))
+		if len(missingRules) > 0 {
+			return &authorization.MissingRulesError{Err: err, MissingRules: missingRules}
+		}
+		return err
 	}
 	return nil
 }
 
 func getUserInfo(ext *ocv1.ClusterExtension) user.Info {
+	if ext.Spec.Install != nil && ext.Spec.Install.Impersonate != nil {
+		return &user.DefaultInfo{Name: ext.Spec.Install.Impersonate.Username, Groups: ext.Spec.Install.Impersonate.Groups}
+	}
 	return &user.DefaultInfo{Name: fmt.Sprintf("system:serviceaccount:%s:%s", ext.Spec.Namespace, ext.Spec.ServiceAccount.Name)}
 }
 
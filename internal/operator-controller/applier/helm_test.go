@@ -1,8 +1,10 @@
 package applier_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"os"
@@ -12,13 +14,21 @@ import (
 	"github.com/stretchr/testify/require"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/kube"
+	"helm.sh/helm/v3/pkg/postrender"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	helmclient "github.com/operator-framework/helm-operator-plugins/pkg/client"
 
@@ -27,6 +37,9 @@ import (
 	"github.com/operator-framework/operator-controller/internal/operator-controller/authorization"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/contentmanager"
 	cmcache "github.com/operator-framework/operator-controller/internal/operator-controller/contentmanager/cache"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/features"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/labels"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/util"
 )
 
 var _ contentmanager.Manager = (*mockManagedContentCacheManager)(nil)
@@ -105,6 +118,18 @@ type mockActionGetter struct {
 	reconcileErr       error
 	desiredRel         *release.Release
 	currentRel         *release.Release
+
+	gotInstallPostRenderer postrender.PostRenderer
+	gotUpgradePostRenderer postrender.PostRenderer
+
+	gotInstallDisableOpenAPIValidation bool
+	gotUpgradeDisableOpenAPIValidation bool
+
+	realInstallCalled bool
+	realUpgradeCalled bool
+
+	uninstallErr            error
+	gotUninstallKeepHistory []bool
 }
 
 func (mag *mockActionGetter) ActionClientFor(ctx context.Context, obj client.Object) (helmclient.ActionInterface, error) {
@@ -126,9 +151,12 @@ func (mag *mockActionGetter) Install(name, namespace string, chrt *chart.Chart,
 			return nil, err
 		}
 	}
+	mag.gotInstallPostRenderer = i.PostRenderer
+	mag.gotInstallDisableOpenAPIValidation = i.DisableOpenAPIValidation
 	if i.DryRun {
 		return mag.desiredRel, mag.dryRunInstallErr
 	}
+	mag.realInstallCalled = true
 	return mag.desiredRel, mag.installErr
 }
 
@@ -139,14 +167,27 @@ func (mag *mockActionGetter) Upgrade(name, namespace string, chrt *chart.Chart,
 			return nil, err
 		}
 	}
+	mag.gotUpgradePostRenderer = i.PostRenderer
+	mag.gotUpgradeDisableOpenAPIValidation = i.DisableOpenAPIValidation
 	if i.DryRun {
 		return mag.desiredRel, mag.dryRunUpgradeErr
 	}
+	mag.realUpgradeCalled = true
 	return mag.desiredRel, mag.upgradeErr
 }
 
 func (mag *mockActionGetter) Uninstall(name string, opts ...helmclient.UninstallOption) (*release.UninstallReleaseResponse, error) {
-	return nil, nil
+	u := action.Uninstall{}
+	for _, opt := range opts {
+		if err := opt(&u); err != nil {
+			return nil, err
+		}
+	}
+	mag.gotUninstallKeepHistory = append(mag.gotUninstallKeepHistory, u.KeepHistory)
+	if mag.uninstallErr != nil {
+		return nil, mag.uninstallErr
+	}
+	return &release.UninstallReleaseResponse{Release: mag.currentRel}, nil
 }
 
 func (mag *mockActionGetter) Reconcile(rel *release.Release) error {
@@ -191,6 +232,21 @@ metadata:
 spec:
   clusterIP: 0.0.0.0`
 
+	preUninstallHookManifest = `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: deregister
+  namespace: ns-a
+  annotations:
+    olm.operatorframework.io/hook: pre-uninstall
+spec:
+  template:
+    spec:
+      containers:
+      - name: deregister
+        image: example.com/deregister
+      restartPolicy: Never`
+
 	testCE = &ocv1.ClusterExtension{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "test-ext",
@@ -237,7 +293,7 @@ func TestApply_Base(t *testing.T) {
 	t.Run("fails converting content FS to helm chart", func(t *testing.T) {
 		helmApplier := applier.Helm{}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), os.DirFS("/"), testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), os.DirFS("/"), testCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.False(t, installSucceeded)
 		require.Empty(t, installStatus)
@@ -250,7 +306,7 @@ func TestApply_Base(t *testing.T) {
 			HelmChartProvider:  DummyHelmChartProvider,
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "getting action client")
 		require.False(t, installSucceeded)
@@ -264,7 +320,7 @@ func TestApply_Base(t *testing.T) {
 			HelmChartProvider:  DummyHelmChartProvider,
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "getting current release")
 		require.False(t, installSucceeded)
@@ -283,11 +339,12 @@ func TestApply_Installation(t *testing.T) {
 			HelmChartProvider:  DummyHelmChartProvider,
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "attempting to dry-run install chart")
 		require.False(t, installSucceeded)
 		require.Empty(t, installStatus)
+		require.False(t, mockAcg.realInstallCalled, "a rejected dry-run must prevent the real install from ever being attempted")
 	})
 
 	t.Run("fails during pre-flight installation", func(t *testing.T) {
@@ -303,7 +360,7 @@ func TestApply_Installation(t *testing.T) {
 			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "install pre-flight check")
 		require.False(t, installSucceeded)
@@ -321,7 +378,7 @@ func TestApply_Installation(t *testing.T) {
 			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "installing chart")
 		require.False(t, installSucceeded)
@@ -345,7 +402,7 @@ func TestApply_Installation(t *testing.T) {
 			},
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.NoError(t, err)
 		require.Empty(t, installStatus)
 		require.True(t, installSucceeded)
@@ -395,7 +452,7 @@ func TestApply_InstallationWithPreflightPermissionsEnabled(t *testing.T) {
 			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
 		}
 
-		_, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		_, _, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 	})
 
@@ -409,7 +466,7 @@ func TestApply_InstallationWithPreflightPermissionsEnabled(t *testing.T) {
 			HelmChartProvider:  DummyHelmChartProvider,
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "attempting to dry-run install chart")
 		require.False(t, installSucceeded)
@@ -438,7 +495,7 @@ func TestApply_InstallationWithPreflightPermissionsEnabled(t *testing.T) {
 			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "install pre-flight check")
 		require.False(t, installSucceeded)
@@ -471,7 +528,7 @@ func TestApply_InstallationWithPreflightPermissionsEnabled(t *testing.T) {
 				},
 			},
 		}
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, validCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, validCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "problem running preauthorization")
 		require.False(t, installSucceeded)
@@ -504,7 +561,7 @@ func TestApply_InstallationWithPreflightPermissionsEnabled(t *testing.T) {
 				},
 			},
 		}
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, validCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, validCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.ErrorContains(t, err, errMissingRBAC)
 		require.False(t, installSucceeded)
@@ -543,13 +600,106 @@ func TestApply_InstallationWithPreflightPermissionsEnabled(t *testing.T) {
 			},
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, validCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, validCE, testObjectLabels, testStorageLabels)
 		require.NoError(t, err)
 		require.Empty(t, installStatus)
 		require.True(t, installSucceeded)
 	})
 }
 
+func TestApply_ControllerManagedRBAC(t *testing.T) {
+	newApplier := func(cl client.Client, preAuthFn func(context.Context, user.Info, io.Reader, ...authorization.UserAuthorizerAttributesFactory) ([]authorization.ScopedPolicyRules, error)) applier.Helm {
+		mockAcg := &mockActionGetter{
+			getClientErr: driver.ErrReleaseNotFound,
+			desiredRel:   &release.Release{Info: &release.Info{Status: release.StatusDeployed}, Manifest: validManifest},
+		}
+		return applier.Helm{
+			ActionClientGetter:            mockAcg,
+			HelmChartProvider:             DummyHelmChartProvider,
+			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
+			Manager: &mockManagedContentCacheManager{
+				cache: &mockManagedContentCache{},
+			},
+			PreAuthorizer: &mockPreAuthorizer{fn: preAuthFn},
+			Client:        cl,
+		}
+	}
+
+	t.Run("does nothing when the feature gate is disabled", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		helmApplier := newApplier(cl, func(context.Context, user.Info, io.Reader, ...authorization.UserAuthorizerAttributesFactory) ([]authorization.ScopedPolicyRules, error) {
+			return nil, nil
+		})
+
+		_, _, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		require.NoError(t, err)
+
+		err = cl.Get(context.TODO(), client.ObjectKey{Namespace: testCE.Spec.Namespace, Name: testCE.Spec.ServiceAccount.Name}, &corev1.ServiceAccount{})
+		require.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("provisions the ServiceAccount and RBAC when the ServiceAccount doesn't exist", func(t *testing.T) {
+		require.NoError(t, features.OperatorControllerFeatureGate.Set(fmt.Sprintf("%s=true", features.ControllerManagedRBAC)))
+		defer func() {
+			require.NoError(t, features.OperatorControllerFeatureGate.Set(fmt.Sprintf("%s=false", features.ControllerManagedRBAC)))
+		}()
+
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		provisioned := false
+		helmApplier := newApplier(cl, func(context.Context, user.Info, io.Reader, ...authorization.UserAuthorizerAttributesFactory) ([]authorization.ScopedPolicyRules, error) {
+			// The first call drives provisioning; once the RBAC exists, later
+			// calls (from the regular preflight permission check) see nothing missing.
+			if !provisioned {
+				provisioned = true
+				return missingRBAC, nil
+			}
+			return nil, nil
+		})
+
+		_, _, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		require.NoError(t, err)
+
+		sa := &corev1.ServiceAccount{}
+		require.NoError(t, cl.Get(context.TODO(), client.ObjectKey{Namespace: testCE.Spec.Namespace, Name: testCE.Spec.ServiceAccount.Name}, sa))
+		require.Equal(t, testObjectLabels["object"], sa.Labels["object"])
+
+		roleName := testCE.Spec.ServiceAccount.Name + "-managed-permissions"
+		require.NoError(t, cl.Get(context.TODO(), client.ObjectKey{Name: roleName}, &rbacv1.ClusterRole{}))
+		require.NoError(t, cl.Get(context.TODO(), client.ObjectKey{Name: roleName}, &rbacv1.ClusterRoleBinding{}))
+		require.NoError(t, cl.Get(context.TODO(), client.ObjectKey{Namespace: testCE.Spec.Namespace, Name: roleName}, &rbacv1.Role{}))
+		require.NoError(t, cl.Get(context.TODO(), client.ObjectKey{Namespace: testCE.Spec.Namespace, Name: roleName}, &rbacv1.RoleBinding{}))
+	})
+
+	t.Run("leaves a pre-existing ServiceAccount untouched", func(t *testing.T) {
+		require.NoError(t, features.OperatorControllerFeatureGate.Set(fmt.Sprintf("%s=true", features.ControllerManagedRBAC)))
+		defer func() {
+			require.NoError(t, features.OperatorControllerFeatureGate.Set(fmt.Sprintf("%s=false", features.ControllerManagedRBAC)))
+		}()
+
+		existing := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testCE.Spec.Namespace, Name: testCE.Spec.ServiceAccount.Name, Labels: map[string]string{"pre-existing": "true"}},
+		}
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing).Build()
+		helmApplier := newApplier(cl, func(context.Context, user.Info, io.Reader, ...authorization.UserAuthorizerAttributesFactory) ([]authorization.ScopedPolicyRules, error) {
+			// Only the regular preflight permission check should run, since the
+			// ServiceAccount already exists.
+			return nil, nil
+		})
+
+		_, _, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		require.NoError(t, err)
+
+		sa := &corev1.ServiceAccount{}
+		require.NoError(t, cl.Get(context.TODO(), client.ObjectKey{Namespace: testCE.Spec.Namespace, Name: testCE.Spec.ServiceAccount.Name}, sa))
+		require.Equal(t, "true", sa.Labels["pre-existing"])
+		require.NotContains(t, sa.Labels, "object")
+
+		roleName := testCE.Spec.ServiceAccount.Name + "-managed-permissions"
+		err = cl.Get(context.TODO(), client.ObjectKey{Name: roleName}, &rbacv1.ClusterRole{})
+		require.True(t, apierrors.IsNotFound(err))
+	})
+}
+
 func TestApply_Upgrade(t *testing.T) {
 	testCurrentRelease := &release.Release{
 		Info: &release.Info{Status: release.StatusDeployed},
@@ -564,11 +714,12 @@ func TestApply_Upgrade(t *testing.T) {
 			HelmChartProvider:  DummyHelmChartProvider,
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "attempting to dry-run upgrade chart")
 		require.False(t, installSucceeded)
 		require.Empty(t, installStatus)
+		require.False(t, mockAcg.realUpgradeCalled, "a rejected dry-run must prevent the real upgrade from ever being attempted")
 	})
 
 	t.Run("fails during pre-flight upgrade", func(t *testing.T) {
@@ -588,7 +739,7 @@ func TestApply_Upgrade(t *testing.T) {
 			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "upgrade pre-flight check")
 		require.False(t, installSucceeded)
@@ -611,7 +762,7 @@ func TestApply_Upgrade(t *testing.T) {
 			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "upgrading chart")
 		require.False(t, installSucceeded)
@@ -635,7 +786,7 @@ func TestApply_Upgrade(t *testing.T) {
 			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.Error(t, err)
 		require.ErrorContains(t, err, "reconciling charts")
 		require.False(t, installSucceeded)
@@ -659,13 +810,459 @@ func TestApply_Upgrade(t *testing.T) {
 			},
 		}
 
-		installSucceeded, installStatus, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		installSucceeded, installStatus, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.NoError(t, err)
 		require.True(t, installSucceeded)
 		require.Empty(t, installStatus)
 	})
 }
 
+func TestApply_PruneBehavior(t *testing.T) {
+	// Simulates objects that a previous bundle revision created but the
+	// current revision no longer renders, e.g. a Deployment that was
+	// renamed and the Role it used to need.
+	removedObjectsManifest := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-renamed
+  namespace: ns-a
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: controller-role
+  namespace: ns-a`
+
+	run := func(t *testing.T, pruneBehavior ocv1.PruneBehavior) postrender.PostRenderer {
+		testCurrentRelease := &release.Release{Info: &release.Info{Status: release.StatusDeployed}}
+		testDesiredRelease := *testCurrentRelease
+		testDesiredRelease.Manifest = validManifest
+
+		mockAcg := &mockActionGetter{
+			currentRel: testCurrentRelease,
+			desiredRel: &testDesiredRelease,
+		}
+		helmApplier := applier.Helm{
+			ActionClientGetter:            mockAcg,
+			HelmChartProvider:             DummyHelmChartProvider,
+			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
+			Manager: &mockManagedContentCacheManager{
+				cache: &mockManagedContentCache{},
+			},
+		}
+
+		ce := *testCE
+		ce.Spec.Install = &ocv1.ClusterExtensionInstallConfig{PruneBehavior: pruneBehavior}
+
+		_, _, _, err := helmApplier.Apply(context.TODO(), validFS, &ce, testObjectLabels, testStorageLabels)
+		require.NoError(t, err)
+		require.NotNil(t, mockAcg.gotUpgradePostRenderer)
+		return mockAcg.gotUpgradePostRenderer
+	}
+
+	t.Run("Orphan marks removed Deployments and RBAC objects with Helm's keep policy", func(t *testing.T) {
+		post := run(t, ocv1.PruneBehaviorOrphan)
+
+		rendered, err := post.Run(bytes.NewBufferString(removedObjectsManifest))
+		require.NoError(t, err)
+
+		objs, err := util.ManifestObjects(rendered, "test")
+		require.NoError(t, err)
+		require.Len(t, objs, 2)
+		for _, obj := range objs {
+			u, ok := obj.(*unstructured.Unstructured)
+			require.True(t, ok)
+			require.Equal(t, kube.KeepPolicy, u.GetAnnotations()[kube.ResourcePolicyAnno])
+		}
+	})
+
+	t.Run("Delete leaves removed objects without the keep policy", func(t *testing.T) {
+		post := run(t, ocv1.PruneBehaviorDelete)
+
+		rendered, err := post.Run(bytes.NewBufferString(removedObjectsManifest))
+		require.NoError(t, err)
+
+		objs, err := util.ManifestObjects(rendered, "test")
+		require.NoError(t, err)
+		require.Len(t, objs, 2)
+		for _, obj := range objs {
+			u, ok := obj.(*unstructured.Unstructured)
+			require.True(t, ok)
+			require.NotContains(t, u.GetAnnotations(), kube.ResourcePolicyAnno)
+		}
+	})
+}
+
+func TestApply_DisableOpenAPIValidation(t *testing.T) {
+	t.Run("install propagates DisableOpenAPIValidation to the Helm action", func(t *testing.T) {
+		mockAcg := &mockActionGetter{
+			getClientErr: driver.ErrReleaseNotFound,
+			desiredRel:   &release.Release{Info: &release.Info{Status: release.StatusDeployed}, Manifest: validManifest},
+		}
+		helmApplier := applier.Helm{
+			ActionClientGetter:            mockAcg,
+			HelmChartProvider:             DummyHelmChartProvider,
+			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
+			Manager: &mockManagedContentCacheManager{
+				cache: &mockManagedContentCache{},
+			},
+			DisableOpenAPIValidation: true,
+		}
+
+		_, _, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		require.NoError(t, err)
+		require.True(t, mockAcg.gotInstallDisableOpenAPIValidation)
+	})
+
+	t.Run("upgrade propagates DisableOpenAPIValidation to the Helm action", func(t *testing.T) {
+		testCurrentRelease := &release.Release{Info: &release.Info{Status: release.StatusDeployed}}
+		testDesiredRelease := *testCurrentRelease
+		testDesiredRelease.Manifest = validManifest
+
+		mockAcg := &mockActionGetter{
+			currentRel: testCurrentRelease,
+			desiredRel: &testDesiredRelease,
+		}
+		helmApplier := applier.Helm{
+			ActionClientGetter:            mockAcg,
+			HelmChartProvider:             DummyHelmChartProvider,
+			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
+			Manager: &mockManagedContentCacheManager{
+				cache: &mockManagedContentCache{},
+			},
+			DisableOpenAPIValidation: true,
+		}
+
+		_, _, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		require.NoError(t, err)
+		require.True(t, mockAcg.gotUpgradeDisableOpenAPIValidation)
+	})
+}
+
+func TestApply_Exclude(t *testing.T) {
+	manifest := `apiVersion: policy/v1
+kind: PodDisruptionBudget
+metadata:
+  name: bundled-pdb
+  namespace: ns-a
+---
+apiVersion: policy/v1
+kind: PodDisruptionBudget
+metadata:
+  name: other-pdb
+  namespace: ns-a
+---
+apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: bundled-netpol
+  namespace: ns-a
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller
+  namespace: ns-a`
+
+	run := func(t *testing.T, exclude []ocv1.ResourceExclusion) postrender.PostRenderer {
+		testCurrentRelease := &release.Release{Info: &release.Info{Status: release.StatusDeployed}}
+		testDesiredRelease := *testCurrentRelease
+		testDesiredRelease.Manifest = validManifest
+
+		mockAcg := &mockActionGetter{
+			currentRel: testCurrentRelease,
+			desiredRel: &testDesiredRelease,
+		}
+		helmApplier := applier.Helm{
+			ActionClientGetter:            mockAcg,
+			HelmChartProvider:             DummyHelmChartProvider,
+			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
+			Manager: &mockManagedContentCacheManager{
+				cache: &mockManagedContentCache{},
+			},
+		}
+
+		ce := *testCE
+		ce.Spec.Install = &ocv1.ClusterExtensionInstallConfig{Exclude: exclude}
+
+		_, _, _, err := helmApplier.Apply(context.TODO(), validFS, &ce, testObjectLabels, testStorageLabels)
+		require.NoError(t, err)
+		require.NotNil(t, mockAcg.gotUpgradePostRenderer)
+		return mockAcg.gotUpgradePostRenderer
+	}
+
+	t.Run("drops every object of a matching group/kind", func(t *testing.T) {
+		post := run(t, []ocv1.ResourceExclusion{{Group: "policy", Kind: "PodDisruptionBudget"}})
+
+		rendered, err := post.Run(bytes.NewBufferString(manifest))
+		require.NoError(t, err)
+
+		objs, err := util.ManifestObjects(rendered, "test")
+		require.NoError(t, err)
+		require.Len(t, objs, 2)
+		for _, obj := range objs {
+			require.NotEqual(t, "PodDisruptionBudget", obj.GetObjectKind().GroupVersionKind().Kind)
+		}
+	})
+
+	t.Run("drops only the named object when name is set", func(t *testing.T) {
+		post := run(t, []ocv1.ResourceExclusion{{Group: "policy", Kind: "PodDisruptionBudget", Name: "bundled-pdb"}})
+
+		rendered, err := post.Run(bytes.NewBufferString(manifest))
+		require.NoError(t, err)
+
+		objs, err := util.ManifestObjects(rendered, "test")
+		require.NoError(t, err)
+		require.Len(t, objs, 3)
+		for _, obj := range objs {
+			require.NotEqual(t, "bundled-pdb", obj.GetName())
+		}
+	})
+
+	t.Run("leaves everything untouched when unset", func(t *testing.T) {
+		post := run(t, nil)
+
+		rendered, err := post.Run(bytes.NewBufferString(manifest))
+		require.NoError(t, err)
+
+		objs, err := util.ManifestObjects(rendered, "test")
+		require.NoError(t, err)
+		require.Len(t, objs, 4)
+	})
+}
+
+func TestApply_CreateNamespace(t *testing.T) {
+	newApplier := func(cl client.Client) (applier.Helm, *mockActionGetter) {
+		mockAcg := &mockActionGetter{
+			getClientErr: driver.ErrReleaseNotFound,
+			desiredRel:   &release.Release{Info: &release.Info{Status: release.StatusDeployed}, Manifest: validManifest},
+		}
+		return applier.Helm{
+			ActionClientGetter:            mockAcg,
+			HelmChartProvider:             DummyHelmChartProvider,
+			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
+			Manager: &mockManagedContentCacheManager{
+				cache: &mockManagedContentCache{},
+			},
+			Client: cl,
+		}, mockAcg
+	}
+
+	t.Run("creates the install namespace with the configured labels when it doesn't exist", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		helmApplier, _ := newApplier(cl)
+
+		ce := *testCE
+		ce.Spec.Install = &ocv1.ClusterExtensionInstallConfig{
+			CreateNamespace: &ocv1.CreateNamespaceConfig{
+				Labels: map[string]string{"pod-security.kubernetes.io/enforce": "restricted"},
+			},
+		}
+
+		_, _, _, err := helmApplier.Apply(context.TODO(), validFS, &ce, testObjectLabels, testStorageLabels)
+		require.NoError(t, err)
+
+		ns := &corev1.Namespace{}
+		require.NoError(t, cl.Get(context.TODO(), client.ObjectKey{Name: ce.Spec.Namespace}, ns))
+		require.Equal(t, "restricted", ns.Labels["pod-security.kubernetes.io/enforce"])
+		require.Equal(t, testObjectLabels["object"], ns.Labels["object"])
+	})
+
+	t.Run("leaves a pre-existing namespace untouched", func(t *testing.T) {
+		existing := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: testCE.Spec.Namespace, Labels: map[string]string{"pre-existing": "true"}},
+		}
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing).Build()
+		helmApplier, _ := newApplier(cl)
+
+		ce := *testCE
+		ce.Spec.Install = &ocv1.ClusterExtensionInstallConfig{CreateNamespace: &ocv1.CreateNamespaceConfig{}}
+
+		_, _, _, err := helmApplier.Apply(context.TODO(), validFS, &ce, testObjectLabels, testStorageLabels)
+		require.NoError(t, err)
+
+		ns := &corev1.Namespace{}
+		require.NoError(t, cl.Get(context.TODO(), client.ObjectKey{Name: ce.Spec.Namespace}, ns))
+		require.Equal(t, "true", ns.Labels["pre-existing"])
+		require.NotContains(t, ns.Labels, "object")
+	})
+
+	t.Run("does nothing when unset", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		helmApplier, _ := newApplier(cl)
+
+		_, _, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		require.NoError(t, err)
+
+		err = cl.Get(context.TODO(), client.ObjectKey{Name: testCE.Spec.Namespace}, &corev1.Namespace{})
+		require.True(t, apierrors.IsNotFound(err))
+	})
+}
+
+func TestUninstall(t *testing.T) {
+	newApplier := func(cl client.Client, mockAcg *mockActionGetter) applier.Helm {
+		return applier.Helm{
+			ActionClientGetter:            mockAcg,
+			HelmReleaseToObjectsConverter: applier.HelmReleaseToObjectsConverter{},
+			Client:                        cl,
+		}
+	}
+
+	t.Run("does nothing when there is no release", func(t *testing.T) {
+		mockAcg := &mockActionGetter{getClientErr: driver.ErrReleaseNotFound}
+		helmApplier := newApplier(fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(), mockAcg)
+
+		require.NoError(t, helmApplier.Uninstall(context.TODO(), testCE))
+		require.Empty(t, mockAcg.gotUninstallKeepHistory)
+	})
+
+	t.Run("triggers deletion and reports that it's waiting on the first reconcile", func(t *testing.T) {
+		mockAcg := &mockActionGetter{
+			currentRel: &release.Release{Info: &release.Info{Status: release.StatusDeployed}, Manifest: validManifest},
+		}
+		helmApplier := newApplier(fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(), mockAcg)
+
+		err := helmApplier.Uninstall(context.TODO(), testCE)
+		require.Error(t, err)
+		require.Equal(t, []bool{true}, mockAcg.gotUninstallKeepHistory)
+	})
+
+	t.Run("reports how many release objects remain while they're still being deleted", func(t *testing.T) {
+		remaining := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "service-a", Namespace: "ns-a", Finalizers: []string{"example.com/keep-around"}}}
+		mockAcg := &mockActionGetter{
+			currentRel: &release.Release{Info: &release.Info{Status: release.StatusUninstalled}, Manifest: validManifest},
+		}
+		helmApplier := newApplier(fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(remaining).Build(), mockAcg)
+
+		err := helmApplier.Uninstall(context.TODO(), testCE)
+		require.ErrorContains(t, err, "waiting for 1 release object(s) to be deleted")
+		require.ErrorContains(t, err, "blocked by finalizers")
+		require.Empty(t, mockAcg.gotUninstallKeepHistory)
+	})
+
+	t.Run("purges the release once every object is gone", func(t *testing.T) {
+		mockAcg := &mockActionGetter{
+			currentRel: &release.Release{Info: &release.Info{Status: release.StatusUninstalled}, Manifest: validManifest},
+		}
+		helmApplier := newApplier(fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(), mockAcg)
+
+		require.NoError(t, helmApplier.Uninstall(context.TODO(), testCE))
+		require.Equal(t, []bool{false}, mockAcg.gotUninstallKeepHistory)
+	})
+
+	t.Run("creates the bundle's pre-uninstall hook job and waits for it before uninstalling", func(t *testing.T) {
+		mockAcg := &mockActionGetter{
+			currentRel: &release.Release{Info: &release.Info{Status: release.StatusDeployed}, Manifest: preUninstallHookManifest},
+		}
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		helmApplier := newApplier(cl, mockAcg)
+
+		err := helmApplier.Uninstall(context.TODO(), testCE)
+		require.ErrorContains(t, err, "running pre-uninstall hooks")
+		require.ErrorContains(t, err, `waiting for pre-uninstall hook job "ns-a/deregister" to complete`)
+		require.Empty(t, mockAcg.gotUninstallKeepHistory)
+
+		job := &batchv1.Job{}
+		require.NoError(t, cl.Get(context.TODO(), client.ObjectKey{Namespace: "ns-a", Name: "deregister"}, job))
+	})
+
+	t.Run("proceeds with uninstall once the pre-uninstall hook job has completed", func(t *testing.T) {
+		mockAcg := &mockActionGetter{
+			currentRel: &release.Release{Info: &release.Info{Status: release.StatusDeployed}, Manifest: preUninstallHookManifest},
+		}
+		completedJob := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "deregister", Namespace: "ns-a"},
+			Status:     batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}},
+		}
+		helmApplier := newApplier(fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(completedJob).Build(), mockAcg)
+
+		err := helmApplier.Uninstall(context.TODO(), testCE)
+		require.ErrorContains(t, err, "waiting for release")
+		require.NotContains(t, err.Error(), "pre-uninstall")
+		require.Equal(t, []bool{true}, mockAcg.gotUninstallKeepHistory)
+	})
+
+	t.Run("skips the pre-uninstall hook job when the force-skip annotation is set", func(t *testing.T) {
+		mockAcg := &mockActionGetter{
+			currentRel: &release.Release{Info: &release.Info{Status: release.StatusDeployed}, Manifest: preUninstallHookManifest},
+		}
+		helmApplier := newApplier(fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(), mockAcg)
+
+		ce := *testCE
+		ce.Annotations = map[string]string{labels.ForceSkipUninstallHookKey: "true"}
+
+		err := helmApplier.Uninstall(context.TODO(), &ce)
+		require.ErrorContains(t, err, "waiting for release")
+		require.Equal(t, []bool{true}, mockAcg.gotUninstallKeepHistory)
+	})
+
+	crdManifest := `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  group: example.com
+  names:
+    kind: Widget
+    listKind: WidgetList
+    plural: widgets
+    singular: widget
+  scope: Namespaced
+  versions:
+  - name: v1
+    served: true
+    storage: true
+    schema:
+      openAPIV3Schema:
+        type: object`
+
+	newWidget := func() *unstructured.Unstructured {
+		widget := &unstructured.Unstructured{}
+		widget.SetAPIVersion("example.com/v1")
+		widget.SetKind("Widget")
+		widget.SetName("my-widget")
+		widget.SetNamespace("ns-a")
+		return widget
+	}
+
+	t.Run("refuses deletion while a custom resource of an owned CRD still exists", func(t *testing.T) {
+		mockAcg := &mockActionGetter{
+			currentRel: &release.Release{Info: &release.Info{Status: release.StatusDeployed}, Manifest: crdManifest},
+		}
+		helmApplier := newApplier(fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(newWidget()).Build(), mockAcg)
+
+		err := helmApplier.Uninstall(context.TODO(), testCE)
+		require.ErrorContains(t, err, `custom resource(s) of CRD "widgets.example.com" still exist`)
+		require.Empty(t, mockAcg.gotUninstallKeepHistory)
+	})
+
+	t.Run("proceeds with deletion when forceDeletion is set even though a custom resource still exists", func(t *testing.T) {
+		mockAcg := &mockActionGetter{
+			currentRel: &release.Release{Info: &release.Info{Status: release.StatusDeployed}, Manifest: crdManifest},
+		}
+		helmApplier := newApplier(fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(newWidget()).Build(), mockAcg)
+
+		ce := *testCE
+		forceDeletion := true
+		ce.Spec.Install = &ocv1.ClusterExtensionInstallConfig{ForceDeletion: &forceDeletion}
+
+		err := helmApplier.Uninstall(context.TODO(), &ce)
+		require.ErrorContains(t, err, "waiting for release")
+		require.Equal(t, []bool{true}, mockAcg.gotUninstallKeepHistory)
+	})
+
+	t.Run("proceeds with deletion when no custom resources of the owned CRD exist", func(t *testing.T) {
+		mockAcg := &mockActionGetter{
+			currentRel: &release.Release{Info: &release.Info{Status: release.StatusDeployed}, Manifest: crdManifest},
+		}
+		helmApplier := newApplier(fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(), mockAcg)
+
+		err := helmApplier.Uninstall(context.TODO(), testCE)
+		require.ErrorContains(t, err, "waiting for release")
+		require.Equal(t, []bool{true}, mockAcg.gotUninstallKeepHistory)
+	})
+}
+
 func TestApply_RegistryV1ToChartConverterIntegration(t *testing.T) {
 	t.Run("generates bundle resources in AllNamespaces install mode", func(t *testing.T) {
 		helmApplier := applier.Helm{
@@ -688,7 +1285,7 @@ func TestApply_RegistryV1ToChartConverterIntegration(t *testing.T) {
 			},
 		}
 
-		_, _, _ = helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		_, _, _, _ = helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 	})
 
 	t.Run("surfaces chart generation errors", func(t *testing.T) {
@@ -710,11 +1307,40 @@ func TestApply_RegistryV1ToChartConverterIntegration(t *testing.T) {
 			},
 		}
 
-		_, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
+		_, _, _, err := helmApplier.Apply(context.TODO(), validFS, testCE, testObjectLabels, testStorageLabels)
 		require.ErrorContains(t, err, "some error")
 	})
 }
 
+func TestRefreshHealth(t *testing.T) {
+	t.Run("returns an error when the release cannot be found", func(t *testing.T) {
+		mockAcg := &mockActionGetter{getClientErr: driver.ErrReleaseNotFound}
+		helmApplier := applier.Helm{ActionClientGetter: mockAcg}
+
+		_, err := helmApplier.RefreshHealth(context.TODO(), testCE)
+		require.ErrorContains(t, err, "failed to get current release")
+	})
+
+	t.Run("evaluates health from the live cluster state without reconciling", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		mockAcg := &mockActionGetter{
+			currentRel: &release.Release{Info: &release.Info{Status: release.StatusDeployed}, Manifest: validManifest},
+		}
+		helmApplier := applier.Helm{
+			ActionClientGetter:            mockAcg,
+			HelmReleaseToObjectsConverter: mockHelmReleaseToObjectsConverter{},
+			Client:                        cl,
+		}
+
+		result, err := helmApplier.RefreshHealth(context.TODO(), testCE)
+		require.NoError(t, err)
+		require.True(t, result.Evaluated)
+		require.Empty(t, mockAcg.gotUninstallKeepHistory)
+		require.False(t, mockAcg.realInstallCalled)
+		require.False(t, mockAcg.realUpgradeCalled)
+	})
+}
+
 type FakeHelmChartProvider struct {
 	fn func(fs.FS, *ocv1.ClusterExtension) (*chart.Chart, error)
 }
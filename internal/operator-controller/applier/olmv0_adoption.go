@@ -0,0 +1,93 @@
+package applier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// olmv0CSVOwnerKind is the OLMv0 owner-kind label value set on resources
+	// managed by a ClusterServiceVersion's install strategy.
+	olmv0CSVOwnerKind = "ClusterServiceVersion"
+
+	// olmv0OwnerKindLabel, olmv0OwnerNameLabel, and olmv0OwnerNamespaceLabel
+	// are the labels OLMv0 sets on resources it manages on behalf of a
+	// ClusterServiceVersion.
+	olmv0OwnerKindLabel      = "olm.owner.kind"
+	olmv0OwnerNameLabel      = "olm.owner"
+	olmv0OwnerNamespaceLabel = "olm.owner.namespace"
+)
+
+// isOLMv0OwnedBy reports whether obj is labeled as managed by the OLMv0
+// ClusterServiceVersion identified by csvName/csvNamespace. Matching on the
+// owner name and namespace, not just the owner-kind label, ensures adoption
+// only ever reassigns resources actually owned by the CSV being migrated,
+// not resources that happen to collide with some unrelated OLMv0 operator's
+// CSV (e.g. cluster-scoped resources with generic names).
+func isOLMv0OwnedBy(obj client.Object, csvName, csvNamespace string) bool {
+	labels := obj.GetLabels()
+	return labels[olmv0OwnerKindLabel] == olmv0CSVOwnerKind &&
+		labels[olmv0OwnerNameLabel] == csvName &&
+		labels[olmv0OwnerNamespaceLabel] == csvNamespace
+}
+
+// AdoptOLMv0Resources prepares objs to be taken over by a ClusterExtension
+// from the OLMv0 ClusterServiceVersion named csvName in csvNamespace, which
+// is the CSV that the bundle being installed is migrating from. For each
+// object that already exists on the cluster and is owned by that specific
+// CSV, it strips the OLMv0 ownership labels and any ownerReferences pointing
+// at the owning CSV, so that the object is no longer considered OLMv0-managed
+// and a subsequent apply can take over field management instead of failing
+// because the object already exists. Objects that don't exist yet, or are
+// owned by a different CSV (OLMv0 or otherwise unrelated), are left
+// untouched.
+func AdoptOLMv0Resources(ctx context.Context, cl client.Client, objs []client.Object, csvName, csvNamespace string) error {
+	if csvName == "" {
+		return errors.New("adopting OLMv0 resources requires the name of the ClusterServiceVersion being migrated")
+	}
+
+	logger := klog.FromContext(ctx)
+	var errs []error
+	for _, obj := range objs {
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+		if err := cl.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			errs = append(errs, fmt.Errorf("getting %s %s/%s: %w", live.GetKind(), obj.GetNamespace(), obj.GetName(), err))
+			continue
+		}
+		if !isOLMv0OwnedBy(live, csvName, csvNamespace) {
+			continue
+		}
+
+		logger.Info("adopting resource from OLMv0", "kind", live.GetKind(), "namespace", live.GetNamespace(), "name", live.GetName())
+
+		labels := live.GetLabels()
+		delete(labels, olmv0OwnerKindLabel)
+		delete(labels, olmv0OwnerNameLabel)
+		delete(labels, olmv0OwnerNamespaceLabel)
+		live.SetLabels(labels)
+
+		ownerRefs := live.GetOwnerReferences()
+		keep := ownerRefs[:0]
+		for _, ref := range ownerRefs {
+			if ref.Kind != olmv0CSVOwnerKind {
+				keep = append(keep, ref)
+			}
+		}
+		live.SetOwnerReferences(keep)
+
+		if err := cl.Update(ctx, live); err != nil {
+			errs = append(errs, fmt.Errorf("adopting %s %s/%s from OLMv0: %w", live.GetKind(), live.GetNamespace(), live.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
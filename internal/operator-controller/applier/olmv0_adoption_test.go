@@ -0,0 +1,97 @@
+package applier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/applier"
+)
+
+func deploymentStub(namespace, name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("apps/v1")
+	u.SetKind("Deployment")
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	return u
+}
+
+func TestAdoptOLMv0Resources(t *testing.T) {
+	olmv0Owned := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "olmv0-owned",
+			Labels: map[string]string{
+				"olm.owner":           "my-csv",
+				"olm.owner.namespace": "default",
+				"olm.owner.kind":      "ClusterServiceVersion",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "operators.coreos.com/v1alpha1", Kind: "ClusterServiceVersion", Name: "my-csv", UID: "abc"},
+			},
+		},
+	}
+	unrelated := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "unrelated"},
+	}
+	ownedByOtherCSV := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "owned-by-other-csv",
+			Labels: map[string]string{
+				"olm.owner":           "someone-elses-csv",
+				"olm.owner.namespace": "default",
+				"olm.owner.kind":      "ClusterServiceVersion",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "operators.coreos.com/v1alpha1", Kind: "ClusterServiceVersion", Name: "someone-elses-csv", UID: "def"},
+			},
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(olmv0Owned, unrelated, ownedByOtherCSV).Build()
+
+	err := applier.AdoptOLMv0Resources(context.Background(), cl, []client.Object{
+		deploymentStub("default", "olmv0-owned"),
+		deploymentStub("default", "unrelated"),
+		deploymentStub("default", "does-not-exist"),
+		deploymentStub("default", "owned-by-other-csv"),
+	}, "my-csv", "default")
+	require.NoError(t, err)
+
+	var adopted appsv1.Deployment
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "olmv0-owned"}, &adopted))
+	require.NotContains(t, adopted.Labels, "olm.owner")
+	require.NotContains(t, adopted.Labels, "olm.owner.namespace")
+	require.NotContains(t, adopted.Labels, "olm.owner.kind")
+	require.Empty(t, adopted.OwnerReferences)
+
+	var stillUnrelated appsv1.Deployment
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "unrelated"}, &stillUnrelated))
+	require.Empty(t, stillUnrelated.Labels)
+
+	// A resource owned by a different OLMv0 CSV must be left alone: it belongs to an unrelated
+	// operator that merely happens to render a colliding GVK/namespace/name, not the CSV this
+	// bundle is actually migrating from.
+	var stillOwnedByOtherCSV appsv1.Deployment
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "owned-by-other-csv"}, &stillOwnedByOtherCSV))
+	require.Equal(t, "someone-elses-csv", stillOwnedByOtherCSV.Labels["olm.owner"])
+	require.NotEmpty(t, stillOwnedByOtherCSV.OwnerReferences)
+}
+
+func TestAdoptOLMv0ResourcesRequiresCSVName(t *testing.T) {
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+	err := applier.AdoptOLMv0Resources(context.Background(), cl, []client.Object{
+		deploymentStub("default", "some-object"),
+	}, "", "default")
+	require.Error(t, err)
+}
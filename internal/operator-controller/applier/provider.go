@@ -7,6 +7,7 @@ import (
 	"io/fs"
 
 	"helm.sh/helm/v3/pkg/chart"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -31,6 +32,11 @@ type RegistryV1ManifestProvider struct {
 	CertificateProvider         render.CertificateProvider
 	IsWebhookSupportEnabled     bool
 	IsSingleOwnNamespaceEnabled bool
+	TrustedCABundleConfigMap    string
+	ProxyEnv                    []corev1.EnvVar
+	DefaultNodeSelector         map[string]string
+	DefaultTolerations          []corev1.Toleration
+	ImageMirror                 map[string]string
 }
 
 func (r *RegistryV1ManifestProvider) Get(bundleFS fs.FS, ext *ocv1.ClusterExtension) ([]client.Object, error) {
@@ -66,6 +72,12 @@ func (r *RegistryV1ManifestProvider) Get(bundleFS fs.FS, ext *ocv1.ClusterExtens
 
 	opts := []render.Option{
 		render.WithCertificateProvider(r.CertificateProvider),
+		render.WithNetworkPolicy(ext.Spec.Install != nil && ext.Spec.Install.NetworkPolicy != nil && ext.Spec.Install.NetworkPolicy.Enabled),
+		render.WithTrustedCABundleConfigMap(r.TrustedCABundleConfigMap),
+		render.WithProxyEnv(r.ProxyEnv),
+		render.WithDefaultNodeSelector(r.DefaultNodeSelector),
+		render.WithDefaultTolerations(r.DefaultTolerations),
+		render.WithImageMirror(r.ImageMirror),
 	}
 
 	if r.IsSingleOwnNamespaceEnabled {
@@ -108,6 +120,10 @@ func (r *RegistryV1HelmChartProvider) Get(bundleFS fs.FS, ext *ocv1.ClusterExten
 		return nil, err
 	}
 	chrt.Metadata.Annotations = rv1.CSV.GetAnnotations()
+	// Stash the bundle's own CSV name in the chart metadata so that callers needing to
+	// identify the specific OLMv0 ClusterServiceVersion this bundle is migrating from
+	// (e.g. AdoptOLMv0Resources) don't need to reload and re-parse the bundle themselves.
+	chrt.Metadata.Name = rv1.CSV.Name
 	for _, obj := range objs {
 		jsonData, err := json.Marshal(obj)
 		if err != nil {
@@ -17,6 +17,7 @@ import (
 type TokenGetter struct {
 	client             corev1.ServiceAccountsGetter
 	expirationDuration time.Duration
+	audiences          []string
 	tokens             map[types.NamespacedName]*authenticationv1.TokenRequestStatus
 	mu                 sync.RWMutex
 }
@@ -66,6 +67,14 @@ func WithExpirationDuration(expirationDuration time.Duration) TokenGetterOption
 	}
 }
 
+// WithAudiences sets the audiences that requested tokens will be valid for.
+// If unset, the token is valid for the audience of the API server.
+func WithAudiences(audiences []string) TokenGetterOption {
+	return func(tg *TokenGetter) {
+		tg.audiences = audiences
+	}
+}
+
 // Get returns a token from the cache if available and not expiring, otherwise creates a new token
 func (t *TokenGetter) Get(ctx context.Context, key types.NamespacedName) (string, error) {
 	t.mu.RLock()
@@ -100,7 +109,10 @@ func (t *TokenGetter) getToken(ctx context.Context, key types.NamespacedName) (*
 	req, err := t.client.ServiceAccounts(key.Namespace).CreateToken(ctx,
 		key.Name,
 		&authenticationv1.TokenRequest{
-			Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: ptr.To(int64(t.expirationDuration / time.Second))},
+			Spec: authenticationv1.TokenRequestSpec{
+				Audiences:         t.audiences,
+				ExpirationSeconds: ptr.To(int64(t.expirationDuration / time.Second)),
+			},
 		}, metav1.CreateOptions{})
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -87,3 +87,28 @@ func TestTokenGetterGet(t *testing.T) {
 		}
 	}
 }
+
+func TestTokenGetterGetRequestsConfiguredAudiences(t *testing.T) {
+	var gotAudiences []string
+	fakeClient := fake.NewSimpleClientset()
+	fakeClient.PrependReactor("create", "serviceaccounts/token",
+		func(action ctest.Action) (bool, runtime.Object, error) {
+			act, ok := action.(ctest.CreateActionImpl)
+			if !ok {
+				return false, nil, nil
+			}
+			tokenRequest := act.GetObject().(*authenticationv1.TokenRequest)
+			gotAudiences = tokenRequest.Spec.Audiences
+			tokenRequest.Status = authenticationv1.TokenRequestStatus{
+				Token:               "test-token",
+				ExpirationTimestamp: metav1.NewTime(metav1.Now().Add(DefaultExpirationDuration)),
+			}
+			return true, tokenRequest, nil
+		})
+
+	tg := NewTokenGetter(fakeClient.CoreV1(), WithAudiences([]string{"api", "olm"}))
+
+	_, err := tg.Get(context.Background(), types.NamespacedName{Namespace: "test-namespace", Name: "test-service-account"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"api", "olm"}, gotAudiences)
+}
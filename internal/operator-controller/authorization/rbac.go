@@ -31,6 +31,7 @@ import (
 	"k8s.io/kubernetes/pkg/registry/rbac/validation"
 	rbac "k8s.io/kubernetes/plugin/pkg/auth/authorizer/rbac"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 )
 
 // UserAuthorizerAttributesFactory is a function that produces a slice of AttributesRecord for user
@@ -57,6 +58,23 @@ type ScopedPolicyRules struct {
 	MissingRules []rbacv1.PolicyRule
 }
 
+// MissingRulesError wraps a pre-authorization failure that includes missing
+// RBAC rules, so that a caller can render those rules on their own (e.g. as
+// ready-to-apply Role/ClusterRole YAML for a status field) instead of only
+// having the flattened error text.
+type MissingRulesError struct {
+	Err          error
+	MissingRules []ScopedPolicyRules
+}
+
+func (e *MissingRulesError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *MissingRulesError) Unwrap() error {
+	return e.Err
+}
+
 var objectVerbs = []string{"get", "patch", "update", "delete"}
 
 // Here we are splitting collection verbs based on required scope
@@ -650,6 +668,40 @@ func hasAggregationRule(clusterRole *rbacv1.ClusterRole) bool {
 	return clusterRole.AggregationRule != nil && len(clusterRole.AggregationRule.ClusterRoleSelectors) > 0
 }
 
+// RenderMissingRulesYAML renders each entry of missingRules as a ready-to-apply
+// Role (namespace-scoped) or ClusterRole (Namespace == "") YAML document,
+// named "<namePrefix>-missing-permissions", so that the exact rules a
+// PreAuthorizer found missing can be handed to a cluster admin to apply
+// directly instead of transcribed by hand from an error message.
+func RenderMissingRulesYAML(namePrefix string, missingRules []ScopedPolicyRules) ([]string, error) {
+	docs := make([]string, 0, len(missingRules))
+	for _, scoped := range missingRules {
+		objectMeta := metav1.ObjectMeta{Name: namePrefix + "-missing-permissions"}
+		var obj interface{}
+		if scoped.Namespace == "" {
+			objectMeta.Name = "cluster-" + objectMeta.Name
+			obj = &rbacv1.ClusterRole{
+				TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
+				ObjectMeta: objectMeta,
+				Rules:      scoped.MissingRules,
+			}
+		} else {
+			objectMeta.Namespace = scoped.Namespace
+			obj = &rbacv1.Role{
+				TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "Role"},
+				ObjectMeta: objectMeta,
+				Rules:      scoped.MissingRules,
+			}
+		}
+		doc, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("rendering missing permissions for namespace %q: %w", scoped.Namespace, err)
+		}
+		docs = append(docs, string(doc))
+	}
+	return docs, nil
+}
+
 func mapSlice[I, O any](in []I, f func(I) O) []O {
 	out := make([]O, len(in))
 	for i := range in {
@@ -757,3 +757,33 @@ func (m mockRulesResolver) RulesFor(ctx context.Context, user user.Info, namespa
 func (m mockRulesResolver) VisitRulesFor(ctx context.Context, user user.Info, namespace string, visitor func(source fmt.Stringer, rule *rbacv1.PolicyRule, err error) bool) {
 	panic("unimplemented")
 }
+
+func TestRenderMissingRulesYAML(t *testing.T) {
+	missingRules := []ScopedPolicyRules{
+		{
+			Namespace: "test-namespace",
+			MissingRules: []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"serviceaccounts"}, Verbs: []string{"create"}},
+			},
+		},
+		{
+			Namespace: "",
+			MissingRules: []rbacv1.PolicyRule{
+				{APIGroups: []string{"apiextensions.k8s.io"}, Resources: []string{"customresourcedefinitions"}, Verbs: []string{"list", "watch"}},
+			},
+		},
+	}
+
+	docs, err := RenderMissingRulesYAML("my-extension", missingRules)
+	require.NoError(t, err)
+	require.Len(t, docs, 2)
+
+	require.Contains(t, docs[0], "kind: Role")
+	require.Contains(t, docs[0], "namespace: test-namespace")
+	require.Contains(t, docs[0], "name: my-extension-missing-permissions")
+	require.Contains(t, docs[0], "serviceaccounts")
+
+	require.Contains(t, docs[1], "kind: ClusterRole")
+	require.Contains(t, docs[1], "name: cluster-my-extension-missing-permissions")
+	require.Contains(t, docs[1], "customresourcedefinitions")
+}
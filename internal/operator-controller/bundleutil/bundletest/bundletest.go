@@ -0,0 +1,97 @@
+// Package bundletest provides a fluent builder for declcfg.Bundle fixtures, so controller and
+// resolver unit tests can construct bundles (and the channel/deprecation entries that go with
+// them) without hand-writing olm.package property JSON blobs.
+package bundletest
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+)
+
+// Builder accumulates a declcfg.Bundle fixture along with the declcfg.ChannelEntry and, if
+// WithDeprecation was used, declcfg.DeprecationEntry that callers assembling a full channel or
+// catalog fixture around the bundle will also need.
+type Builder struct {
+	bundle     declcfg.Bundle
+	entry      declcfg.ChannelEntry
+	deprecated *declcfg.DeprecationEntry
+}
+
+// Option configures a Builder.
+type Option func(*Builder)
+
+// NewBundle returns a Builder for a bundle named name, with opts applied in order.
+func NewBundle(name string, opts ...Option) *Builder {
+	b := &Builder{
+		bundle: declcfg.Bundle{Schema: declcfg.SchemaBundle, Name: name},
+		entry:  declcfg.ChannelEntry{Name: name},
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// WithPackage sets the bundle's package name.
+func WithPackage(pkg string) Option {
+	return func(b *Builder) {
+		b.bundle.Package = pkg
+	}
+}
+
+// WithVersion adds an olm.package property for version. The bundle's package name must already be
+// set via WithPackage.
+func WithVersion(version string) Option {
+	return func(b *Builder) {
+		b.bundle.Properties = append(b.bundle.Properties, property.MustBuildPackage(b.bundle.Package, version))
+	}
+}
+
+// WithReplaces records that this bundle's channel entry replaces the bundle named replacedName.
+func WithReplaces(replacedName string) Option {
+	return func(b *Builder) {
+		b.entry.Replaces = replacedName
+	}
+}
+
+// WithDeprecation records that this bundle is deprecated with the given message. Callers include
+// the resulting Deprecation() entry in a declcfg.Deprecation for the bundle's package.
+func WithDeprecation(message string) Option {
+	return func(b *Builder) {
+		b.deprecated = &declcfg.DeprecationEntry{
+			Reference: declcfg.PackageScopedReference{Schema: declcfg.SchemaBundle, Name: b.bundle.Name},
+			Message:   message,
+		}
+	}
+}
+
+// WithProperty adds an arbitrary property of type propType to the bundle, JSON-encoding value, for
+// fixtures that need a property not covered by one of the other With* options.
+func WithProperty(propType string, value any) Option {
+	return func(b *Builder) {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			panic(fmt.Sprintf("bundletest: marshalling property %q: %v", propType, err))
+		}
+		b.bundle.Properties = append(b.bundle.Properties, property.Property{Type: propType, Value: raw})
+	}
+}
+
+// Build returns the built declcfg.Bundle.
+func (b *Builder) Build() declcfg.Bundle {
+	return b.bundle
+}
+
+// Entry returns the declcfg.ChannelEntry for this bundle, for callers assembling a declcfg.Channel.
+func (b *Builder) Entry() declcfg.ChannelEntry {
+	return b.entry
+}
+
+// Deprecation returns this bundle's declcfg.DeprecationEntry, or nil if WithDeprecation wasn't
+// used.
+func (b *Builder) Deprecation() *declcfg.DeprecationEntry {
+	return b.deprecated
+}
@@ -0,0 +1,43 @@
+package bundletest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+)
+
+func TestBuilder(t *testing.T) {
+	b := NewBundle("pkg.v1.0.1",
+		WithPackage("pkg"),
+		WithVersion("1.0.1"),
+		WithReplaces("pkg.v1.0.0"),
+		WithDeprecation("superseded"),
+		WithProperty(property.TypeGVK, property.GVK{Group: "example.com", Kind: "Foo", Version: "v1"}),
+	)
+
+	bundle := b.Build()
+	assert.Equal(t, "pkg.v1.0.1", bundle.Name)
+	assert.Equal(t, "pkg", bundle.Package)
+	require.Len(t, bundle.Properties, 2)
+	assert.Equal(t, property.TypePackage, bundle.Properties[0].Type)
+	assert.Equal(t, property.TypeGVK, bundle.Properties[1].Type)
+
+	entry := b.Entry()
+	assert.Equal(t, "pkg.v1.0.1", entry.Name)
+	assert.Equal(t, "pkg.v1.0.0", entry.Replaces)
+
+	dep := b.Deprecation()
+	require.NotNil(t, dep)
+	assert.Equal(t, declcfg.SchemaBundle, dep.Reference.Schema)
+	assert.Equal(t, "pkg.v1.0.1", dep.Reference.Name)
+	assert.Equal(t, "superseded", dep.Message)
+}
+
+func TestBuilderWithoutDeprecation(t *testing.T) {
+	b := NewBundle("pkg.v1.0.0", WithPackage("pkg"), WithVersion("1.0.0"))
+	assert.Nil(t, b.Deprecation())
+}
@@ -6,21 +6,49 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/operator-framework/operator-registry/alpha/declcfg"
 
 	"github.com/operator-framework/operator-controller/internal/operator-controller/catalogmetadata/client"
+	fsutil "github.com/operator-framework/operator-controller/internal/shared/util/fs"
 )
 
 var _ client.Cache = &filesystemCache{}
 
-func NewFilesystemCache(cachePath string) *filesystemCache {
-	return &filesystemCache{
+// cacheRefFileSuffix names a sidecar file, kept alongside (not inside) each catalog's cache
+// directory, recording the resolvedRef that directory was populated from. It lets a fresh
+// process rebuild its view of what's already on disk - and reuse it - instead of treating every
+// catalog as a cache miss after a restart.
+const cacheRefFileSuffix = ".ref"
+
+// FilesystemCacheOption configures a filesystemCache created by NewFilesystemCache.
+type FilesystemCacheOption func(*filesystemCache)
+
+// WithMaxCacheSizeBytes caps the total size, in bytes, of cached catalog content kept on disk.
+// Once a Put pushes the cache over the cap, catalogs are evicted least-recently-used first
+// (tracked by each catalog's cache directory modification time) until it's back under the cap.
+// A cap of zero, the default, leaves the cache unbounded.
+func WithMaxCacheSizeBytes(maxCacheSizeBytes int64) FilesystemCacheOption {
+	return func(fsc *filesystemCache) {
+		fsc.maxCacheSizeBytes = maxCacheSizeBytes
+	}
+}
+
+func NewFilesystemCache(cachePath string, opts ...FilesystemCacheOption) *filesystemCache {
+	fsc := &filesystemCache{
 		cachePath:              cachePath,
 		mutex:                  sync.RWMutex{},
 		cacheDataByCatalogName: map[string]cacheData{},
 	}
+	for _, opt := range opts {
+		opt(fsc)
+	}
+	fsc.rebuildFromDisk()
+	return fsc
 }
 
 // cacheData holds information about a catalog
@@ -38,9 +66,33 @@ type cacheData struct {
 type filesystemCache struct {
 	mutex                  sync.RWMutex
 	cachePath              string
+	maxCacheSizeBytes      int64
 	cacheDataByCatalogName map[string]cacheData
 }
 
+// rebuildFromDisk populates cacheDataByCatalogName from whatever catalog directories are
+// already present in cachePath, so content left behind by a previous process (after a restart,
+// for example) is recognized as cached rather than re-fetched. A catalog directory without a
+// matching, readable ref file is left untracked; the next Put for that catalog name will simply
+// overwrite it, same as if the cache were empty.
+func (fsc *filesystemCache) rebuildFromDisk() {
+	entries, err := os.ReadDir(fsc.cachePath)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		catalogName := entry.Name()
+		if !entry.IsDir() || strings.HasPrefix(catalogName, ".") {
+			continue
+		}
+		ref, err := os.ReadFile(fsc.refPath(catalogName))
+		if err != nil {
+			continue
+		}
+		fsc.cacheDataByCatalogName[catalogName] = cacheData{Ref: string(ref)}
+	}
+}
+
 // Put writes content from source to the filesystem and stores errToCache
 // for a specified catalog name and version (resolvedRef).
 //
@@ -62,17 +114,21 @@ func (fsc *filesystemCache) Put(catalogName, resolvedRef string, source io.Reade
 
 	var cacheFS fs.FS
 	if errToCache == nil {
-		cacheFS, errToCache = fsc.writeFS(catalogName, source)
+		cacheFS, errToCache = fsc.writeFS(catalogName, resolvedRef, source)
 	}
 	fsc.cacheDataByCatalogName[catalogName] = cacheData{
 		Ref:   resolvedRef,
 		Error: errToCache,
 	}
 
+	if errToCache == nil {
+		fsc.evictLRU()
+	}
+
 	return cacheFS, errToCache
 }
 
-func (fsc *filesystemCache) writeFS(catalogName string, source io.Reader) (fs.FS, error) {
+func (fsc *filesystemCache) writeFS(catalogName, resolvedRef string, source io.Reader) (fs.FS, error) {
 	cacheDir := fsc.cacheDir(catalogName)
 
 	tmpDir, err := os.MkdirTemp(fsc.cachePath, fmt.Sprintf(".%s-", catalogName))
@@ -110,10 +166,63 @@ func (fsc *filesystemCache) writeFS(catalogName string, source io.Reader) (fs.FS
 	if err := os.Rename(tmpDir, cacheDir); err != nil {
 		return nil, fmt.Errorf("error moving temporary directory to cache directory: %v", err)
 	}
+	if err := os.WriteFile(fsc.refPath(catalogName), []byte(resolvedRef), 0600); err != nil {
+		return nil, fmt.Errorf("error writing cache metadata for catalog: %v", err)
+	}
 
 	return os.DirFS(cacheDir), nil
 }
 
+// evictLRU removes whole catalog caches, least-recently-used first, until the cache is back
+// under maxCacheSizeBytes. Recency is tracked by each catalog's cache directory modification
+// time, which is refreshed on every Get hit and Put, so it doubles as a last-used timestamp
+// without needing separate bookkeeping. Catalogs currently cached as an error (no directory on
+// disk) are never eviction candidates. Must be called with fsc.mutex held for writing.
+func (fsc *filesystemCache) evictLRU() {
+	if fsc.maxCacheSizeBytes <= 0 {
+		return
+	}
+
+	total, err := fsutil.DirectorySize(fsc.cachePath)
+	if err != nil || total <= fsc.maxCacheSizeBytes {
+		return
+	}
+
+	type candidate struct {
+		catalogName string
+		lastUsed    time.Time
+	}
+	candidates := make([]candidate, 0, len(fsc.cacheDataByCatalogName))
+	for catalogName, data := range fsc.cacheDataByCatalogName {
+		if data.Error != nil {
+			continue
+		}
+		lastUsed, err := fsutil.GetDirectoryModTime(fsc.cacheDir(catalogName))
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, candidate{catalogName: catalogName, lastUsed: lastUsed})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].lastUsed.Before(candidates[j].lastUsed)
+	})
+
+	for _, c := range candidates {
+		if total <= fsc.maxCacheSizeBytes {
+			return
+		}
+		freed, err := fsutil.DirectorySize(fsc.cacheDir(c.catalogName))
+		if err != nil {
+			continue
+		}
+		if err := fsc.removeFromDisk(c.catalogName); err != nil {
+			continue
+		}
+		delete(fsc.cacheDataByCatalogName, c.catalogName)
+		total -= freed
+	}
+}
+
 // Get returns cache for a specified catalog name and version (resolvedRef).
 //
 // Method behaviour is as follows:
@@ -135,6 +244,8 @@ func (fsc *filesystemCache) get(catalogName, resolvedRef string) (fs.FS, error)
 			if data.Error != nil {
 				return nil, data.Error
 			}
+			now := time.Now()
+			_ = os.Chtimes(cacheDir, now, now)
 			return os.DirFS(cacheDir), nil
 		}
 	}
@@ -144,8 +255,6 @@ func (fsc *filesystemCache) get(catalogName, resolvedRef string) (fs.FS, error)
 
 // Remove deletes cache directory for a given catalog from the filesystem
 func (fsc *filesystemCache) Remove(catalogName string) error {
-	cacheDir := fsc.cacheDir(catalogName)
-
 	fsc.mutex.Lock()
 	defer fsc.mutex.Unlock()
 
@@ -153,7 +262,7 @@ func (fsc *filesystemCache) Remove(catalogName string) error {
 		return nil
 	}
 
-	if err := os.RemoveAll(cacheDir); err != nil {
+	if err := fsc.removeFromDisk(catalogName); err != nil {
 		return fmt.Errorf("error removing cache directory: %v", err)
 	}
 
@@ -161,6 +270,21 @@ func (fsc *filesystemCache) Remove(catalogName string) error {
 	return nil
 }
 
+// removeFromDisk deletes a catalog's cache directory and its ref sidecar file. The ref file is
+// removed best-effort: its absence is not an error, since it may simply never have existed (an
+// error-only Put never writes one).
+func (fsc *filesystemCache) removeFromDisk(catalogName string) error {
+	if err := os.RemoveAll(fsc.cacheDir(catalogName)); err != nil {
+		return err
+	}
+	_ = os.Remove(fsc.refPath(catalogName))
+	return nil
+}
+
 func (fsc *filesystemCache) cacheDir(catalogName string) string {
 	return filepath.Join(fsc.cachePath, catalogName)
 }
+
+func (fsc *filesystemCache) refPath(catalogName string) string {
+	return filepath.Join(fsc.cachePath, catalogName+cacheRefFileSuffix)
+}
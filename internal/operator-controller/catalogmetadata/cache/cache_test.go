@@ -12,6 +12,7 @@ import (
 	"strings"
 	"testing"
 	"testing/fstest"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
@@ -169,6 +170,89 @@ func TestFilesystemCacheRemove(t *testing.T) {
 	assert.NoDirExists(t, catalogCachePath)
 }
 
+func TestFilesystemCacheSurvivesRestart(t *testing.T) {
+	const (
+		catalogName = "test-catalog"
+		resolvedRef = "fake/catalog@sha256:fakesha"
+	)
+
+	cacheDir := t.TempDir()
+	c := cache.NewFilesystemCache(cacheDir)
+	_, err := c.Put(catalogName, resolvedRef, defaultContent(), nil)
+	require.NoError(t, err)
+
+	t.Log("A fresh cache instance over the same directory, simulating a process restart, " +
+		"should recognize the existing content instead of treating it as a miss")
+	restarted := cache.NewFilesystemCache(cacheDir)
+	actualFS, err := restarted.Get(catalogName, resolvedRef)
+	require.NoError(t, err)
+	require.NotNil(t, actualFS)
+	require.NoError(t, equalFilesystems(defaultFS(), actualFS))
+
+	t.Log("A stale ref is still treated as a miss after restart")
+	actualFS, err = restarted.Get(catalogName, "fake/catalog@sha256:someotherdigest")
+	require.NoError(t, err)
+	require.Nil(t, actualFS)
+}
+
+func TestFilesystemCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	// Size the cap to fit one catalog's worth of content but not two, so adding the second
+	// catalog forces exactly one eviction.
+	probeDir := t.TempDir()
+	require.NoError(t, writeFileTree(t, probeDir, "catalog-a", defaultFS()))
+	oneCatalogSize := dirSize(t, probeDir)
+
+	cacheDir := t.TempDir()
+	c := cache.NewFilesystemCache(cacheDir, cache.WithMaxCacheSizeBytes(oneCatalogSize+1))
+
+	_, err := c.Put("catalog-a", "ref-a", defaultContent(), nil)
+	require.NoError(t, err)
+	// Ensure catalog-b's cache directory gets a strictly later modification time than
+	// catalog-a's, so it isn't the one picked as least-recently-used below.
+	time.Sleep(10 * time.Millisecond)
+
+	t.Log("Putting a second catalog exceeds the cap and evicts the older, less-recently-used one")
+	_, err = c.Put("catalog-b", "ref-b", defaultContent(), nil)
+	require.NoError(t, err)
+
+	actualFS, err := c.Get("catalog-b", "ref-b")
+	require.NoError(t, err)
+	require.NotNil(t, actualFS, "the catalog just written should survive its own Put")
+
+	actualFS, err = c.Get("catalog-a", "ref-a")
+	require.NoError(t, err)
+	require.Nil(t, actualFS, "the least-recently-used catalog should have been evicted")
+}
+
+func writeFileTree(t *testing.T, root, catalogName string, files fstest.MapFS) error {
+	t.Helper()
+	for path, f := range files {
+		fullPath := filepath.Join(root, catalogName, path)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0700); err != nil {
+			return err
+		}
+		if err := os.WriteFile(fullPath, f.Data, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dirSize(t *testing.T, path string) int64 {
+	t.Helper()
+	var total int64
+	require.NoError(t, filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			total += info.Size()
+		}
+		return nil
+	}))
+	return total
+}
+
 func equalFilesystems(expected, actual fs.FS) error {
 	normalizeJSON := func(data []byte) []byte {
 		var v interface{}
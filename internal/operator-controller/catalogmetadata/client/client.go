@@ -8,6 +8,7 @@ import (
 	"io/fs"
 	"net/http"
 	"net/url"
+	"sync"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -50,23 +51,45 @@ type Cache interface {
 
 func New(cache Cache, httpClient func() (*http.Client, error)) *Client {
 	return &Client{
-		cache:      cache,
-		httpClient: httpClient,
+		cache:             cache,
+		httpClient:        httpClient,
+		packagesByCatalog: map[string]*packageIndex{},
 	}
 }
 
+// packageIndex is the in-memory package -> parsed FBC index for a single catalog generation
+// (identified by resolvedRef). It's filled in lazily, one package at a time, as extensions are
+// reconciled, rather than eagerly parsing every package in the catalog up front.
+type packageIndex struct {
+	resolvedRef string
+	packages    map[string]*declcfg.DeclarativeConfig
+}
+
 // Client is reading catalog metadata
 type Client struct {
 	cache      Cache
 	httpClient func() (*http.Client, error)
+
+	// mu guards packagesByCatalog, which memoizes parsed per-package FBC so that many
+	// ClusterExtensions resolving against the same catalog generation don't each re-parse the same
+	// package's metadata from disk on every reconcile. It's keyed by catalog name; the nested
+	// packageIndex is discarded and rebuilt incrementally, package by package, whenever a catalog's
+	// resolvedRef changes, so it never serves stale entries from a previous catalog generation.
+	mu                sync.RWMutex
+	packagesByCatalog map[string]*packageIndex
 }
 
 func (c *Client) GetPackage(ctx context.Context, catalog *ocv1.ClusterCatalog, pkgName string) (*declcfg.DeclarativeConfig, error) {
 	if err := validateCatalog(catalog); err != nil {
 		return nil, err
 	}
+	resolvedRef := catalog.Status.ResolvedSource.Image.Ref
+
+	if pkgFBC := c.lookupPackage(catalog.Name, resolvedRef, pkgName); pkgFBC != nil {
+		return pkgFBC, nil
+	}
 
-	catalogFsys, err := c.cache.Get(catalog.Name, catalog.Status.ResolvedSource.Image.Ref)
+	catalogFsys, err := c.cache.Get(catalog.Name, resolvedRef)
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving cache for catalog %q: %v", catalog.Name, err)
 	}
@@ -79,7 +102,9 @@ func (c *Client) GetPackage(ctx context.Context, catalog *ocv1.ClusterCatalog, p
 		if !errors.Is(err, fs.ErrNotExist) {
 			return nil, fmt.Errorf("error getting package %q: %v", pkgName, err)
 		}
-		return &declcfg.DeclarativeConfig{}, nil
+		pkgFBC := &declcfg.DeclarativeConfig{}
+		c.indexPackage(catalog.Name, resolvedRef, pkgName, pkgFBC)
+		return pkgFBC, nil
 	}
 
 	pkgFBC, err := declcfg.LoadFS(ctx, pkgFsys)
@@ -87,11 +112,39 @@ func (c *Client) GetPackage(ctx context.Context, catalog *ocv1.ClusterCatalog, p
 		if !errors.Is(err, fs.ErrNotExist) {
 			return nil, fmt.Errorf("error loading package %q: %v", pkgName, err)
 		}
-		return &declcfg.DeclarativeConfig{}, nil
+		pkgFBC = &declcfg.DeclarativeConfig{}
 	}
+	c.indexPackage(catalog.Name, resolvedRef, pkgName, pkgFBC)
 	return pkgFBC, nil
 }
 
+// lookupPackage returns the already-indexed FBC for pkgName in the given catalog generation, or
+// nil if it hasn't been indexed yet (either because it's never been requested, or because the
+// catalog has moved on to a newer generation).
+func (c *Client) lookupPackage(catalogName, resolvedRef, pkgName string) *declcfg.DeclarativeConfig {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	idx, ok := c.packagesByCatalog[catalogName]
+	if !ok || idx.resolvedRef != resolvedRef {
+		return nil
+	}
+	return idx.packages[pkgName]
+}
+
+// indexPackage records pkgFBC as the parsed content for pkgName in the given catalog generation.
+// If the catalog has moved on to a newer generation since the last index update, the stale index
+// is discarded and rebuilding starts from this package.
+func (c *Client) indexPackage(catalogName, resolvedRef, pkgName string, pkgFBC *declcfg.DeclarativeConfig) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	idx, ok := c.packagesByCatalog[catalogName]
+	if !ok || idx.resolvedRef != resolvedRef {
+		idx = &packageIndex{resolvedRef: resolvedRef, packages: map[string]*declcfg.DeclarativeConfig{}}
+		c.packagesByCatalog[catalogName] = idx
+	}
+	idx.packages[pkgName] = pkgFBC
+}
+
 func (c *Client) PopulateCache(ctx context.Context, catalog *ocv1.ClusterCatalog) (fs.FS, error) {
 	if err := validateCatalog(catalog); err != nil {
 		return nil, err
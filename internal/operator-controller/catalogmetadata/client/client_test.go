@@ -136,6 +136,31 @@ func TestClientGetPackage(t *testing.T) {
 	}
 }
 
+func TestClientGetPackageIndexesPerGeneration(t *testing.T) {
+	testFS := fstest.MapFS{
+		"pkg-present/olm.package/pkg-present.json": &fstest.MapFile{Data: []byte(`{"schema": "olm.package","name": "pkg-present"}`)},
+	}
+	newHTTPClient := func() (*http.Client, error) {
+		return &http.Client{Transport: &fakeTripper{resp: &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}}}, nil
+	}
+
+	cache := &fakeCache{getFS: testFS}
+	c := catalogClient.New(cache, newHTTPClient)
+	catalog := defaultCatalog()
+
+	_, err := c.GetPackage(context.Background(), catalog, "pkg-present")
+	require.NoError(t, err)
+	_, err = c.GetPackage(context.Background(), catalog, "pkg-present")
+	require.NoError(t, err)
+	assert.Equal(t, 1, cache.getCalls, "second request for the same package in the same catalog generation should be served from the in-memory index")
+
+	// A new catalog generation (different resolvedRef) must not be served from the stale index.
+	catalog.Status.ResolvedSource.Image.Ref = "fake/catalog@sha256:newsha"
+	_, err = c.GetPackage(context.Background(), catalog, "pkg-present")
+	require.NoError(t, err)
+	assert.Equal(t, 2, cache.getCalls, "a new catalog generation should not be served from the previous generation's index")
+}
+
 func TestClientPopulateCache(t *testing.T) {
 	testFS := fstest.MapFS{
 		"pkg-present/olm.package/pkg-present.json": &fstest.MapFile{Data: []byte(`{"schema": "olm.package","name": "pkg-present"}`)},
@@ -265,11 +290,15 @@ func TestClientPopulateCache(t *testing.T) {
 type fakeCache struct {
 	getFS  fs.FS
 	getErr error
+	// getCalls counts Get invocations, so tests can assert that the in-memory package index
+	// avoided re-reading the filesystem cache for a package already indexed this generation.
+	getCalls int
 
 	putFunc func(source string, errToCache error) (fs.FS, error)
 }
 
 func (c *fakeCache) Get(catalogName, resolvedRef string) (fs.FS, error) {
+	c.getCalls++
 	return c.getFS, c.getErr
 }
 
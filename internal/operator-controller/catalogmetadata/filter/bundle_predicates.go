@@ -5,6 +5,7 @@ import (
 
 	"github.com/operator-framework/operator-registry/alpha/declcfg"
 
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/bundle"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/bundleutil"
 	"github.com/operator-framework/operator-controller/internal/shared/util/filter"
@@ -36,6 +37,38 @@ func InSemverRange(versionRange bsemver.Range) filter.Predicate[declcfg.Bundle]
 	}
 }
 
+// WhitelistedUpgradeEdge returns a predicate that matches bundles whose version equals the
+// toVersion of an override edge whose fromVersion equals installedVersion. It lets a
+// ClusterExtensionUpgradeEdgeOverride whitelist a version jump that the catalog's own replaces,
+// skips, or skipRange graph doesn't otherwise permit. An edge with an unparseable fromVersion or
+// toVersion is ignored.
+func WhitelistedUpgradeEdge(installedVersion bsemver.Version, overrides []ocv1.UpgradeEdgeOverride) filter.Predicate[declcfg.Bundle] {
+	var toVersions []bsemver.Version
+	for _, edge := range overrides {
+		fromVersion, err := bsemver.Parse(edge.FromVersion)
+		if err != nil || !fromVersion.EQ(installedVersion) {
+			continue
+		}
+		toVersion, err := bsemver.Parse(edge.ToVersion)
+		if err != nil {
+			continue
+		}
+		toVersions = append(toVersions, toVersion)
+	}
+	return func(b declcfg.Bundle) bool {
+		vr, err := bundleutil.GetVersionAndRelease(b)
+		if err != nil {
+			return false
+		}
+		for _, v := range toVersions {
+			if vr.Version.EQ(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 func InAnyChannel(channels ...declcfg.Channel) filter.Predicate[declcfg.Bundle] {
 	return func(bundle declcfg.Bundle) bool {
 		for _, ch := range channels {
@@ -4,12 +4,14 @@ import (
 	"encoding/json"
 	"testing"
 
+	bsemver "github.com/blang/semver/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/operator-framework/operator-registry/alpha/declcfg"
 	"github.com/operator-framework/operator-registry/alpha/property"
 
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/catalogmetadata/compare"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/catalogmetadata/filter"
 )
@@ -68,3 +70,30 @@ func TestInAnyChannel(t *testing.T) {
 	assert.False(t, fStable(b2))
 	assert.False(t, fStable(b3))
 }
+
+func TestWhitelistedUpgradeEdge(t *testing.T) {
+	bundleAt := func(version string) declcfg.Bundle {
+		return declcfg.Bundle{
+			Properties: []property.Property{
+				{
+					Type:  property.TypePackage,
+					Value: json.RawMessage(`{"packageName": "package1", "version": "` + version + `"}`),
+				},
+			},
+		}
+	}
+
+	installedVersion := bsemver.MustParse("1.0.0")
+	overrides := []ocv1.UpgradeEdgeOverride{
+		{FromVersion: "1.0.0", ToVersion: "1.2.0"},
+		{FromVersion: "2.0.0", ToVersion: "3.0.0"},
+		{FromVersion: "1.0.0", ToVersion: "not-a-semver"},
+	}
+
+	f := filter.WhitelistedUpgradeEdge(installedVersion, overrides)
+
+	assert.True(t, f(bundleAt("1.2.0")), "whitelisted edge from the installed version matches")
+	assert.False(t, f(bundleAt("3.0.0")), "edge whose fromVersion isn't the installed version is ignored")
+	assert.False(t, f(bundleAt("1.1.0")), "version not named by any edge doesn't match")
+	assert.False(t, f(bundleAt("broken")), "a bundle with an unparseable version never matches")
+}
@@ -2,6 +2,7 @@ package filter
 
 import (
 	"fmt"
+	"slices"
 
 	bsemver "github.com/blang/semver/v4"
 
@@ -9,6 +10,7 @@ import (
 
 	ocv1 "github.com/operator-framework/operator-controller/api/v1"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/bundle"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/bundleutil"
 	"github.com/operator-framework/operator-controller/internal/shared/util/filter"
 )
 
@@ -41,33 +43,10 @@ func legacySuccessor(installedBundle ocv1.BundleMetadata, channels ...declcfg.Ch
 		return nil, fmt.Errorf("error parsing installed bundle version: %w", err)
 	}
 
-	isSuccessor := func(candidateBundleEntry declcfg.ChannelEntry) bool {
-		if candidateBundleEntry.Replaces == installedBundle.Name {
-			return true
-		}
-		for _, skip := range candidateBundleEntry.Skips {
-			if skip == installedBundle.Name {
-				return true
-			}
-		}
-		if candidateBundleEntry.SkipRange != "" {
-			// There are differences between how "github.com/blang/semver/v4" and "github.com/Masterminds/semver/v3"
-			// handle version ranges. OLM v0 used blang and there might still be registry+v1 bundles that rely
-			// on those specific differences. Because OLM v1 supports registry+v1 bundles,
-			// blang needs to be kept alongside any other semver lib for range handling.
-			// see: https://github.com/operator-framework/operator-controller/pull/1565#issuecomment-2586455768
-			skipRange, err := bsemver.ParseRange(candidateBundleEntry.SkipRange)
-			if err == nil && skipRange(installedBundleVersion) {
-				return true
-			}
-		}
-		return false
-	}
-
 	return func(candidateBundle declcfg.Bundle) bool {
 		for _, ch := range channels {
 			for _, chEntry := range ch.Entries {
-				if candidateBundle.Name == chEntry.Name && isSuccessor(chEntry) {
+				if candidateBundle.Name == chEntry.Name && edgeReason(installedBundle, installedBundleVersion, chEntry) != "" {
 					return true
 				}
 			}
@@ -75,3 +54,90 @@ func legacySuccessor(installedBundle ocv1.BundleMetadata, channels ...declcfg.Ch
 		return false
 	}, nil
 }
+
+// edgeReason reports why entry is a catalog-provided upgrade edge from installedBundle, or ""
+// if it isn't one. When more than one of replaces/skips/skipRange matches, replaces takes
+// precedence over skips, which takes precedence over skipRange, matching the order OLM v0
+// itself checked them in.
+func edgeReason(installedBundle ocv1.BundleMetadata, installedBundleVersion bsemver.Version, entry declcfg.ChannelEntry) ocv1.UpgradeEdgeReason {
+	if entry.Replaces == installedBundle.Name {
+		return ocv1.UpgradeEdgeReasonReplaces
+	}
+	if slices.Contains(entry.Skips, installedBundle.Name) {
+		return ocv1.UpgradeEdgeReasonSkips
+	}
+	if entry.SkipRange != "" {
+		// There are differences between how "github.com/blang/semver/v4" and "github.com/Masterminds/semver/v3"
+		// handle version ranges. OLM v0 used blang and there might still be registry+v1 bundles that rely
+		// on those specific differences. Because OLM v1 supports registry+v1 bundles,
+		// blang needs to be kept alongside any other semver lib for range handling.
+		// see: https://github.com/operator-framework/operator-controller/pull/1565#issuecomment-2586455768
+		skipRange, err := bsemver.ParseRange(entry.SkipRange)
+		if err == nil && skipRange(installedBundleVersion) {
+			return ocv1.UpgradeEdgeReasonSkipRange
+		}
+	}
+	return ""
+}
+
+// UpgradeEdges returns every catalog-provided upgrade edge from installedBundle to a bundle in
+// bundles, as determined by the replaces/skips/skipRange entries across channels. Candidates
+// reachable via more than one channel entry are deduplicated by bundle name, keeping the
+// highest-precedence reason (see edgeReason). The result is sorted by descending bundle
+// version, and a candidate whose version can't be parsed, or that isn't present in bundles, is
+// silently omitted.
+func UpgradeEdges(installedBundle ocv1.BundleMetadata, bundles []declcfg.Bundle, channels ...declcfg.Channel) ([]ocv1.AvailableUpgradeEdge, error) {
+	installedBundleVersion, err := bsemver.Parse(installedBundle.Version)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing installed bundle version: %w", err)
+	}
+
+	reasonPrecedence := map[ocv1.UpgradeEdgeReason]int{
+		ocv1.UpgradeEdgeReasonReplaces:  0,
+		ocv1.UpgradeEdgeReasonSkips:     1,
+		ocv1.UpgradeEdgeReasonSkipRange: 2,
+	}
+
+	reasonFor := map[string]ocv1.UpgradeEdgeReason{}
+	for _, ch := range channels {
+		for _, entry := range ch.Entries {
+			reason := edgeReason(installedBundle, installedBundleVersion, entry)
+			if reason == "" {
+				continue
+			}
+			if existing, ok := reasonFor[entry.Name]; !ok || reasonPrecedence[reason] < reasonPrecedence[existing] {
+				reasonFor[entry.Name] = reason
+			}
+		}
+	}
+
+	var edges []ocv1.AvailableUpgradeEdge
+	for _, b := range bundles {
+		reason, ok := reasonFor[b.Name]
+		if !ok {
+			continue
+		}
+		versionRelease, err := bundleutil.GetVersionAndRelease(b)
+		if err != nil {
+			continue
+		}
+		edges = append(edges, ocv1.AvailableUpgradeEdge{
+			Bundle: ocv1.BundleMetadata{
+				Name:    b.Name,
+				Version: versionRelease.AsLegacyRegistryV1Version().String(),
+			},
+			Reason: reason,
+		})
+	}
+
+	slices.SortFunc(edges, func(a, b ocv1.AvailableUpgradeEdge) int {
+		av, aErr := bsemver.Parse(a.Bundle.Version)
+		bv, bErr := bsemver.Parse(b.Bundle.Version)
+		if aErr != nil || bErr != nil {
+			return 0
+		}
+		return bv.Compare(av)
+	})
+
+	return edges, nil
+}
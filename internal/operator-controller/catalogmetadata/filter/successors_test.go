@@ -240,3 +240,43 @@ func TestLegacySuccessor(t *testing.T) {
 	assert.True(t, f(b5))
 	assert.False(t, f(emptyBundle))
 }
+
+func TestUpgradeEdges(t *testing.T) {
+	const testPackageName = "test-package"
+
+	skipRangeOnlyChannel := declcfg.Channel{
+		Name:    "stable",
+		Package: testPackageName,
+		Entries: []declcfg.ChannelEntry{
+			{
+				Name:      "test-package.v2.4.0",
+				SkipRange: ">=2.3.0 <2.4.0",
+			},
+		},
+	}
+
+	bundles := []declcfg.Bundle{
+		{
+			Name:    "test-package.v2.4.0",
+			Package: testPackageName,
+			Image:   "registry.io/repo/test-package@v2.4.0",
+			Properties: []property.Property{
+				property.MustBuildPackage(testPackageName, "2.4.0"),
+			},
+		},
+	}
+
+	installedBundle := bundleutil.MetadataFor("test-package.v2.3.0", bsemver.MustParse("2.3.0"))
+
+	edges, err := UpgradeEdges(installedBundle, bundles, skipRangeOnlyChannel)
+	require.NoError(t, err)
+	require.Equal(t, []ocv1.AvailableUpgradeEdge{
+		{
+			Bundle: ocv1.BundleMetadata{
+				Name:    "test-package.v2.4.0",
+				Version: "2.4.0",
+			},
+			Reason: ocv1.UpgradeEdgeReasonSkipRange,
+		},
+	}, edges)
+}
@@ -31,6 +31,7 @@ var ConditionTypes = []string{
 	ocv1.TypeChannelDeprecated,
 	ocv1.TypeBundleDeprecated,
 	ocv1.TypeProgressing,
+	ocv1.TypeHealthy,
 }
 
 var ConditionReasons = []string{
@@ -41,7 +42,10 @@ var ConditionReasons = []string{
 	ocv1.ReasonFailed,
 	ocv1.ReasonBlocked,
 	ocv1.ReasonRetrying,
+	ocv1.ReasonUnpackTimeout,
 	ocv1.ReasonAbsent,
 	ocv1.ReasonRollingOut,
 	ocv1.ReasonProgressDeadlineExceeded,
+	ocv1.ReasonHealthUnknown,
+	ocv1.ReasonTimeout,
 }
@@ -15,6 +15,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/metrics"
 )
 
 type Watcher interface {
@@ -86,10 +88,11 @@ func (c *cache) Close() error {
 	defer c.mu.Unlock()
 
 	errs := []error{}
-	for _, source := range c.sources {
+	for gvk, source := range c.sources {
 		if err := source.Close(); err != nil {
 			errs = append(errs, err)
 		}
+		metrics.ManagedContentInformersActive.DeleteLabelValues(gvk.String())
 	}
 
 	slices.SortFunc(errs, func(a, b error) int {
@@ -184,6 +187,7 @@ func (c *cache) startNewSource(ctx context.Context, gvk schema.GroupVersionKind,
 func (c *cache) addSource(gvk schema.GroupVersionKind, source CloserSyncingSource) error {
 	if _, ok := c.sources[gvk]; !ok {
 		c.sources[gvk] = source
+		metrics.ManagedContentInformersActive.WithLabelValues(gvk.String()).Set(1)
 		return nil
 	}
 	return errors.New("source already exists")
@@ -215,6 +219,7 @@ func (c *cache) removeSource(gvk schema.GroupVersionKind) error {
 		}
 	}
 	delete(c.sources, gvk)
+	metrics.ManagedContentInformersActive.DeleteLabelValues(gvk.String())
 	return nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -15,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/metrics"
 )
 
 type mockWatcher struct {
@@ -181,6 +183,34 @@ func TestCacheWatchRemovesStaleSources(t *testing.T) {
 	require.NotContains(t, c.(*cache).sources, podGvk)
 }
 
+func TestCacheWatchReportsActiveInformerMetric(t *testing.T) {
+	c := NewCache(
+		&mockSourcerer{
+			source: &mockSource{},
+		},
+		&ocv1.ClusterExtension{},
+		time.Second,
+	)
+
+	pod := &corev1.Pod{}
+	podGvk := corev1.SchemeGroupVersion.WithKind("Pod")
+	pod.SetGroupVersionKind(podGvk)
+
+	require.NoError(t, c.Watch(context.Background(), &mockWatcher{}, pod))
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.ManagedContentInformersActive.WithLabelValues(podGvk.String())))
+
+	secret := &corev1.Secret{}
+	secretGvk := corev1.SchemeGroupVersion.WithKind("Secret")
+	secret.SetGroupVersionKind(secretGvk)
+	require.NoError(t, c.Watch(context.Background(), &mockWatcher{}, secret))
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.ManagedContentInformersActive.WithLabelValues(secretGvk.String())))
+	require.Equal(t, float64(0), testutil.ToFloat64(metrics.ManagedContentInformersActive.WithLabelValues(podGvk.String())),
+		"a GVK with no remaining source should report as inactive, not linger at its last value")
+
+	require.NoError(t, c.Close())
+	require.Equal(t, float64(0), testutil.ToFloat64(metrics.ManagedContentInformersActive.WithLabelValues(secretGvk.String())))
+}
+
 func TestCacheWatchRemovingStaleSourcesError(t *testing.T) {
 	c := NewCache(
 		&mockSourcerer{
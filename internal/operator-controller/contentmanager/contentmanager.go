@@ -106,6 +106,10 @@ func (i *managerImpl) Get(ctx context.Context, ce *ocv1.ClusterExtension) (cmcac
 		return nil, fmt.Errorf("getting dynamic client: %w", err)
 	}
 
+	// tgtLabels scopes every informer this Cache starts to only the resources owned by ce, via the
+	// owner labels the applier stamps onto every object it applies. Without this, a dynamic informer
+	// for e.g. Deployment or Secret would list/watch every Deployment or Secret cluster-wide just to
+	// find the handful this ClusterExtension owns.
 	tgtLabels := labels.Set{
 		oclabels.OwnerKindKey: ocv1.ClusterExtensionKind,
 		oclabels.OwnerNameKey: ce.GetName(),
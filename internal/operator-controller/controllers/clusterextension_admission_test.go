@@ -346,6 +346,7 @@ func TestClusterExtensionAdmissionInstallNamespace(t *testing.T) {
 func TestClusterExtensionAdmissionServiceAccount(t *testing.T) {
 	tooLongError := "spec.serviceAccount.name: Too long: may not be more than 253"
 	regexMismatchError := "name must be a valid DNS1123 subdomain"
+	oneOfErrMsg := "exactly one of [serviceAccount.name, install.impersonate] is required"
 
 	testCases := []struct {
 		name           string
@@ -357,7 +358,7 @@ func TestClusterExtensionAdmissionServiceAccount(t *testing.T) {
 		{"dot-separated", "dotted.name", ""},
 		{"longest valid service account name", strings.Repeat("x", 253), ""},
 		{"too long service account name", strings.Repeat("x", 254), tooLongError},
-		{"no service account name", "", regexMismatchError},
+		{"no service account name and no impersonation configured", "", oneOfErrMsg},
 		{"spaces", "spaces spaces", regexMismatchError},
 		{"capitalized", "Capitalized", regexMismatchError},
 		{"camel case", "camelCase", regexMismatchError},
@@ -397,6 +398,62 @@ func TestClusterExtensionAdmissionServiceAccount(t *testing.T) {
 	}
 }
 
+func TestClusterExtensionAdmissionIdentity(t *testing.T) {
+	oneOfErrMsg := "exactly one of [serviceAccount.name, install.impersonate] is required"
+
+	testCases := []struct {
+		name           string
+		serviceAccount string
+		impersonate    *ocv1.ImpersonationConfig
+		errMsg         string
+	}{
+		{"serviceAccount only", "default", nil, ""},
+		{"impersonate only", "", &ocv1.ImpersonationConfig{Username: "jane"}, ""},
+		{"impersonate with groups", "", &ocv1.ImpersonationConfig{Username: "jane", Groups: []string{"admins"}}, ""},
+		{"neither configured", "", nil, oneOfErrMsg},
+		{"both configured", "default", &ocv1.ImpersonationConfig{Username: "jane"}, oneOfErrMsg},
+	}
+
+	t.Parallel()
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cl := newClient(t)
+			var install *ocv1.ClusterExtensionInstallConfig
+			if tc.impersonate != nil {
+				install = &ocv1.ClusterExtensionInstallConfig{
+					Impersonate: tc.impersonate,
+					Preflight: &ocv1.PreflightConfig{
+						CRDUpgradeSafety: &ocv1.CRDUpgradeSafetyPreflightConfig{
+							Enforcement: ocv1.CRDUpgradeSafetyEnforcementNone,
+						},
+					},
+				}
+			}
+			err := cl.Create(context.Background(), buildClusterExtension(ocv1.ClusterExtensionSpec{
+				Source: ocv1.SourceConfig{
+					SourceType: "Catalog",
+					Catalog: &ocv1.CatalogFilter{
+						PackageName: "package",
+					},
+				},
+				Namespace: "default",
+				ServiceAccount: ocv1.ServiceAccountReference{
+					Name: tc.serviceAccount,
+				},
+				Install: install,
+			}))
+			if tc.errMsg == "" {
+				require.NoError(t, err, "unexpected error for identity configuration %+v/%+v: %w", tc.serviceAccount, tc.impersonate, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+		})
+	}
+}
+
 func TestClusterExtensionAdmissionInstall(t *testing.T) {
 	oneOfErrMsg := "at least one of [preflight] are required when install is specified"
 
@@ -21,17 +21,23 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"math"
 	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -48,14 +54,21 @@ import (
 	"github.com/operator-framework/operator-registry/alpha/declcfg"
 
 	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/applier"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/conditionsets"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/health"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/labels"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/metrics"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/notify"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/tracing"
 	k8sutil "github.com/operator-framework/operator-controller/internal/shared/util/k8s"
 )
 
 const (
 	ClusterExtensionCleanupUnpackCacheFinalizer         = "olm.operatorframework.io/cleanup-unpack-cache"
 	ClusterExtensionCleanupContentManagerCacheFinalizer = "olm.operatorframework.io/cleanup-contentmanager-cache"
+	ClusterExtensionCleanupInstallNamespaceFinalizer    = "olm.operatorframework.io/cleanup-install-namespace"
+	ClusterExtensionCleanupReleaseFinalizer             = "olm.operatorframework.io/cleanup-release"
 )
 
 type reconcileState struct {
@@ -74,6 +87,21 @@ type ReconcileStepFunc func(context.Context, *reconcileState, *ocv1.ClusterExten
 // Each step receives the shared state from previous steps, allowing data to flow through the pipeline.
 type ReconcileSteps []ReconcileStepFunc
 
+// TraceStage wraps step in an OTel span named stage, so a slow reconcile can be attributed to the
+// stage that caused it. stage is expected to be one of the pipeline's well-known stage names
+// (e.g. "resolve", "unpack", "apply") rather than a per-call label, so spans for the same stage
+// group together in a trace backend across every ClusterExtension and reconcile.
+func TraceStage(stage string, step ReconcileStepFunc) ReconcileStepFunc {
+	return func(ctx context.Context, state *reconcileState, ext *ocv1.ClusterExtension) (*ctrl.Result, error) {
+		ctx, span := tracing.StartStage(ctx, stage, ext.GetName())
+		defer span.End()
+
+		res, err := step(ctx, state, ext)
+		tracing.RecordError(span, err)
+		return res, err
+	}
+}
+
 // Reconcile executes a series of reconciliation steps in sequence for a ClusterExtension.
 // It takes a context and ClusterExtension object as input and executes each step in the ReconcileSteps slice.
 // If any step returns an error, reconciliation stops and the error is returned.
@@ -99,6 +127,149 @@ func (steps *ReconcileSteps) Reconcile(ctx context.Context, ext *ocv1.ClusterExt
 type ClusterExtensionReconciler struct {
 	client.Client
 	ReconcileSteps ReconcileSteps
+
+	// Notifier, when set, is told about transitions of the conditions listed in
+	// notifiableConditions into their "interesting" status, as soon as each one is
+	// observed. A nil Notifier disables notifications.
+	Notifier notify.Notifier
+
+	// failureLogState tracks, per ClusterExtension, the most recently logged reconcile error so
+	// that a hot failure loop logs once per failureLogInterval with a repeat count instead of
+	// flooding the log at every requeue. Keyed by types.NamespacedName.
+	failureLogState sync.Map
+
+	// statusWriteState tracks, per ClusterExtension, the last time its status was actually written
+	// to the API server, so a burst of rapid real status changes (e.g. a flapping Healthy
+	// condition) coalesces into at most one write per statusUpdateCoalesceInterval instead of a
+	// write - and the associated watch/informer traffic - on every single flap. Keyed by
+	// types.NamespacedName.
+	statusWriteState sync.Map
+
+	// RetryBaseDelay and RetryMaxDelay are used to estimate ClusterExtension.Status.NextRetryTime
+	// after a failed reconcile. They should match the base and max delay of the exponential
+	// rate limiter the controller is actually configured with (see WithRateLimiter), so the
+	// estimate doesn't drift from the workqueue's real requeue delay. Zero values fall back to
+	// defaultBackoffBaseDelay and defaultBackoffMaxDelay, which mirror the exponential component
+	// of workqueue.DefaultControllerRateLimiter(), the rate limiter controller-runtime uses when
+	// SetupWithManager isn't given a different one.
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+}
+
+// failureLogInterval is how often a repeating reconcile error is re-logged. Between occurrences
+// of the same error, only the repeat count in ClusterExtension.Status.FailureRepeatCount advances.
+const failureLogInterval = 5 * time.Minute
+
+// statusUpdateCoalesceInterval bounds how often Reconcile will write a real (non-no-op) status
+// change for the same ClusterExtension. A change observed before the interval has elapsed is held
+// back and re-evaluated on the next reconcile instead of written immediately, so a burst of rapid
+// real changes (e.g. a flapping Healthy condition) coalesces into one write instead of many.
+const statusUpdateCoalesceInterval = 2 * time.Second
+
+// statusUpdateWait returns how much longer Reconcile must wait before it's allowed to write a new
+// status change for key, based on the last time one was written. Zero means it may write now.
+func (r *ClusterExtensionReconciler) statusUpdateWait(key types.NamespacedName) time.Duration {
+	v, ok := r.statusWriteState.Load(key)
+	if !ok {
+		return 0
+	}
+	if wait := statusUpdateCoalesceInterval - time.Since(v.(time.Time)); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// defaultBackoffBaseDelay and defaultBackoffMaxDelay mirror the exponential component of
+// workqueue.DefaultControllerRateLimiter(), the rate limiter controller-runtime uses to requeue a
+// failed reconcile when SetupWithManager isn't given a different one. They're the fallback for
+// ClusterExtensionReconciler.RetryBaseDelay/RetryMaxDelay when left unset.
+const (
+	defaultBackoffBaseDelay = 5 * time.Millisecond
+	defaultBackoffMaxDelay  = 1000 * time.Second
+)
+
+// backoffDelay returns the estimated requeue delay for the count'th consecutive failure of the
+// same error, following the same doubling schedule as workqueue.ItemExponentialFailureRateLimiter.
+func (r *ClusterExtensionReconciler) backoffDelay(count int32) time.Duration {
+	if count <= 0 {
+		return 0
+	}
+	baseDelay, maxDelay := r.RetryBaseDelay, r.RetryMaxDelay
+	if baseDelay <= 0 {
+		baseDelay = defaultBackoffBaseDelay
+	}
+	if maxDelay <= 0 {
+		maxDelay = defaultBackoffMaxDelay
+	}
+	delay := float64(baseDelay) * math.Pow(2, float64(count-1))
+	if delay > float64(maxDelay) {
+		return maxDelay
+	}
+	return time.Duration(delay)
+}
+
+// failureLogEntry tracks the last-logged occurrence of a reconcile error for a single
+// ClusterExtension.
+type failureLogEntry struct {
+	message    string
+	generation int64
+	count      int32
+	loggedAt   time.Time
+}
+
+// recordReconcileError updates the reconciler's dedup state for key based on reconcileErr and the
+// ClusterExtension's current generation, logs the error via l if this is either the first
+// occurrence of this error message or failureLogInterval has elapsed since it was last logged, and
+// returns the running repeat count to store in ClusterExtension.Status.FailureRepeatCount along
+// with an estimate of when the next retry will happen to store in Status.NextRetryTime. A change in
+// generation resets the repeat count, since a spec change is a new attempt rather than a repeat of
+// the old one. A nil reconcileErr clears the dedup state and returns a zero count and a nil time.
+func (r *ClusterExtensionReconciler) recordReconcileError(key types.NamespacedName, l logr.Logger, reconcileErr error, generation int64) (int32, *metav1.Time) {
+	if reconcileErr == nil {
+		r.failureLogState.Delete(key)
+		return 0, nil
+	}
+
+	message := reconcileErr.Error()
+	entry := failureLogEntry{message: message, generation: generation}
+	if v, ok := r.failureLogState.Load(key); ok {
+		entry = v.(failureLogEntry)
+	}
+
+	sameFailure := entry.message == message && entry.generation == generation
+	if !sameFailure {
+		entry = failureLogEntry{message: message, generation: generation}
+	}
+	entry.count++
+
+	if !sameFailure || time.Since(entry.loggedAt) >= failureLogInterval {
+		l.Error(reconcileErr, "reconcile failed", "repeatCount", entry.count)
+		entry.loggedAt = time.Now()
+	}
+
+	r.failureLogState.Store(key, entry)
+	nextRetryTime := metav1.NewTime(time.Now().Add(r.backoffDelay(entry.count)))
+	return entry.count, &nextRetryTime
+}
+
+// statusSemanticallyEqual reports whether a and b would look the same to a user, ignoring fields
+// that tick on every reconcile even when nothing else changed: NextRetryTime (re-estimated from
+// time.Now() on every failed reconcile of the same error) and LastUnpackedTime (bumped on every
+// successful pull, cache hit or not). Reconcile uses this instead of a raw DeepEqual to avoid
+// writing a status update - and the associated watch/informer traffic across the fleet - for
+// reconciles that didn't actually change anything a user would see.
+func statusSemanticallyEqual(a, b *ocv1.ClusterExtensionStatus) bool {
+	if a.NextRetryTime == nil && b.NextRetryTime != nil || a.NextRetryTime != nil && b.NextRetryTime == nil {
+		return false
+	}
+	if a.LastUnpackedTime == nil && b.LastUnpackedTime != nil || a.LastUnpackedTime != nil && b.LastUnpackedTime == nil {
+		return false
+	}
+
+	aCopy, bCopy := a.DeepCopy(), b.DeepCopy()
+	aCopy.NextRetryTime, bCopy.NextRetryTime = nil, nil
+	aCopy.LastUnpackedTime, bCopy.LastUnpackedTime = nil, nil
+	return equality.Semantic.DeepEqual(aCopy, bCopy)
 }
 
 type StorageMigrator interface {
@@ -109,13 +280,72 @@ type Applier interface {
 	// Apply applies the content in the provided fs.FS using the configuration of the provided ClusterExtension.
 	// It also takes in a map[string]string to be applied to all applied resources as labels and another
 	// map[string]string used to create a unique identifier for a stored reference to the resources created.
-	Apply(context.Context, fs.FS, *ocv1.ClusterExtension, map[string]string, map[string]string) (bool, string, error)
+	//
+	// The returned health.Result reflects the aggregate health of the applied resources. Implementations that
+	// don't yet evaluate health return the zero value, which callers must treat as "unknown" rather than healthy.
+	Apply(context.Context, fs.FS, *ocv1.ClusterExtension, map[string]string, map[string]string) (bool, string, health.Result, error)
 }
 
 type RevisionStatesGetter interface {
 	GetRevisionStates(ctx context.Context, ext *ocv1.ClusterExtension) (*RevisionStates, error)
 }
 
+// HealthRefresher is implemented by Appliers that can re-evaluate the aggregate health of an
+// already-applied release without performing a render, diff, or apply. It lets SkipUnchangedBundle
+// refresh the Healthy condition in a steady-state reconcile (resolved bundle, rendered config, and
+// installed release all unchanged) without paying for a full unpack and apply. An Applier that
+// doesn't implement this interface never has its unpack/apply stages skipped.
+type HealthRefresher interface {
+	RefreshHealth(ctx context.Context, ext *ocv1.ClusterExtension) (health.Result, error)
+}
+
+// notifiableConditions maps each condition type a Notifier should be told about to the
+// status it must transition into for the transition to be worth notifying on. RolledBack
+// and Deprecated are rollup conditions that are only ever set True when active, so those
+// simply notify whenever the condition is present at all.
+var notifiableConditions = map[string]metav1.ConditionStatus{
+	ocv1.TypeUpgradeAvailable: metav1.ConditionTrue,
+	ocv1.TypeInstalled:        metav1.ConditionFalse, // "InstallFailed": Installed=False, Reason=Failed
+	ocv1.TypeRolledBack:       metav1.ConditionTrue,
+	ocv1.TypeDeprecated:       metav1.ConditionTrue,
+}
+
+// notifyTransitions compares before and after for each condition in notifiableConditions
+// and sends an Event for every one that newly transitioned into its interesting status.
+// Send errors are logged and otherwise ignored: a slow or unreachable notification sink
+// must never fail or delay a reconcile.
+func (r *ClusterExtensionReconciler) notifyTransitions(ctx context.Context, extName string, before, after []metav1.Condition) {
+	if r.Notifier == nil {
+		return
+	}
+	l := log.FromContext(ctx)
+
+	for condType, wantStatus := range notifiableConditions {
+		prev := apimeta.FindStatusCondition(before, condType)
+		cur := apimeta.FindStatusCondition(after, condType)
+		if cur == nil || cur.Status != wantStatus {
+			continue
+		}
+		if condType == ocv1.TypeInstalled && cur.Reason != ocv1.ReasonFailed {
+			continue
+		}
+		if prev != nil && prev.Status == cur.Status && prev.Reason == cur.Reason {
+			continue
+		}
+
+		event := notify.Event{
+			ExtensionName: extName,
+			ConditionType: cur.Type,
+			Reason:        cur.Reason,
+			Message:       cur.Message,
+			Time:          cur.LastTransitionTime.Time,
+		}
+		if err := r.Notifier.Notify(ctx, event); err != nil {
+			l.Error(err, "failed to send lifecycle notification", "conditionType", cur.Type, "reason", cur.Reason)
+		}
+	}
+}
+
 // The operator controller needs to watch all the bundle objects and reconcile accordingly. Though not ideal, but these permissions are required.
 // This has been taken from rukpak, and an issue was created before to discuss it: https://github.com/operator-framework/rukpak/issues/800.
 func (r *ClusterExtensionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -127,16 +357,43 @@ func (r *ClusterExtensionReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	// Include the ClusterExtension's UID alongside the name/reconcileID that
+	// controller-runtime already attaches, so log lines for the same-named
+	// ClusterExtension recreated after a delete can still be told apart.
+	l = l.WithValues("clusterExtensionUID", existingExt.GetUID())
+	ctx = log.IntoContext(ctx, l)
+
 	l.Info("reconcile starting")
 	defer l.Info("reconcile ending")
 
 	reconciledExt := existingExt.DeepCopy()
 	res, reconcileErr := r.ReconcileSteps.Reconcile(ctx, reconciledExt)
+	reconciledExt.Status.FailureRepeatCount, reconciledExt.Status.NextRetryTime = r.recordReconcileError(req.NamespacedName, l, reconcileErr, reconciledExt.Generation)
+
+	installedVersion := ""
+	if reconciledExt.Status.Install != nil {
+		installedVersion = reconciledExt.Status.Install.Bundle.Version
+	}
+	metrics.SetClusterExtensionStatus(getPackageName(reconciledExt), installedVersion, reconciledExt.Status.Conditions)
 
 	// Do checks before any Update()s, as Update() may modify the resource structure!
-	updateStatus := !equality.Semantic.DeepEqual(existingExt.Status, reconciledExt.Status)
+	updateStatus := !statusSemanticallyEqual(&existingExt.Status, &reconciledExt.Status)
 	updateFinalizers := !equality.Semantic.DeepEqual(existingExt.Finalizers, reconciledExt.Finalizers)
 
+	if updateStatus {
+		if wait := r.statusUpdateWait(req.NamespacedName); wait > 0 {
+			// A real status change arrived within statusUpdateCoalesceInterval of the last write for
+			// this ClusterExtension; hold it back and let it coalesce with whatever the next
+			// reconcile (which this requeue triggers) observes, rather than writing it immediately.
+			updateStatus = false
+			if res.RequeueAfter <= 0 || res.RequeueAfter > wait {
+				res.RequeueAfter = wait
+			}
+		} else {
+			r.statusWriteState.Store(req.NamespacedName, time.Now())
+		}
+	}
+
 	// If any unexpected fields have changed, panic before updating the resource
 	unexpectedFieldsChanged := k8sutil.CheckForUnexpectedFieldChange(existingExt, reconciledExt)
 	if unexpectedFieldsChanged {
@@ -149,6 +406,7 @@ func (r *ClusterExtensionReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	// CreateOrPatch()
 	finalizers := reconciledExt.Finalizers
 	if updateStatus {
+		r.notifyTransitions(ctx, existingExt.Name, existingExt.Status.Conditions, reconciledExt.Status.Conditions)
 		if err := r.Client.Status().Update(ctx, reconciledExt); err != nil {
 			reconcileErr = errors.Join(reconcileErr, fmt.Errorf("error updating status: %v", err))
 		}
@@ -414,14 +672,38 @@ func collectDeprecationMessages(entries []declcfg.DeprecationEntry) []string {
 	return messages
 }
 
-type ControllerBuilderOption func(builder *ctrl.Builder)
+// ControllerBuilderOption configures the controller built by SetupWithManager. ctrlOptions
+// accumulates the crcontroller.Options that every option contributes to; SetupWithManager applies
+// it to the builder once, after every option has run, so options like WithMaxConcurrentReconciles
+// and WithRateLimiter that both target crcontroller.Options don't clobber each other.
+type ControllerBuilderOption func(builder *ctrl.Builder, ctrlOptions *crcontroller.Options)
 
 func WithOwns(obj client.Object) ControllerBuilderOption {
-	return func(builder *ctrl.Builder) {
+	return func(builder *ctrl.Builder, _ *crcontroller.Options) {
 		builder.Owns(obj)
 	}
 }
 
+// WithMaxConcurrentReconciles sets the maximum number of ClusterExtensions the controller will
+// reconcile concurrently. Values greater than 1 trade additional API server load (one client per
+// concurrent reconcile, potentially overlapping Helm/boxcutter applies) for higher install and
+// upgrade throughput across many ClusterExtensions.
+func WithMaxConcurrentReconciles(n int) ControllerBuilderOption {
+	return func(_ *ctrl.Builder, ctrlOptions *crcontroller.Options) {
+		ctrlOptions.MaxConcurrentReconciles = n
+	}
+}
+
+// WithRateLimiter overrides the rate limiter the controller's workqueue uses to schedule
+// requeues, in place of controller-runtime's default (workqueue.DefaultControllerRateLimiter()).
+// Pair it with a matching ClusterExtensionReconciler.RetryBaseDelay/RetryMaxDelay so that
+// ClusterExtension.Status.NextRetryTime keeps estimating the workqueue's actual requeue delay.
+func WithRateLimiter(rl workqueue.TypedRateLimiter[reconcile.Request]) ControllerBuilderOption {
+	return func(_ *ctrl.Builder, ctrlOptions *crcontroller.Options) {
+		ctrlOptions.RateLimiter = rl
+	}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClusterExtensionReconciler) SetupWithManager(mgr ctrl.Manager, opts ...ControllerBuilderOption) (crcontroller.Controller, error) {
 	ctrlBuilder := ctrl.NewControllerManagedBy(mgr).
@@ -447,9 +729,11 @@ func (r *ClusterExtensionReconciler) SetupWithManager(mgr ctrl.Manager, opts ...
 				},
 			}))
 
+	var ctrlOptions crcontroller.Options
 	for _, applyOpt := range opts {
-		applyOpt(ctrlBuilder)
+		applyOpt(ctrlBuilder, &ctrlOptions)
 	}
+	ctrlBuilder.WithOptions(ctrlOptions)
 
 	return ctrlBuilder.Build(r)
 }
@@ -531,3 +815,33 @@ func (d *HelmRevisionStatesGetter) GetRevisionStates(ctx context.Context, ext *o
 	}
 	return rs, nil
 }
+
+// GitOpsRevisionStatesGetter reports revision state for a ClusterExtension whose manifests are
+// exported via applier.GitOpsExport rather than applied directly: instead of querying release
+// history, it reads back the labels.PackageNameKey/BundleReferenceKey/BundleNameKey/
+// BundleVersionKey annotations that GitOpsExport stamps on the exported ConfigMap.
+type GitOpsRevisionStatesGetter struct {
+	Client client.Client
+}
+
+func (d *GitOpsRevisionStatesGetter) GetRevisionStates(ctx context.Context, ext *ocv1.ClusterExtension) (*RevisionStates, error) {
+	cm := &corev1.ConfigMap{}
+	err := d.Client.Get(ctx, client.ObjectKey{Name: applier.GitOpsManifestConfigMapName(ext), Namespace: ext.Spec.Namespace}, cm)
+	if apierrors.IsNotFound(err) {
+		return &RevisionStates{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &RevisionStates{
+		Installed: &RevisionMetadata{
+			Package: cm.Annotations[labels.PackageNameKey],
+			Image:   cm.Annotations[labels.BundleReferenceKey],
+			BundleMetadata: ocv1.BundleMetadata{
+				Name:    cm.Annotations[labels.BundleNameKey],
+				Version: cm.Annotations[labels.BundleVersionKey],
+			},
+		},
+	}, nil
+}
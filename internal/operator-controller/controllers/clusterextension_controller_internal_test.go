@@ -0,0 +1,259 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+func TestClusterExtensionReconciler_recordReconcileError(t *testing.T) {
+	key := types.NamespacedName{Name: "test-ext"}
+	errFoo := errors.New("foo failed")
+	errBar := errors.New("bar failed")
+
+	r := &ClusterExtensionReconciler{}
+
+	// The first occurrence of an error always logs, starts the repeat count at 1, and estimates a
+	// next retry time in the future.
+	count, nextRetryTime := r.recordReconcileError(key, logr.Discard(), errFoo, 1)
+	require.Equal(t, int32(1), count)
+	require.NotNil(t, nextRetryTime)
+	require.True(t, nextRetryTime.Time.After(time.Now()))
+
+	// The same error occurring again within failureLogInterval keeps incrementing the repeat
+	// count without forcing another log line, and the estimated next retry time backs off further.
+	count, secondRetryTime := r.recordReconcileError(key, logr.Discard(), errFoo, 1)
+	require.Equal(t, int32(2), count)
+	require.True(t, secondRetryTime.After(nextRetryTime.Time))
+	count, _ = r.recordReconcileError(key, logr.Discard(), errFoo, 1)
+	require.Equal(t, int32(3), count)
+
+	// A different error resets the repeat count and is treated as a fresh occurrence.
+	count, _ = r.recordReconcileError(key, logr.Discard(), errBar, 1)
+	require.Equal(t, int32(1), count)
+
+	// A generation change (a spec edit) also resets the repeat count, even for the same error
+	// message, since it's a new attempt rather than a repeat of the old one.
+	count, _ = r.recordReconcileError(key, logr.Discard(), errBar, 2)
+	require.Equal(t, int32(1), count)
+
+	// Success clears the dedup state, so the same error occurring afterwards starts over.
+	count, nilRetryTime := r.recordReconcileError(key, logr.Discard(), nil, 2)
+	require.Equal(t, int32(0), count)
+	require.Nil(t, nilRetryTime)
+	count, _ = r.recordReconcileError(key, logr.Discard(), errBar, 2)
+	require.Equal(t, int32(1), count)
+
+	// Different ClusterExtensions are tracked independently.
+	otherKey := types.NamespacedName{Name: "other-ext"}
+	count, _ = r.recordReconcileError(otherKey, logr.Discard(), errBar, 1)
+	require.Equal(t, int32(1), count)
+	count, _ = r.recordReconcileError(key, logr.Discard(), errBar, 2)
+	require.Equal(t, int32(2), count)
+}
+
+func TestBackoffDelay(t *testing.T) {
+	r := &ClusterExtensionReconciler{}
+	require.Zero(t, r.backoffDelay(0))
+	require.Equal(t, defaultBackoffBaseDelay, r.backoffDelay(1))
+	require.Equal(t, 2*defaultBackoffBaseDelay, r.backoffDelay(2))
+	require.Equal(t, defaultBackoffMaxDelay, r.backoffDelay(100))
+}
+
+func TestBackoffDelayCustom(t *testing.T) {
+	r := &ClusterExtensionReconciler{RetryBaseDelay: time.Second, RetryMaxDelay: 10 * time.Second}
+	require.Zero(t, r.backoffDelay(0))
+	require.Equal(t, time.Second, r.backoffDelay(1))
+	require.Equal(t, 2*time.Second, r.backoffDelay(2))
+	require.Equal(t, 10*time.Second, r.backoffDelay(100))
+}
+
+func TestStatusSemanticallyEqual(t *testing.T) {
+	t1 := metav1.NewTime(time.Now())
+	t2 := metav1.NewTime(time.Now().Add(time.Hour))
+
+	base := &ocv1.ClusterExtensionStatus{
+		Conditions: []metav1.Condition{{Type: ocv1.TypeInstalled, Status: metav1.ConditionTrue, Reason: ocv1.ReasonSucceeded}},
+	}
+
+	// Differing only by NextRetryTime or LastUnpackedTime value is not a semantic change.
+	a := base.DeepCopy()
+	a.NextRetryTime, a.LastUnpackedTime = &t1, &t1
+	b := base.DeepCopy()
+	b.NextRetryTime, b.LastUnpackedTime = &t2, &t2
+	require.True(t, statusSemanticallyEqual(a, b))
+
+	// One having a NextRetryTime set and the other not is a semantic change (a retry is either
+	// scheduled or it isn't).
+	c := base.DeepCopy()
+	c.NextRetryTime = nil
+	require.False(t, statusSemanticallyEqual(a, c))
+
+	// One having a LastUnpackedTime set and the other not is a semantic change (content has
+	// either been unpacked at least once or it hasn't).
+	d := base.DeepCopy()
+	d.NextRetryTime, d.LastUnpackedTime = &t1, nil
+	require.False(t, statusSemanticallyEqual(a, d))
+
+	// Any other difference is still a semantic change.
+	e := base.DeepCopy()
+	e.NextRetryTime, e.LastUnpackedTime = &t1, &t1
+	e.FailureRepeatCount = 3
+	require.False(t, statusSemanticallyEqual(a, e))
+}
+
+// reconcileStepFunc adapts a plain function literal to ReconcileStepFunc for tests that only need
+// to exercise Reconcile's status write decision, not a real reconcile pipeline.
+func reconcileStepFunc(f func(ext *ocv1.ClusterExtension)) ReconcileStepFunc {
+	return func(_ context.Context, _ *reconcileState, ext *ocv1.ClusterExtension) (*ctrl.Result, error) {
+		f(ext)
+		return nil, nil
+	}
+}
+
+func TestReconcile_SkipsStatusUpdateWhenUnchanged(t *testing.T) {
+	ext := &ocv1.ClusterExtension{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-ext"},
+	}
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, ocv1.AddToScheme(testScheme))
+	cl := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithStatusSubresource(&ocv1.ClusterExtension{}).
+		WithObjects(ext).
+		Build()
+
+	var statusUpdates int
+	trackingClient := interceptor.NewClient(cl, interceptor.Funcs{
+		SubResourcePatch: func(ctx context.Context, subResourceClient client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			if subResourceName == "status" {
+				statusUpdates++
+			}
+			return subResourceClient.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+		},
+		SubResourceUpdate: func(ctx context.Context, subResourceClient client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			if subResourceName == "status" {
+				statusUpdates++
+			}
+			return subResourceClient.SubResource(subResourceName).Update(ctx, obj, opts...)
+		},
+	})
+
+	r := &ClusterExtensionReconciler{
+		Client: trackingClient,
+		ReconcileSteps: ReconcileSteps{
+			// Simulates a steady-state, repeatedly-successful unpack: it sets a fresh
+			// LastUnpackedTime on every call, as a real UnpackBundle(Async) step would.
+			reconcileStepFunc(func(ext *ocv1.ClusterExtension) {
+				now := metav1.NewTime(time.Now())
+				ext.Status.LastUnpackedTime = &now
+				SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+					Type:               ocv1.TypeInstalled,
+					Status:             metav1.ConditionTrue,
+					Reason:             ocv1.ReasonSucceeded,
+					Message:            "installed",
+					ObservedGeneration: ext.GetGeneration(),
+				})
+			}),
+		},
+	}
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: ext.Name}}
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, statusUpdates, "first reconcile should write the new status once")
+
+	for i := 0; i < 3; i++ {
+		_, err := r.Reconcile(context.Background(), req)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, statusUpdates, "later reconciles that only bump LastUnpackedTime should not write status again")
+}
+
+func TestReconcile_CoalescesRapidStatusChanges(t *testing.T) {
+	ext := &ocv1.ClusterExtension{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-ext"},
+	}
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, ocv1.AddToScheme(testScheme))
+	cl := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithStatusSubresource(&ocv1.ClusterExtension{}).
+		WithObjects(ext).
+		Build()
+
+	var statusUpdates int
+	trackingClient := interceptor.NewClient(cl, interceptor.Funcs{
+		SubResourcePatch: func(ctx context.Context, subResourceClient client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			if subResourceName == "status" {
+				statusUpdates++
+			}
+			return subResourceClient.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+		},
+		SubResourceUpdate: func(ctx context.Context, subResourceClient client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			if subResourceName == "status" {
+				statusUpdates++
+			}
+			return subResourceClient.SubResource(subResourceName).Update(ctx, obj, opts...)
+		},
+	})
+
+	// Each reconcile flips the Installed condition's message, so every reconcile is a genuine
+	// (non-no-op) status change - the scenario statusUpdateWait exists to coalesce.
+	var flap int
+	r := &ClusterExtensionReconciler{
+		Client: trackingClient,
+		ReconcileSteps: ReconcileSteps{
+			reconcileStepFunc(func(ext *ocv1.ClusterExtension) {
+				flap++
+				SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+					Type:               ocv1.TypeInstalled,
+					Status:             metav1.ConditionTrue,
+					Reason:             ocv1.ReasonSucceeded,
+					Message:            fmt.Sprintf("installed (flap %d)", flap),
+					ObservedGeneration: ext.GetGeneration(),
+				})
+			}),
+		},
+	}
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: ext.Name}}
+
+	res, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, statusUpdates, "first reconcile should write the new status once")
+	require.Zero(t, res.RequeueAfter)
+
+	// Rapid successive real changes, all within statusUpdateCoalesceInterval of the first write,
+	// are held back rather than written immediately, and each asks to be requeued soon so the
+	// held-back change gets re-evaluated.
+	for i := 0; i < 3; i++ {
+		res, err := r.Reconcile(context.Background(), req)
+		require.NoError(t, err)
+		require.Greater(t, res.RequeueAfter, time.Duration(0))
+	}
+	require.Equal(t, 1, statusUpdates, "rapid real changes within the coalesce interval should not each write status")
+
+	// Once the coalesce interval has elapsed, the next (still-changing) reconcile is free to write.
+	key := types.NamespacedName{Name: ext.Name}
+	r.statusWriteState.Store(key, time.Now().Add(-statusUpdateCoalesceInterval))
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 2, statusUpdates, "a change observed after the coalesce interval elapses should write")
+}
@@ -18,20 +18,36 @@ package controllers
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
+	mmsemver "github.com/Masterminds/semver/v3"
+	bsemver "github.com/blang/semver/v4"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/finalizer"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	ocv1 "github.com/operator-framework/operator-controller/api/v1"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/authentication"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/bundleutil"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/labels"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/metrics"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/resolve"
 	imageutil "github.com/operator-framework/operator-controller/internal/shared/util/image"
 )
@@ -63,6 +79,251 @@ func HandleFinalizers(f finalizer.Finalizer) ReconcileStepFunc {
 	}
 }
 
+// CheckInstallNamespacePolicy rejects a ClusterExtension whose install namespace
+// (spec.namespace) doesn't match selector, so multi-tenant clusters can reserve
+// namespaces (e.g. by requiring a "olm.operatorframework.io/allow-install" label)
+// that ClusterExtensions may not target. The ClusterExtensionValidatingWebhook
+// enforces the same policy at admission time; this step is defense-in-depth for
+// when the webhook isn't enabled, or a namespace's labels change after admission.
+//
+// A nil selector disables the check entirely, and a namespace that doesn't yet
+// exist is allowed through: there are no labels to evaluate, and rukpak's
+// namespace-creation step (if configured) will create it un-labeled, which is
+// out of scope for this policy.
+func CheckInstallNamespacePolicy(c client.Client, selector k8slabels.Selector) ReconcileStepFunc {
+	return func(ctx context.Context, state *reconcileState, ext *ocv1.ClusterExtension) (*ctrl.Result, error) {
+		if selector == nil {
+			return nil, nil
+		}
+
+		ns := &corev1.Namespace{}
+		if err := c.Get(ctx, client.ObjectKey{Name: ext.Spec.Namespace}, ns); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			err = fmt.Errorf("checking install namespace %q: %w", ext.Spec.Namespace, err)
+			setStatusProgressing(ext, err)
+			return nil, err
+		}
+
+		if !selector.Matches(k8slabels.Set(ns.Labels)) {
+			err := reconcile.TerminalError(fmt.Errorf("namespace %q is not permitted as an install namespace: it does not match the required label selector %q", ext.Spec.Namespace, selector.String()))
+			setInstalledStatusConditionUnknown(ext, err.Error())
+			setStatusProgressing(ext, err)
+			return nil, err
+		}
+		return nil, nil
+	}
+}
+
+// NamespaceCatalogSelector returns a resolve.CatalogResolver.NamespaceCatalogSelectorFunc that
+// restricts resolution for a ClusterExtension installing into namespace to the ClusterCatalogs
+// matching namespace's "olm.operatorframework.io/catalog-selector" annotation, if it has one. A
+// namespace without the annotation, or one that doesn't exist yet, has no restriction.
+func NamespaceCatalogSelector(c client.Client) func(ctx context.Context, namespace string) (k8slabels.Selector, error) {
+	return func(ctx context.Context, namespace string) (k8slabels.Selector, error) {
+		ns := &corev1.Namespace{}
+		if err := c.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+			if apierrors.IsNotFound(err) {
+				return k8slabels.Everything(), nil
+			}
+			return nil, fmt.Errorf("getting namespace %q: %w", namespace, err)
+		}
+
+		selectorString, ok := ns.Annotations[labels.CatalogSelectorAnnotationKey]
+		if !ok {
+			return k8slabels.Everything(), nil
+		}
+
+		parsedSelector, err := metav1.ParseToLabelSelector(selectorString)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q annotation on namespace %q: %w", labels.CatalogSelectorAnnotationKey, namespace, err)
+		}
+		selector, err := metav1.LabelSelectorAsSelector(parsedSelector)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q annotation on namespace %q: %w", labels.CatalogSelectorAnnotationKey, namespace, err)
+		}
+		return selector, nil
+	}
+}
+
+// CheckDependencies defers resolving and installing ext until every ClusterExtension
+// named in ext.Spec.DependsOn is Installed and Healthy, surfacing the WaitingForDependencies
+// condition with the names that are still pending. This lets stacks of related
+// ClusterExtensions (e.g. cert-manager before an operator that requires it) come up in
+// order without each dependent one independently retrying installation until its
+// prerequisites happen to be ready.
+//
+// It does not detect dependency cycles: a cycle simply leaves every ClusterExtension in it
+// waiting on the others forever.
+func CheckDependencies(c client.Client) ReconcileStepFunc {
+	return func(ctx context.Context, state *reconcileState, ext *ocv1.ClusterExtension) (*ctrl.Result, error) {
+		if len(ext.Spec.DependsOn) == 0 {
+			apimeta.RemoveStatusCondition(&ext.Status.Conditions, ocv1.TypeWaitingForDependencies)
+			return nil, nil
+		}
+
+		var notReady []string
+		for _, name := range ext.Spec.DependsOn {
+			dep := &ocv1.ClusterExtension{}
+			if err := c.Get(ctx, client.ObjectKey{Name: name}, dep); err != nil {
+				if apierrors.IsNotFound(err) {
+					notReady = append(notReady, fmt.Sprintf("%s (not found)", name))
+					continue
+				}
+				return nil, fmt.Errorf("checking dependency %q: %w", name, err)
+			}
+			installed := apimeta.IsStatusConditionTrue(dep.Status.Conditions, ocv1.TypeInstalled)
+			healthy := apimeta.IsStatusConditionTrue(dep.Status.Conditions, ocv1.TypeHealthy)
+			if !installed || !healthy {
+				notReady = append(notReady, name)
+			}
+		}
+
+		if len(notReady) > 0 {
+			message := fmt.Sprintf("waiting for dependencies to become Installed and Healthy: %s", strings.Join(notReady, ", "))
+			SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+				Type:               ocv1.TypeWaitingForDependencies,
+				Status:             metav1.ConditionTrue,
+				Reason:             ocv1.ReasonDependenciesNotReady,
+				Message:            message,
+				ObservedGeneration: ext.GetGeneration(),
+			})
+			setStatusProgressing(ext, errors.New(message))
+			return &ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+
+		SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+			Type:               ocv1.TypeWaitingForDependencies,
+			Status:             metav1.ConditionFalse,
+			Reason:             ocv1.ReasonDependenciesReady,
+			Message:            "all dependencies are Installed and Healthy",
+			ObservedGeneration: ext.GetGeneration(),
+		})
+		return nil, nil
+	}
+}
+
+// CheckOLMv0PackageConflict blocks install/upgrade of a ClusterExtension whose package is already
+// managed by an OLMv0 Subscription, surfacing a dedicated ManagedByOLMv0 condition instead of
+// letting OLMv0 and operator-controller fight over ownership of the package's CRDs and
+// Deployments object-by-object. A ClusterExtension that sets spec.install.adoptionPolicy:
+// AdoptOLMV0 is exempt, since that's the supported path for taking the package over from OLMv0.
+//
+// It only looks for a Subscription naming the package; a ClusterServiceVersion installed without
+// one (e.g. manually, or left behind after its Subscription was deleted) isn't detected.
+func CheckOLMv0PackageConflict(c client.Client) ReconcileStepFunc {
+	return func(ctx context.Context, state *reconcileState, ext *ocv1.ClusterExtension) (*ctrl.Result, error) {
+		packageName := getPackageName(ext)
+		if packageName == "" || (ext.Spec.Install != nil && ext.Spec.Install.AdoptionPolicy == ocv1.AdoptionPolicyAdoptOLMV0) {
+			apimeta.RemoveStatusCondition(&ext.Status.Conditions, ocv1.TypeManagedByOLMv0)
+			return nil, nil
+		}
+
+		var subs v1alpha1.SubscriptionList
+		if err := c.List(ctx, &subs); err != nil {
+			// Treat "the Subscription CRD doesn't exist" as "OLMv0 isn't installed",
+			// rather than as an error.
+			if apimeta.IsNoMatchError(err) {
+				apimeta.RemoveStatusCondition(&ext.Status.Conditions, ocv1.TypeManagedByOLMv0)
+				return nil, nil
+			}
+			err = fmt.Errorf("checking for OLMv0 Subscriptions managing package %q: %w", packageName, err)
+			setStatusProgressing(ext, err)
+			return nil, err
+		}
+
+		var owners []string
+		for _, sub := range subs.Items {
+			if sub.Spec != nil && sub.Spec.Package == packageName {
+				owners = append(owners, client.ObjectKeyFromObject(&sub).String())
+			}
+		}
+		if len(owners) == 0 {
+			SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+				Type:               ocv1.TypeManagedByOLMv0,
+				Status:             metav1.ConditionFalse,
+				Reason:             ocv1.ReasonNotManagedByOLMv0,
+				Message:            "no OLMv0 Subscription manages this package",
+				ObservedGeneration: ext.GetGeneration(),
+			})
+			return nil, nil
+		}
+
+		err := reconcile.TerminalError(fmt.Errorf("package %q is already managed by OLMv0 Subscription(s) %s; set spec.install.adoptionPolicy to %q to take it over instead",
+			packageName, strings.Join(owners, ", "), ocv1.AdoptionPolicyAdoptOLMV0))
+		SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+			Type:               ocv1.TypeManagedByOLMv0,
+			Status:             metav1.ConditionTrue,
+			Reason:             ocv1.ReasonManagedByOLMv0,
+			Message:            err.Error(),
+			ObservedGeneration: ext.GetGeneration(),
+		})
+		setInstalledStatusConditionUnknown(ext, err.Error())
+		setStatusProgressing(ext, err)
+		return nil, err
+	}
+}
+
+// CheckPackageSingleton blocks install/upgrade of a ClusterExtension whose package is already
+// installed by a different ClusterExtension, surfacing a dedicated DuplicatePackageInstall
+// condition instead of letting both ClusterExtensions fight over ownership of the package's CRDs
+// and Deployments object-by-object. A conflict with a given other ClusterExtension is suppressed
+// only when BOTH ext and that other ClusterExtension set spec.install.allowMultiplePerPackage:
+// true - either one opting out is enough to keep the guard active for that pair.
+func CheckPackageSingleton(c client.Client) ReconcileStepFunc {
+	return func(ctx context.Context, state *reconcileState, ext *ocv1.ClusterExtension) (*ctrl.Result, error) {
+		packageName := getPackageName(ext)
+		if packageName == "" {
+			apimeta.RemoveStatusCondition(&ext.Status.Conditions, ocv1.TypeDuplicatePackageInstall)
+			return nil, nil
+		}
+		extAllowsMultiple := ext.Spec.Install != nil && ext.Spec.Install.AllowMultiplePerPackage
+
+		var exts ocv1.ClusterExtensionList
+		if err := c.List(ctx, &exts); err != nil {
+			err = fmt.Errorf("checking for other ClusterExtensions installing package %q: %w", packageName, err)
+			setStatusProgressing(ext, err)
+			return nil, err
+		}
+
+		var owners []string
+		for _, other := range exts.Items {
+			if other.Name == ext.Name || getPackageName(&other) != packageName {
+				continue
+			}
+			otherAllowsMultiple := other.Spec.Install != nil && other.Spec.Install.AllowMultiplePerPackage
+			if extAllowsMultiple && otherAllowsMultiple {
+				continue
+			}
+			owners = append(owners, other.Name)
+		}
+		if len(owners) == 0 {
+			SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+				Type:               ocv1.TypeDuplicatePackageInstall,
+				Status:             metav1.ConditionFalse,
+				Reason:             ocv1.ReasonNoDuplicatePackageInstall,
+				Message:            "no other ClusterExtension installs this package",
+				ObservedGeneration: ext.GetGeneration(),
+			})
+			return nil, nil
+		}
+
+		err := reconcile.TerminalError(fmt.Errorf("package %q is already installed by ClusterExtension(s) %s; set spec.install.allowMultiplePerPackage on both to scope each install to a distinct watch namespace instead",
+			packageName, strings.Join(owners, ", ")))
+		SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+			Type:               ocv1.TypeDuplicatePackageInstall,
+			Status:             metav1.ConditionTrue,
+			Reason:             ocv1.ReasonDuplicatePackageInstall,
+			Message:            err.Error(),
+			ObservedGeneration: ext.GetGeneration(),
+		})
+		setInstalledStatusConditionUnknown(ext, err.Error())
+		setStatusProgressing(ext, err)
+		return nil, err
+	}
+}
+
 func RetrieveRevisionStates(r RevisionStatesGetter) ReconcileStepFunc {
 	return func(ctx context.Context, state *reconcileState, ext *ocv1.ClusterExtension) (*ctrl.Result, error) {
 		l := log.FromContext(ctx)
@@ -107,6 +368,33 @@ func ResolveBundle(r resolve.Resolver, c client.Client) ReconcileStepFunc {
 			return nil, nil
 		}
 
+		if ext.Spec.RollbackTo != "" {
+			l.V(1).Info("resolving rollback target", "rollbackTo", ext.Spec.RollbackTo)
+			return resolveRollbackTarget(state, ext)
+		}
+
+		if ext.Spec.FreezeVersion && state.revisionStates.Installed != nil {
+			l.V(1).Info("version changes frozen; reconciling installed version", "version", state.revisionStates.Installed.Version)
+			SetDeprecationStatus(ext, state.revisionStates.Installed.Name, nil, false)
+			state.resolvedRevisionMetadata = state.revisionStates.Installed
+			setUpgradeAvailableStatus(ctx, r, ext, state.revisionStates.Installed, nil)
+			setAvailableUpgradeEdgesStatus(ctx, r, ext, state.revisionStates.Installed)
+			return nil, nil
+		}
+
+		if shouldAutoRevert(ext) && state.revisionStates.Installed != nil {
+			l.Info("persistent upgrade failure; automatically reverting to last installed version",
+				"version", state.revisionStates.Installed.Version, "failureRepeatCount", ext.Status.FailureRepeatCount)
+			SetDeprecationStatus(ext, state.revisionStates.Installed.Name, nil, false)
+			state.resolvedRevisionMetadata = state.revisionStates.Installed
+			setUpgradeAvailableStatus(ctx, r, ext, state.revisionStates.Installed, nil)
+			setAvailableUpgradeEdgesStatus(ctx, r, ext, state.revisionStates.Installed)
+			setRolledBackStatusCondition(ext, metav1.ConditionTrue, ocv1.ReasonPersistentUpgradeFailure,
+				fmt.Sprintf("reverted to version %s after %d consecutive failed attempts to change version", state.revisionStates.Installed.Version, ext.Status.FailureRepeatCount))
+			return nil, nil
+		}
+		setRolledBackStatusCondition(ext, metav1.ConditionFalse, ocv1.ReasonNotRolledBack, "not automatically reverted")
+
 		// Resolve a new bundle from the catalog
 		l.V(1).Info("resolving bundle")
 		var bm *ocv1.BundleMetadata
@@ -139,9 +427,15 @@ func ResolveBundle(r resolve.Resolver, c client.Client) ReconcileStepFunc {
 		SetDeprecationStatus(ext, installedBundleName, resolvedDeprecation, hasCatalogData)
 
 		if err != nil {
-			return handleResolutionError(ctx, c, state, ext, err)
+			setUpgradeAvailableStatus(ctx, r, ext, state.revisionStates.Installed, nil)
+			setAvailableUpgradeEdgesStatus(ctx, r, ext, state.revisionStates.Installed)
+			return handleResolutionError(ctx, r, c, state, ext, err)
 		}
 
+		// A bundle resolved successfully, so whatever was previously installed (if anything) is
+		// provably still reachable in the catalog; clear any earlier missing-version finding.
+		apimeta.RemoveStatusCondition(&ext.Status.Conditions, ocv1.TypeInstalledVersionMissingFromCatalog)
+
 		state.resolvedRevisionMetadata = &RevisionMetadata{
 			Package: resolvedBundle.Package,
 			Image:   resolvedBundle.Image,
@@ -151,10 +445,175 @@ func ResolveBundle(r resolve.Resolver, c client.Client) ReconcileStepFunc {
 			//   registry+v1's semver spec violations of treating build metadata as orderable.
 			BundleMetadata: bundleutil.MetadataFor(resolvedBundle.Name, resolvedBundleVersion.AsLegacyRegistryV1Version()),
 		}
+		ext.Status.LastResolvedTime = ptr.To(metav1.Now())
+		setUpgradeAvailableStatus(ctx, r, ext, state.revisionStates.Installed, state.resolvedRevisionMetadata)
+		setAvailableUpgradeEdgesStatus(ctx, r, ext, state.revisionStates.Installed)
 		return nil, nil
 	}
 }
 
+// shouldAutoRevert reports whether ext should be resolved to its currently installed
+// version instead of attempting a new one, because ext.Spec.AutoRevertAfterFailures is
+// set and either this reconcile has reached that many consecutive failures, or a prior
+// reconcile already triggered the revert for the current generation. The latter check
+// makes the revert sticky: once triggered, resolution keeps targeting the installed
+// version (rather than retrying, and failing, the same new version every reconcile)
+// until a spec change produces a new generation to attempt.
+func shouldAutoRevert(ext *ocv1.ClusterExtension) bool {
+	if ext.Spec.AutoRevertAfterFailures == nil {
+		return false
+	}
+	if ext.Status.FailureRepeatCount >= *ext.Spec.AutoRevertAfterFailures {
+		return true
+	}
+	cond := apimeta.FindStatusCondition(ext.Status.Conditions, ocv1.TypeRolledBack)
+	return cond != nil && cond.Status == metav1.ConditionTrue && cond.ObservedGeneration == ext.GetGeneration()
+}
+
+// setRolledBackStatusCondition sets the RolledBack condition, which reports whether
+// ext.Spec.AutoRevertAfterFailures has reverted the extension to its last installed
+// version after persistent failures trying to move to a different one.
+func setRolledBackStatusCondition(ext *ocv1.ClusterExtension, status metav1.ConditionStatus, reason, message string) {
+	SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+		Type:               ocv1.TypeRolledBack,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: ext.GetGeneration(),
+	})
+}
+
+// resolveRollbackTarget looks up ext.Spec.RollbackTo in ext.Status.History and, if an
+// entry's bundle version matches exactly, sets state.resolvedRevisionMetadata to that
+// bundle instead of resolving one from the catalog, so the remaining reconcile steps
+// (preflights, apply) run against it exactly as they would for a freshly resolved
+// bundle. It's a terminal error if rollbackTo doesn't match any history entry: there's
+// nothing recorded to roll back to, and retrying resolution won't change that.
+func resolveRollbackTarget(state *reconcileState, ext *ocv1.ClusterExtension) (*ctrl.Result, error) {
+	for _, entry := range ext.Status.History {
+		if entry.Bundle.Version != ext.Spec.RollbackTo {
+			continue
+		}
+		pkg := ""
+		if ext.Spec.Source.Catalog != nil {
+			pkg = ext.Spec.Source.Catalog.PackageName
+		}
+		state.resolvedRevisionMetadata = &RevisionMetadata{
+			Package:        pkg,
+			Image:          entry.Image,
+			BundleMetadata: entry.Bundle,
+		}
+		return nil, nil
+	}
+
+	err := reconcile.TerminalError(fmt.Errorf("rollbackTo %q does not match any bundle version in status.history", ext.Spec.RollbackTo))
+	setStatusProgressing(ext, err)
+	return nil, err
+}
+
+// setUpgradeAvailableStatus computes and sets the UpgradeAvailable condition by comparing accepted,
+// the version resolution just chose under ext's current UpgradeConstraintPolicy and upgrade edges
+// (nil if resolution failed), against the newest version that exists in the catalog for the same
+// package selection, ignoring those constraints. It runs regardless of whether resolution itself
+// succeeded, so an admin can see that an update exists even while it's blocked by policy or a
+// broken upgrade path. installed is the currently installed bundle, or nil for a ClusterExtension
+// that hasn't installed anything yet.
+func setUpgradeAvailableStatus(ctx context.Context, r resolve.Resolver, ext *ocv1.ClusterExtension, installed, accepted *RevisionMetadata) {
+	if installed == nil {
+		apimeta.SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+			Type:               ocv1.TypeUpgradeAvailable,
+			Status:             metav1.ConditionUnknown,
+			Reason:             ocv1.ReasonUpgradeAvailableUnknown,
+			Message:            "nothing installed yet",
+			ObservedGeneration: ext.GetGeneration(),
+		})
+		return
+	}
+
+	newestExistingResolver, ok := r.(resolve.NewestExistingResolver)
+	if !ok {
+		return
+	}
+
+	acceptedVersion := installed.Version
+	if accepted != nil {
+		acceptedVersion = accepted.Version
+	}
+
+	newestExisting, err := newestExistingResolver.ResolveNewestExisting(ctx, ext)
+	if err != nil {
+		apimeta.SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+			Type:               ocv1.TypeUpgradeAvailable,
+			Status:             metav1.ConditionUnknown,
+			Reason:             ocv1.ReasonUpgradeAvailableUnknown,
+			Message:            fmt.Sprintf("unable to determine newest existing version: %v", err),
+			ObservedGeneration: ext.GetGeneration(),
+		})
+		return
+	}
+
+	acceptedSemver, err := bsemver.Parse(acceptedVersion)
+	if err != nil {
+		apimeta.SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+			Type:               ocv1.TypeUpgradeAvailable,
+			Status:             metav1.ConditionUnknown,
+			Reason:             ocv1.ReasonUpgradeAvailableUnknown,
+			Message:            fmt.Sprintf("unable to parse accepted version %q: %v", acceptedVersion, err),
+			ObservedGeneration: ext.GetGeneration(),
+		})
+		return
+	}
+	newestExistingVersion := newestExisting.AsLegacyRegistryV1Version()
+
+	if !newestExistingVersion.GT(acceptedSemver) {
+		apimeta.SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+			Type:               ocv1.TypeUpgradeAvailable,
+			Status:             metav1.ConditionFalse,
+			Reason:             ocv1.ReasonNoUpgradeAvailable,
+			Message:            fmt.Sprintf("already at the newest existing version %s", acceptedVersion),
+			ObservedGeneration: ext.GetGeneration(),
+		})
+		return
+	}
+
+	apimeta.SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+		Type:               ocv1.TypeUpgradeAvailable,
+		Status:             metav1.ConditionTrue,
+		Reason:             ocv1.ReasonUpgradeAvailable,
+		Message:            fmt.Sprintf("newest acceptable version is %s; newest existing version in the catalog is %s", acceptedVersion, newestExistingVersion),
+		ObservedGeneration: ext.GetGeneration(),
+	})
+}
+
+// setAvailableUpgradeEdgesStatus populates ext.Status.AvailableUpgradeEdges with every
+// catalog-provided upgrade edge available from installed, when r supports
+// resolve.UpgradeEdgesResolver. installed is the currently installed bundle; if it's nil, there's
+// nothing to compute edges from, so the field is cleared. Errors resolving edges are swallowed
+// (falling back to clearing the field), since this is supplementary information and shouldn't
+// block reconciliation the way a Resolve error does.
+func setAvailableUpgradeEdgesStatus(ctx context.Context, r resolve.Resolver, ext *ocv1.ClusterExtension, installed *RevisionMetadata) {
+	ext.Status.AvailableUpgradeEdges = nil
+	if installed == nil {
+		return
+	}
+
+	upgradeEdgesResolver, ok := r.(resolve.UpgradeEdgesResolver)
+	if !ok {
+		return
+	}
+
+	edges, err := upgradeEdgesResolver.ResolveUpgradeEdges(ctx, ext, installed.BundleMetadata)
+	if err != nil {
+		log.FromContext(ctx).V(1).Info("unable to determine available upgrade edges", "error", err)
+		return
+	}
+
+	if len(edges) > maxAvailableUpgradeEdges {
+		edges = edges[:maxAvailableUpgradeEdges]
+	}
+	ext.Status.AvailableUpgradeEdges = edges
+}
+
 // handleResolutionError handles the case when bundle resolution fails.
 //
 // Decision logic (evaluated in order):
@@ -168,8 +627,16 @@ func ResolveBundle(r resolve.Resolver, c client.Client) ReconcileStepFunc {
 // no error, allowing the Apply step to run and maintain resources using the existing installation.
 // The controller watches ClusterCatalog resources, so reconciliation will automatically resume
 // when catalogs return, enabling upgrades.
-func handleResolutionError(ctx context.Context, c client.Client, state *reconcileState, ext *ocv1.ClusterExtension, err error) (*ctrl.Result, error) {
+//
+// In case 4, if r can additionally resolve the newest existing version ignoring upgrade edge
+// constraints (see resolve.NewestExistingResolver) and doing so succeeds, that's proof the
+// package still exists in the catalog and resolution failed only because the installed bundle's
+// upgrade edges or UpgradeConstraintPolicy rule it out - a distinct, actionable case from a
+// package that's genuinely missing or a transient catalog error. That case is surfaced via the
+// BlockedByUpgradeConstraints Progressing reason and the UpgradeConstraintsBlockedTotal metric.
+func handleResolutionError(ctx context.Context, r resolve.Resolver, c client.Client, state *reconcileState, ext *ocv1.ClusterExtension, err error) (*ctrl.Result, error) {
 	l := log.FromContext(ctx)
+	metrics.ResolutionFailuresTotal.WithLabelValues(getPackageName(ext)).Inc()
 
 	// No installed bundle and resolution failed - cannot proceed
 	if state.revisionStates.Installed == nil {
@@ -221,11 +688,53 @@ func handleResolutionError(ctx context.Context, c client.Client, state *reconcil
 	if catalogsExist {
 		// ClusterCatalogs exist but resolution failed - likely a transient issue (ClusterCatalog updating, cache stale, etc.)
 		// Retry resolution instead of falling back
-		msg := fmt.Sprintf("failed to resolve bundle, retrying: %v", err)
 		var catalogName string
 		if ext.Spec.Source.Catalog != nil {
 			catalogName = getCatalogNameFromSelector(ext.Spec.Source.Catalog.Selector)
 		}
+
+		// If the installed bundle itself no longer exists in any matching catalog (its version was
+		// pulled, or the extension was pointed at a catalog that never had it), resolution will
+		// never succeed by retrying - there's nothing to wait for. Surface that distinctly via the
+		// InstalledVersionMissingFromCatalog condition and fall back to the installed bundle to keep
+		// the installation stable, the same way we do when the catalogs themselves are gone, instead
+		// of retrying a resolution error forever.
+		if installedBundleChecker, ok := r.(resolve.InstalledBundleChecker); ok {
+			if exists, checkErr := installedBundleChecker.InstalledBundleExists(ctx, ext, state.revisionStates.Installed.BundleMetadata); checkErr == nil {
+				if !exists {
+					metrics.InstalledVersionMissingFromCatalogTotal.WithLabelValues(getPackageName(ext)).Inc()
+					l.Info("installed bundle missing from catalog - falling back to installed bundle to maintain workload",
+						"packageName", getPackageName(ext),
+						"catalogName", catalogName,
+						"installedBundle", state.revisionStates.Installed.Name,
+						"installedVersion", installedVersion)
+					SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+						Type:               ocv1.TypeInstalledVersionMissingFromCatalog,
+						Status:             metav1.ConditionTrue,
+						Reason:             ocv1.ReasonInstalledVersionMissingFromCatalog,
+						Message:            fmt.Sprintf("installed version %s no longer exists in any matching catalog", installedVersion),
+						ObservedGeneration: ext.GetGeneration(),
+					})
+					setInstalledStatusFromRevisionStates(ext, state.revisionStates)
+					state.resolvedRevisionMetadata = state.revisionStates.Installed
+					return nil, nil
+				}
+				apimeta.RemoveStatusCondition(&ext.Status.Conditions, ocv1.TypeInstalledVersionMissingFromCatalog)
+			}
+		}
+
+		// If a bundle satisfying the package selection exists in the catalog once upgrade edge
+		// constraints are ignored, resolution didn't fail for lack of a matching bundle - it's
+		// blocked by the installed bundle's upgrade edges or UpgradeConstraintPolicy. Surface that
+		// distinctly so fleet tooling doesn't mistake it for a missing package.
+		if newestExistingResolver, ok := r.(resolve.NewestExistingResolver); ok {
+			if _, resolveErr := newestExistingResolver.ResolveNewestExisting(ctx, ext); resolveErr == nil {
+				metrics.UpgradeConstraintsBlockedTotal.WithLabelValues(getPackageName(ext)).Inc()
+				err = fmt.Errorf("%w: %w", errBlockedByUpgradeConstraints, err)
+			}
+		}
+
+		msg := fmt.Sprintf("failed to resolve bundle, retrying: %v", err)
 		l.Error(err, "resolution failed but matching ClusterCatalogs exist - retrying instead of falling back",
 			"packageName", getPackageName(ext),
 			"catalogName", catalogName)
@@ -311,7 +820,181 @@ func CheckCatalogsExist(ctx context.Context, c client.Client, ext *ocv1.ClusterE
 	return len(catalogList.Items) > 0, nil
 }
 
-func UnpackBundle(i imageutil.Puller, cache imageutil.Cache) ReconcileStepFunc {
+// errUnpackTimeout is wrapped into the error returned by UnpackBundle when
+// unpacking is aborted because it exceeded its configured timeout. Wrapping
+// (rather than a sentinel message match) lets setStatusProgressing map it to
+// the UnpackTimeout reason without coupling to the pull error's wording.
+var errUnpackTimeout = errors.New("unpack timeout exceeded")
+
+// errBlockedByUpgradeConstraints is wrapped into the error returned by
+// handleResolutionError when resolution failed only because the installed
+// bundle's upgrade edges (or UpgradeConstraintPolicy) rule out every bundle
+// that otherwise satisfies the extension's package selection, as opposed to
+// no such bundle existing in the catalog at all. Wrapping lets
+// setStatusProgressing map it to the BlockedByUpgradeConstraints reason so
+// fleet tooling can tell the two cases apart.
+var errBlockedByUpgradeConstraints = errors.New("blocked by upgrade constraints")
+
+// maintenanceModeRequeueAfter bounds how long a ClusterExtension paused by
+// CheckMaintenanceMode waits before re-checking whether maintenance mode has ended,
+// rather than relying solely on a ConfigMap watch or exponential backoff.
+const maintenanceModeRequeueAfter = 30 * time.Second
+
+// CheckMaintenanceMode defers unpacking and applying ext's resolved bundle while
+// cluster-wide maintenance mode is enabled, so installs and upgrades can be frozen ahead
+// of a maintenance window without pausing reconciliation altogether: resolution (and the
+// UpgradeAvailable status it produces) and the drift-detection watches set up by a
+// previous successful apply keep running, since this step only short-circuits the steps
+// that come after it.
+func CheckMaintenanceMode(m MaintenanceModeChecker) ReconcileStepFunc {
+	return func(ctx context.Context, state *reconcileState, ext *ocv1.ClusterExtension) (*ctrl.Result, error) {
+		enabled, err := m.IsEnabled(ctx)
+		if err != nil {
+			err = fmt.Errorf("checking maintenance mode: %w", err)
+			setStatusProgressing(ext, err)
+			return nil, err
+		}
+
+		if !enabled {
+			SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+				Type:               ocv1.TypePaused,
+				Status:             metav1.ConditionFalse,
+				Reason:             ocv1.ReasonMaintenanceModeDisabled,
+				Message:            "cluster-wide maintenance mode is not enabled",
+				ObservedGeneration: ext.GetGeneration(),
+			})
+			return nil, nil
+		}
+
+		message := "installs and upgrades are paused cluster-wide for maintenance"
+		SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+			Type:               ocv1.TypePaused,
+			Status:             metav1.ConditionTrue,
+			Reason:             ocv1.ReasonMaintenanceModeEnabled,
+			Message:            message,
+			ObservedGeneration: ext.GetGeneration(),
+		})
+		setStatusProgressing(ext, errors.New(message))
+		return &ctrl.Result{RequeueAfter: maintenanceModeRequeueAfter}, nil
+	}
+}
+
+// appliedCheckpoint is the combination of inputs that determine whether an apply would do any new
+// work: a digest of the resolved bundle image and the rendered configuration, plus the bundle that
+// digest was computed for. It doesn't need to be cryptographically strong, only stable and
+// collision-resistant enough for a steady-state optimization - a digest collision only costs an
+// unnecessary apply, it never causes a missed update.
+type appliedCheckpoint struct {
+	digest    string
+	bundle    ocv1.BundleMetadata
+	appliedAt time.Time
+}
+
+// clusterExtensionDigest hashes the inputs that determine whether a reconcile would produce a
+// different result than the last one: the resolved bundle's image reference and its rendered
+// configuration.
+func clusterExtensionDigest(image string, cfg *ocv1.ClusterExtensionConfig) (string, error) {
+	configJSON, err := json.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("hashing rendered configuration: %w", err)
+	}
+	sum := sha256.New()
+	sum.Write([]byte(image))
+	sum.Write([]byte{0})
+	sum.Write(configJSON)
+	return hex.EncodeToString(sum.Sum(nil)), nil
+}
+
+func extensionKey(ext *ocv1.ClusterExtension) types.NamespacedName {
+	return types.NamespacedName{Namespace: ext.GetNamespace(), Name: ext.GetName()}
+}
+
+// SkipCache holds the most recent appliedCheckpoint per ClusterExtension, shared between
+// ApplyBundle (which records a checkpoint on every successful apply) and SkipUnchangedBundle
+// (which skips unpacking and applying when the current reconcile's checkpoint still matches).
+// It's safe for concurrent use by multiple ClusterExtension reconciles.
+type SkipCache struct {
+	mu    sync.Mutex
+	byExt map[types.NamespacedName]appliedCheckpoint
+}
+
+// NewSkipCache returns an empty SkipCache.
+func NewSkipCache() *SkipCache {
+	return &SkipCache{byExt: map[types.NamespacedName]appliedCheckpoint{}}
+}
+
+func (c *SkipCache) record(key types.NamespacedName, cp appliedCheckpoint) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.byExt[key] = cp
+}
+
+func (c *SkipCache) get(key types.NamespacedName) (appliedCheckpoint, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cp, ok := c.byExt[key]
+	return cp, ok
+}
+
+// skipCacheFreshness bounds how long SkipUnchangedBundle trusts a checkpoint without forcing a
+// real apply. The digest/bundle comparison only tells us the *desired* state hasn't changed; it
+// says nothing about whether the live objects have drifted (e.g. a manual edit or delete) since
+// the last real apply. Bounding the skip window ensures the applier's own drift-correcting
+// re-apply (e.g. Helm's release reconciliation) still runs periodically even through an
+// indefinitely steady-state ClusterExtension spec.
+const skipCacheFreshness = 10 * time.Minute
+
+// SkipUnchangedBundle skips the Unpack and Apply steps entirely when the resolved bundle digest,
+// rendered configuration, and currently installed release are all unchanged since the last
+// reconcile that successfully applied them, making steady-state reconciles (no spec, catalog, or
+// drift change) near-free. Instead of unpacking and applying, it only refreshes the Healthy
+// condition, via the Applier's HealthRefresher capability. The skip is only honored for
+// skipCacheFreshness after the checkpoint was recorded, so the applier's drift-correcting re-apply
+// still runs periodically even when the ClusterExtension spec itself never changes. An Applier
+// that doesn't implement HealthRefresher, or a reconcile that isn't yet in a steady installed
+// state, always falls through to a normal unpack and apply.
+func SkipUnchangedBundle(a Applier, cache *SkipCache) ReconcileStepFunc {
+	refresher, canRefreshHealth := a.(HealthRefresher)
+
+	return func(ctx context.Context, state *reconcileState, ext *ocv1.ClusterExtension) (*ctrl.Result, error) {
+		if !canRefreshHealth || cache == nil ||
+			state.resolvedRevisionMetadata == nil ||
+			state.revisionStates == nil || state.revisionStates.Installed == nil ||
+			state.revisionStates.Installed.BundleMetadata != state.resolvedRevisionMetadata.BundleMetadata {
+			return nil, nil
+		}
+		if !apimeta.IsStatusConditionTrue(ext.Status.Conditions, ocv1.TypeInstalled) || len(ext.Status.ApplyErrors) > 0 {
+			return nil, nil
+		}
+
+		digest, err := clusterExtensionDigest(state.resolvedRevisionMetadata.Image, ext.Spec.Config)
+		if err != nil {
+			return nil, nil
+		}
+		checkpoint, ok := cache.get(extensionKey(ext))
+		if !ok || checkpoint.digest != digest || checkpoint.bundle != state.resolvedRevisionMetadata.BundleMetadata ||
+			time.Since(checkpoint.appliedAt) > skipCacheFreshness {
+			return nil, nil
+		}
+
+		healthResult, err := refresher.RefreshHealth(ctx, ext)
+		if err != nil {
+			// A failed health refresh doesn't mean the release itself is broken; fall through to
+			// a normal apply rather than fail the reconcile over it.
+			return nil, nil
+		}
+
+		l := log.FromContext(ctx)
+		l.V(1).Info("bundle, configuration, and release unchanged, skipping unpack and apply",
+			"bundle", state.resolvedRevisionMetadata.Name, "version", state.resolvedRevisionMetadata.Version)
+		setHealthyStatus(ext, healthResult)
+		checkHealthTimeout(ext)
+		metrics.ReconcileStageSkippedTotal.WithLabelValues(ext.Spec.Source.Catalog.PackageName).Inc()
+		return &ctrl.Result{}, nil
+	}
+}
+
+func UnpackBundle(i imageutil.Puller, cache imageutil.Cache, defaultUnpackTimeout time.Duration) ReconcileStepFunc {
 	return func(ctx context.Context, state *reconcileState, ext *ocv1.ClusterExtension) (*ctrl.Result, error) {
 		l := log.FromContext(ctx)
 
@@ -320,9 +1003,19 @@ func UnpackBundle(i imageutil.Puller, cache imageutil.Cache) ReconcileStepFunc {
 			return nil, fmt.Errorf("unable to retrieve bundle information")
 		}
 
+		unpackTimeout := defaultUnpackTimeout
+		if ext.Spec.Install != nil && ext.Spec.Install.UnpackTimeout != nil {
+			unpackTimeout = ext.Spec.Install.UnpackTimeout.Duration
+		}
+		unpackCtx, cancel := context.WithTimeout(ctx, unpackTimeout)
+		defer cancel()
+
 		// Always try to pull the bundle content (Pull uses cache-first strategy, so this is efficient)
-		l.V(1).Info("pulling bundle content")
-		imageFS, _, _, err := i.Pull(ctx, ext.GetName(), state.resolvedRevisionMetadata.Image, cache)
+		l.V(1).Info("pulling bundle content", "unpackTimeout", unpackTimeout)
+		imageFS, _, _, err := i.Pull(unpackCtx, ext.GetName(), state.resolvedRevisionMetadata.Image, cache)
+		if err != nil && errors.Is(unpackCtx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("%w: unpacking did not complete within %s: %w", errUnpackTimeout, unpackTimeout, err)
+		}
 
 		// Check if resolved bundle matches installed bundle (no version change)
 		bundleUnchanged := state.revisionStates != nil &&
@@ -355,13 +1048,103 @@ func UnpackBundle(i imageutil.Puller, cache imageutil.Cache) ReconcileStepFunc {
 		}
 
 		state.imageFS = imageFS
+		ext.Status.LastUnpackedTime = ptr.To(metav1.Now())
 		return nil, nil
 	}
 }
 
-func ApplyBundle(a Applier) ReconcileStepFunc {
+// UnpackBundleAsync is UnpackBundle's counterpart for a bounded background worker pool: it submits
+// the resolved bundle's image to queue and, while the pull is still running, requeues the
+// ClusterExtension after unpackPollInterval instead of blocking this reconcile on the pull. This
+// keeps a slow or large pull from occupying a reconcile worker for its entire duration, and lets
+// ClusterExtensions that resolve to the same image share one in-flight pull rather than each
+// starting their own.
+func UnpackBundleAsync(queue *UnpackQueue, defaultUnpackTimeout time.Duration) ReconcileStepFunc {
 	return func(ctx context.Context, state *reconcileState, ext *ocv1.ClusterExtension) (*ctrl.Result, error) {
 		l := log.FromContext(ctx)
+
+		// Defensive check: resolvedRevisionMetadata should be set by ResolveBundle step
+		if state.resolvedRevisionMetadata == nil {
+			return nil, fmt.Errorf("unable to retrieve bundle information")
+		}
+
+		unpackTimeout := defaultUnpackTimeout
+		if ext.Spec.Install != nil && ext.Spec.Install.UnpackTimeout != nil {
+			unpackTimeout = ext.Spec.Install.UnpackTimeout.Duration
+		}
+
+		ref := state.resolvedRevisionMetadata.Image
+		job := queue.Submit(ext.GetName(), ref, unpackTimeout)
+		imageFS, err, done := job.Done()
+		if !done {
+			l.V(1).Info("bundle content pull in progress, requeuing", "unpackTimeout", unpackTimeout)
+			return &ctrl.Result{RequeueAfter: unpackPollInterval}, nil
+		}
+		// Let a later reconcile (for this or any other ClusterExtension resolving to the same
+		// image) start a fresh pull rather than replaying this one's result indefinitely.
+		queue.Forget(ref)
+
+		// Check if resolved bundle matches installed bundle (no version change)
+		bundleUnchanged := state.revisionStates != nil &&
+			state.revisionStates.Installed != nil &&
+			state.resolvedRevisionMetadata.Name == state.revisionStates.Installed.Name &&
+			state.resolvedRevisionMetadata.Version == state.revisionStates.Installed.Version
+
+		if err != nil {
+			if bundleUnchanged {
+				// Bundle hasn't changed and Pull failed (likely cache miss + catalog unavailable).
+				// This happens in fallback mode after catalog deletion. Set imageFS to nil so the
+				// applier can maintain the workload using existing Helm release or ClusterExtensionRevision.
+				l.V(1).Info("bundle content unavailable but version unchanged, maintaining current installation",
+					"bundle", state.resolvedRevisionMetadata.Name,
+					"version", state.resolvedRevisionMetadata.Version,
+					"error", err.Error())
+				state.imageFS = nil
+				return nil, nil
+			}
+			// New bundle version but Pull failed - this is an error condition
+			setStatusProgressing(ext, wrapErrorWithResolutionInfo(state.resolvedRevisionMetadata.BundleMetadata, err))
+			setInstalledStatusFromRevisionStates(ext, state.revisionStates)
+			return nil, err
+		}
+
+		if bundleUnchanged {
+			l.V(1).Info("bundle unchanged, using cached content for resource reconciliation",
+				"bundle", state.resolvedRevisionMetadata.Name,
+				"version", state.resolvedRevisionMetadata.Version)
+		}
+
+		state.imageFS = imageFS
+		ext.Status.LastUnpackedTime = ptr.To(metav1.Now())
+		return nil, nil
+	}
+}
+
+// ApplyBundleOption configures optional behavior of ApplyBundle.
+type ApplyBundleOption func(*applyBundleConfig)
+
+type applyBundleConfig struct {
+	skipCache *SkipCache
+}
+
+// WithSkipCache records every successful apply's checkpoint in cache, so a subsequent
+// SkipUnchangedBundle step sharing the same cache can recognize a steady-state reconcile and skip
+// unpacking and applying again.
+func WithSkipCache(cache *SkipCache) ApplyBundleOption {
+	return func(c *applyBundleConfig) {
+		c.skipCache = cache
+	}
+}
+
+func ApplyBundle(a Applier, opts ...ApplyBundleOption) ReconcileStepFunc {
+	cfg := &applyBundleConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return func(ctx context.Context, state *reconcileState, ext *ocv1.ClusterExtension) (*ctrl.Result, error) {
+		l := log.FromContext(ctx)
+		metrics.ReconcileStageExecutedTotal.WithLabelValues(ext.Spec.Source.Catalog.PackageName).Inc()
 		revisionAnnotations := map[string]string{
 			labels.BundleNameKey:      state.resolvedRevisionMetadata.Name,
 			labels.PackageNameKey:     state.resolvedRevisionMetadata.Package,
@@ -383,22 +1166,35 @@ func ApplyBundle(a Applier) ReconcileStepFunc {
 		// to ensure exponential backoff can occur:
 		//   - Permission errors (it is not possible to watch changes to permissions.
 		//     The only way to eventually recover from permission errors is to keep retrying).
-		rolloutSucceeded, rolloutStatus, err := a.Apply(ctx, state.imageFS, ext, objLbls, revisionAnnotations)
+		previousInstalled := state.revisionStates.Installed
+		rolloutSucceeded, rolloutStatus, healthResult, err := a.Apply(ctx, state.imageFS, ext, objLbls, revisionAnnotations)
 
 		// Set installed status
 		if rolloutSucceeded {
 			state.revisionStates = &RevisionStates{Installed: state.resolvedRevisionMetadata}
+			installTime := metav1.Now()
+			recordInstallMetric(previousInstalled, state.resolvedRevisionMetadata)
+			recordAutomaticUpgrade(ext, previousInstalled, state.resolvedRevisionMetadata, installTime)
+			appendHistoryEntry(ext, previousInstalled, state.resolvedRevisionMetadata, installTime)
+			ext.Status.LastInstallTime = ptr.To(installTime)
 		} else if err == nil && state.revisionStates.Installed == nil && len(state.revisionStates.RollingOut) == 0 {
 			state.revisionStates = &RevisionStates{RollingOut: []*RevisionMetadata{state.resolvedRevisionMetadata}}
 		}
 		setInstalledStatusFromRevisionStates(ext, state.revisionStates)
+		setHealthyStatus(ext, healthResult)
+		checkHealthTimeout(ext)
 
 		// If there was an error applying the resolved bundle,
 		// report the error via the Progressing condition.
 		if err != nil {
+			ext.Status.ApplyErrors = applyErrorList(err)
+			ext.Status.MissingPermissions = missingPermissionsList(ext.GetName(), err)
 			setStatusProgressing(ext, wrapErrorWithResolutionInfo(state.resolvedRevisionMetadata.BundleMetadata, err))
 			return nil, err
-		} else if !rolloutSucceeded {
+		}
+		ext.Status.ApplyErrors = nil
+		ext.Status.MissingPermissions = nil
+		if !rolloutSucceeded {
 			apimeta.SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
 				Type:               ocv1.TypeProgressing,
 				Status:             metav1.ConditionTrue,
@@ -409,6 +1205,118 @@ func ApplyBundle(a Applier) ReconcileStepFunc {
 		} else {
 			setStatusProgressing(ext, nil)
 		}
+		if rolloutSucceeded && cfg.skipCache != nil {
+			if digest, digestErr := clusterExtensionDigest(state.resolvedRevisionMetadata.Image, ext.Spec.Config); digestErr == nil {
+				cfg.skipCache.record(extensionKey(ext), appliedCheckpoint{
+					digest:    digest,
+					bundle:    state.resolvedRevisionMetadata.BundleMetadata,
+					appliedAt: time.Now(),
+				})
+			}
+		}
 		return nil, nil
 	}
 }
+
+// maxHistoryEntries bounds the number of entries recorded in
+// ClusterExtensionStatus.History.
+const maxHistoryEntries = 10
+
+// classifyOutcome compares current, the revision that was just successfully
+// applied, against previous, the revision that was installed immediately
+// beforehand (nil for a first-time install), and reports how they relate.
+// changed is false when previous and current are the same version, i.e. a
+// reconcile re-applied the already-installed bundle rather than moving to a
+// different one. A version that fails to parse as semver is treated as an
+// upgrade, since it can't be ordered against the previous version.
+func classifyOutcome(previous, current *RevisionMetadata) (outcome ocv1.ClusterExtensionHistoryOutcome, changed bool) {
+	if previous == nil {
+		return ocv1.ClusterExtensionHistoryOutcomeInstall, true
+	}
+	if previous.Version == current.Version {
+		return "", false
+	}
+
+	previousVersion, prevErr := bsemver.Parse(previous.Version)
+	currentVersion, curErr := bsemver.Parse(current.Version)
+	if prevErr == nil && curErr == nil && currentVersion.LT(previousVersion) {
+		return ocv1.ClusterExtensionHistoryOutcomeRollback, true
+	}
+	return ocv1.ClusterExtensionHistoryOutcomeUpgrade, true
+}
+
+// recordInstallMetric increments the install, upgrade, or rollback counter
+// for a successful rollout to current, based on previous, the revision that
+// was installed immediately beforehand (nil for a first-time install).
+func recordInstallMetric(previous, current *RevisionMetadata) {
+	outcome, changed := classifyOutcome(previous, current)
+	if !changed {
+		return
+	}
+
+	pkg := current.Package
+	switch outcome {
+	case ocv1.ClusterExtensionHistoryOutcomeInstall:
+		metrics.InstallsTotal.WithLabelValues(pkg).Inc()
+	case ocv1.ClusterExtensionHistoryOutcomeRollback:
+		metrics.RollbacksTotal.WithLabelValues(pkg).Inc()
+	default:
+		metrics.UpgradesTotal.WithLabelValues(pkg).Inc()
+	}
+}
+
+// isPinnedVersion reports whether versionRange, the value of
+// spec.source.catalog.version, pins installation to a single exact version rather than
+// constraining it to a range. Per the field's documentation, a version string that parses as an
+// exact semver version (e.g. "0.6.0") pins; anything else, including the empty string, is a range
+// that's eligible for automatic channel-head tracking.
+func isPinnedVersion(versionRange string) bool {
+	_, err := mmsemver.NewVersion(versionRange)
+	return err == nil
+}
+
+// recordAutomaticUpgrade sets ext.Status.LastAutomaticUpgradeTime and increments
+// metrics.AutomaticUpgradesTotal when the successful rollout to current is an upgrade that
+// happened by channel-head tracking, i.e. ext's version isn't pinned to an exact version. previous
+// is the revision that was installed immediately beforehand (nil for a first-time install).
+func recordAutomaticUpgrade(ext *ocv1.ClusterExtension, previous, current *RevisionMetadata, upgradedAt metav1.Time) {
+	outcome, changed := classifyOutcome(previous, current)
+	if !changed || outcome != ocv1.ClusterExtensionHistoryOutcomeUpgrade {
+		return
+	}
+
+	versionRange := ""
+	if ext.Spec.Source.Catalog != nil {
+		versionRange = ext.Spec.Source.Catalog.Version
+	}
+	if isPinnedVersion(versionRange) {
+		return
+	}
+
+	ext.Status.LastAutomaticUpgradeTime = ptr.To(upgradedAt)
+	metrics.AutomaticUpgradesTotal.WithLabelValues(current.Package).Inc()
+}
+
+// appendHistoryEntry records current, the revision that was just
+// successfully applied, at the front of ext.Status.History, bounded to
+// maxHistoryEntries. previous is the revision that was installed immediately
+// beforehand (nil for a first-time install); no entry is recorded if current
+// is the same version as previous, since that reflects a reconcile
+// re-applying the already-installed bundle rather than a version change.
+func appendHistoryEntry(ext *ocv1.ClusterExtension, previous, current *RevisionMetadata, installedAt metav1.Time) {
+	outcome, changed := classifyOutcome(previous, current)
+	if !changed {
+		return
+	}
+
+	entry := ocv1.ClusterExtensionHistoryEntry{
+		Bundle:      current.BundleMetadata,
+		Image:       current.Image,
+		Outcome:     outcome,
+		InstalledAt: installedAt,
+	}
+	ext.Status.History = append([]ocv1.ClusterExtensionHistoryEntry{entry}, ext.Status.History...)
+	if len(ext.Status.History) > maxHistoryEntries {
+		ext.Status.History = ext.Status.History[:maxHistoryEntries]
+	}
+}
@@ -0,0 +1,635 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/bundle"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/health"
+)
+
+// fakeNewestExistingResolver implements resolve.NewestExistingResolver (and the base
+// resolve.Resolver interface, unused by these tests) by returning a fixed version or error.
+type fakeNewestExistingResolver struct {
+	newestExisting *bundle.VersionRelease
+	err            error
+}
+
+func (f *fakeNewestExistingResolver) Resolve(context.Context, *ocv1.ClusterExtension, *ocv1.BundleMetadata) (*declcfg.Bundle, *bundle.VersionRelease, *declcfg.Deprecation, error) {
+	return nil, nil, nil, errors.New("not implemented")
+}
+
+func (f *fakeNewestExistingResolver) ResolveNewestExisting(context.Context, *ocv1.ClusterExtension) (*bundle.VersionRelease, error) {
+	return f.newestExisting, f.err
+}
+
+func mustVersionRelease(t *testing.T, v string) *bundle.VersionRelease {
+	t.Helper()
+	vr, err := bundle.NewLegacyRegistryV1VersionRelease(v)
+	require.NoError(t, err)
+	return vr
+}
+
+func TestSetUpgradeAvailableStatus(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		installed      *RevisionMetadata
+		accepted       *RevisionMetadata
+		newestExisting *bundle.VersionRelease
+		resolveErr     error
+		expectedStatus metav1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:           "nothing installed yet",
+			installed:      nil,
+			expectedStatus: metav1.ConditionUnknown,
+			expectedReason: ocv1.ReasonUpgradeAvailableUnknown,
+		},
+		{
+			name:           "already at the newest existing version",
+			installed:      &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.0.0"}},
+			newestExisting: mustVersionRelease(t, "1.0.0"),
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: ocv1.ReasonNoUpgradeAvailable,
+		},
+		{
+			name:           "newer version exists but resolution accepted the installed version",
+			installed:      &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.0.0"}},
+			accepted:       &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.0.0"}},
+			newestExisting: mustVersionRelease(t, "1.2.0"),
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: ocv1.ReasonUpgradeAvailable,
+		},
+		{
+			name:           "resolution accepted a newer version, so it's not blocked",
+			installed:      &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.0.0"}},
+			accepted:       &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.2.0"}},
+			newestExisting: mustVersionRelease(t, "1.2.0"),
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: ocv1.ReasonNoUpgradeAvailable,
+		},
+		{
+			name:           "unable to determine newest existing version",
+			installed:      &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.0.0"}},
+			resolveErr:     errors.New("boom"),
+			expectedStatus: metav1.ConditionUnknown,
+			expectedReason: ocv1.ReasonUpgradeAvailableUnknown,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ext := &ocv1.ClusterExtension{}
+			r := &fakeNewestExistingResolver{newestExisting: tc.newestExisting, err: tc.resolveErr}
+
+			setUpgradeAvailableStatus(t.Context(), r, ext, tc.installed, tc.accepted)
+
+			cond := meta.FindStatusCondition(ext.Status.Conditions, ocv1.TypeUpgradeAvailable)
+			require.NotNil(t, cond)
+			require.Equal(t, tc.expectedStatus, cond.Status)
+			require.Equal(t, tc.expectedReason, cond.Reason)
+		})
+	}
+}
+
+func TestIsPinnedVersion(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		versionRange string
+		expectPinned bool
+	}{
+		{name: "empty is a range, not a pin", versionRange: "", expectPinned: false},
+		{name: "exact version pins", versionRange: "0.6.0", expectPinned: true},
+		{name: "exact version with build metadata pins", versionRange: "1.2.3+build1", expectPinned: true},
+		{name: "comparison range does not pin", versionRange: ">=1.0.0, <1.5.0", expectPinned: false},
+		{name: "wildcard range does not pin", versionRange: "1.2.x", expectPinned: false},
+		{name: "caret range does not pin", versionRange: "^1.2.3", expectPinned: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectPinned, isPinnedVersion(tc.versionRange))
+		})
+	}
+}
+
+func TestRecordAutomaticUpgrade(t *testing.T) {
+	installTime := metav1.Now()
+
+	for _, tc := range []struct {
+		name             string
+		versionRange     string
+		previous         *RevisionMetadata
+		current          *RevisionMetadata
+		expectLastUpdate bool
+	}{
+		{
+			name:             "first install is not an automatic upgrade",
+			current:          &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.0.0"}},
+			expectLastUpdate: false,
+		},
+		{
+			name:             "unpinned upgrade is automatic",
+			previous:         &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.0.0"}},
+			current:          &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.1.0"}},
+			expectLastUpdate: true,
+		},
+		{
+			name:             "version range upgrade is automatic",
+			versionRange:     ">=1.0.0, <2.0.0",
+			previous:         &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.0.0"}},
+			current:          &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.1.0"}},
+			expectLastUpdate: true,
+		},
+		{
+			name:             "pinned version is never an automatic upgrade",
+			versionRange:     "1.1.0",
+			previous:         &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.0.0"}},
+			current:          &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.1.0"}},
+			expectLastUpdate: false,
+		},
+		{
+			name:             "rollback is not an automatic upgrade",
+			previous:         &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.1.0"}},
+			current:          &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.0.0"}},
+			expectLastUpdate: false,
+		},
+		{
+			name:             "re-applying the same version is not an automatic upgrade",
+			previous:         &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.0.0"}},
+			current:          &RevisionMetadata{BundleMetadata: ocv1.BundleMetadata{Version: "1.0.0"}},
+			expectLastUpdate: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ext := &ocv1.ClusterExtension{
+				Spec: ocv1.ClusterExtensionSpec{
+					Source: ocv1.SourceConfig{
+						Catalog: &ocv1.CatalogFilter{Version: tc.versionRange},
+					},
+				},
+			}
+
+			recordAutomaticUpgrade(ext, tc.previous, tc.current, installTime)
+
+			if tc.expectLastUpdate {
+				require.NotNil(t, ext.Status.LastAutomaticUpgradeTime)
+				require.Equal(t, installTime, *ext.Status.LastAutomaticUpgradeTime)
+			} else {
+				require.Nil(t, ext.Status.LastAutomaticUpgradeTime)
+			}
+		})
+	}
+}
+
+func TestShouldAutoRevert(t *testing.T) {
+	threshold := int32(3)
+
+	for _, tc := range []struct {
+		name       string
+		threshold  *int32
+		failCount  int32
+		generation int64
+		rolledBack *metav1.Condition
+		expect     bool
+	}{
+		{name: "disabled", threshold: nil, failCount: 10, expect: false},
+		{name: "below threshold", threshold: &threshold, failCount: 2, expect: false},
+		{name: "at threshold", threshold: &threshold, failCount: 3, expect: true},
+		{
+			name:       "sticky: already rolled back at the current generation, count has since reset",
+			threshold:  &threshold,
+			failCount:  0,
+			generation: 2,
+			rolledBack: &metav1.Condition{Type: ocv1.TypeRolledBack, Status: metav1.ConditionTrue, ObservedGeneration: 2},
+			expect:     true,
+		},
+		{
+			name:       "not sticky across a generation change",
+			threshold:  &threshold,
+			failCount:  0,
+			generation: 3,
+			rolledBack: &metav1.Condition{Type: ocv1.TypeRolledBack, Status: metav1.ConditionTrue, ObservedGeneration: 2},
+			expect:     false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ext := &ocv1.ClusterExtension{
+				ObjectMeta: metav1.ObjectMeta{Generation: tc.generation},
+				Spec:       ocv1.ClusterExtensionSpec{AutoRevertAfterFailures: tc.threshold},
+				Status:     ocv1.ClusterExtensionStatus{FailureRepeatCount: tc.failCount},
+			}
+			if tc.rolledBack != nil {
+				ext.Status.Conditions = append(ext.Status.Conditions, *tc.rolledBack)
+			}
+
+			require.Equal(t, tc.expect, shouldAutoRevert(ext))
+		})
+	}
+}
+
+func TestResolveRollbackTarget(t *testing.T) {
+	installedAt := metav1.Now()
+	history := []ocv1.ClusterExtensionHistoryEntry{
+		{Bundle: ocv1.BundleMetadata{Name: "test-operator.v1.1.0", Version: "1.1.0"}, Image: "registry.io/test-operator@sha256:1.1.0", Outcome: ocv1.ClusterExtensionHistoryOutcomeUpgrade, InstalledAt: installedAt},
+		{Bundle: ocv1.BundleMetadata{Name: "test-operator.v1.0.0", Version: "1.0.0"}, Image: "registry.io/test-operator@sha256:1.0.0", Outcome: ocv1.ClusterExtensionHistoryOutcomeInstall, InstalledAt: installedAt},
+	}
+
+	t.Run("matching history entry resolves", func(t *testing.T) {
+		ext := &ocv1.ClusterExtension{
+			Spec: ocv1.ClusterExtensionSpec{
+				Source:     ocv1.SourceConfig{Catalog: &ocv1.CatalogFilter{PackageName: "test-operator"}},
+				RollbackTo: "1.0.0",
+			},
+			Status: ocv1.ClusterExtensionStatus{History: history},
+		}
+		state := &reconcileState{}
+
+		res, err := resolveRollbackTarget(state, ext)
+
+		require.NoError(t, err)
+		require.Nil(t, res)
+		require.NotNil(t, state.resolvedRevisionMetadata)
+		require.Equal(t, "test-operator", state.resolvedRevisionMetadata.Package)
+		require.Equal(t, "registry.io/test-operator@sha256:1.0.0", state.resolvedRevisionMetadata.Image)
+		require.Equal(t, history[1].Bundle, state.resolvedRevisionMetadata.BundleMetadata)
+	})
+
+	t.Run("no matching history entry is a terminal error", func(t *testing.T) {
+		ext := &ocv1.ClusterExtension{
+			Spec: ocv1.ClusterExtensionSpec{
+				Source:     ocv1.SourceConfig{Catalog: &ocv1.CatalogFilter{PackageName: "test-operator"}},
+				RollbackTo: "9.9.9",
+			},
+			Status: ocv1.ClusterExtensionStatus{History: history},
+		}
+		state := &reconcileState{}
+
+		_, err := resolveRollbackTarget(state, ext)
+
+		require.Error(t, err)
+		require.ErrorContains(t, err, `rollbackTo "9.9.9" does not match any bundle version in status.history`)
+		require.Nil(t, state.resolvedRevisionMetadata)
+	})
+}
+
+func TestCheckMaintenanceMode(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		checker        StaticMaintenanceModeChecker
+		expectedStatus metav1.ConditionStatus
+		expectedReason string
+		expectRequeue  bool
+	}{
+		{
+			name:           "maintenance mode disabled",
+			checker:        StaticMaintenanceModeChecker(false),
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: ocv1.ReasonMaintenanceModeDisabled,
+		},
+		{
+			name:           "maintenance mode enabled",
+			checker:        StaticMaintenanceModeChecker(true),
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: ocv1.ReasonMaintenanceModeEnabled,
+			expectRequeue:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ext := &ocv1.ClusterExtension{}
+			state := &reconcileState{}
+
+			res, err := CheckMaintenanceMode(tc.checker)(context.Background(), state, ext)
+
+			require.NoError(t, err)
+			cond := meta.FindStatusCondition(ext.Status.Conditions, ocv1.TypePaused)
+			require.NotNil(t, cond)
+			require.Equal(t, tc.expectedStatus, cond.Status)
+			require.Equal(t, tc.expectedReason, cond.Reason)
+			if tc.expectRequeue {
+				require.NotNil(t, res)
+				require.Positive(t, res.RequeueAfter)
+			} else {
+				require.Nil(t, res)
+			}
+		})
+	}
+}
+
+func TestClusterExtensionDigest(t *testing.T) {
+	digest, err := clusterExtensionDigest("registry.example.com/pkg@sha256:abc", &ocv1.ClusterExtensionConfig{Inline: &apiextensionsv1.JSON{Raw: []byte(`{"foo":"bar"}`)}})
+	require.NoError(t, err)
+	require.NotEmpty(t, digest)
+
+	t.Run("same inputs produce the same digest", func(t *testing.T) {
+		again, err := clusterExtensionDigest("registry.example.com/pkg@sha256:abc", &ocv1.ClusterExtensionConfig{Inline: &apiextensionsv1.JSON{Raw: []byte(`{"foo":"bar"}`)}})
+		require.NoError(t, err)
+		require.Equal(t, digest, again)
+	})
+
+	t.Run("a different image changes the digest", func(t *testing.T) {
+		other, err := clusterExtensionDigest("registry.example.com/pkg@sha256:def", &ocv1.ClusterExtensionConfig{Inline: &apiextensionsv1.JSON{Raw: []byte(`{"foo":"bar"}`)}})
+		require.NoError(t, err)
+		require.NotEqual(t, digest, other)
+	})
+
+	t.Run("a different config changes the digest", func(t *testing.T) {
+		other, err := clusterExtensionDigest("registry.example.com/pkg@sha256:abc", &ocv1.ClusterExtensionConfig{Inline: &apiextensionsv1.JSON{Raw: []byte(`{"foo":"baz"}`)}})
+		require.NoError(t, err)
+		require.NotEqual(t, digest, other)
+	})
+
+	t.Run("a nil config is handled like any other config value", func(t *testing.T) {
+		_, err := clusterExtensionDigest("registry.example.com/pkg@sha256:abc", nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestSkipCache(t *testing.T) {
+	cache := NewSkipCache()
+	key := extensionKey(&ocv1.ClusterExtension{ObjectMeta: metav1.ObjectMeta{Name: "ext1"}})
+
+	_, ok := cache.get(key)
+	require.False(t, ok, "an unrecorded extension should not be found")
+
+	want := appliedCheckpoint{digest: "abc", bundle: ocv1.BundleMetadata{Name: "bundle1", Version: "1.0.0"}}
+	cache.record(key, want)
+
+	got, ok := cache.get(key)
+	require.True(t, ok)
+	require.Equal(t, want, got)
+
+	otherKey := extensionKey(&ocv1.ClusterExtension{ObjectMeta: metav1.ObjectMeta{Name: "ext2"}})
+	_, ok = cache.get(otherKey)
+	require.False(t, ok, "a different extension's key must not see ext1's checkpoint")
+}
+
+// fakeHealthRefresherApplier implements controllers.Applier and HealthRefresher, so
+// TestSkipUnchangedBundle can exercise SkipUnchangedBundle without a real applier.
+type fakeHealthRefresherApplier struct {
+	refreshCalls int
+}
+
+func (f *fakeHealthRefresherApplier) Apply(context.Context, fs.FS, *ocv1.ClusterExtension, map[string]string, map[string]string) (bool, string, health.Result, error) {
+	return false, "", health.Result{}, errors.New("Apply should not be called when the bundle is unchanged")
+}
+
+func (f *fakeHealthRefresherApplier) RefreshHealth(context.Context, *ocv1.ClusterExtension) (health.Result, error) {
+	f.refreshCalls++
+	return health.Result{}, nil
+}
+
+func TestSkipUnchangedBundle(t *testing.T) {
+	bundleMeta := ocv1.BundleMetadata{Name: "bundle1", Version: "1.0.0"}
+	installed := &RevisionMetadata{BundleMetadata: bundleMeta}
+
+	newState := func() *reconcileState {
+		return &reconcileState{
+			resolvedRevisionMetadata: &RevisionMetadata{Image: "registry.example.com/pkg@sha256:abc", BundleMetadata: bundleMeta},
+			revisionStates:           &RevisionStates{Installed: installed},
+		}
+	}
+	newExt := func() *ocv1.ClusterExtension {
+		ext := &ocv1.ClusterExtension{}
+		meta.SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+			Type:   ocv1.TypeInstalled,
+			Status: metav1.ConditionTrue,
+			Reason: ocv1.ReasonSucceeded,
+		})
+		return ext
+	}
+
+	t.Run("skips apply when the checkpoint is fresh", func(t *testing.T) {
+		applier := &fakeHealthRefresherApplier{}
+		cache := NewSkipCache()
+		ext := newExt()
+		digest, err := clusterExtensionDigest("registry.example.com/pkg@sha256:abc", ext.Spec.Config)
+		require.NoError(t, err)
+		cache.record(extensionKey(ext), appliedCheckpoint{digest: digest, bundle: bundleMeta, appliedAt: time.Now()})
+
+		res, err := SkipUnchangedBundle(applier, cache)(context.Background(), newState(), ext)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Equal(t, 1, applier.refreshCalls)
+	})
+
+	t.Run("falls through to a real apply once the checkpoint goes stale", func(t *testing.T) {
+		applier := &fakeHealthRefresherApplier{}
+		cache := NewSkipCache()
+		ext := newExt()
+		digest, err := clusterExtensionDigest("registry.example.com/pkg@sha256:abc", ext.Spec.Config)
+		require.NoError(t, err)
+		cache.record(extensionKey(ext), appliedCheckpoint{
+			digest:    digest,
+			bundle:    bundleMeta,
+			appliedAt: time.Now().Add(-(skipCacheFreshness + time.Minute)),
+		})
+
+		res, err := SkipUnchangedBundle(applier, cache)(context.Background(), newState(), ext)
+		require.NoError(t, err)
+		require.Nil(t, res, "a stale checkpoint must fall through so the applier's drift correction runs")
+		require.Equal(t, 0, applier.refreshCalls)
+	})
+}
+
+func TestCheckOLMv0PackageConflict(t *testing.T) {
+	newExt := func(adoptionPolicy ocv1.AdoptionPolicy) *ocv1.ClusterExtension {
+		return &ocv1.ClusterExtension{
+			Spec: ocv1.ClusterExtensionSpec{
+				Source: ocv1.SourceConfig{
+					SourceType: ocv1.SourceTypeCatalog,
+					Catalog:    &ocv1.CatalogFilter{PackageName: "pkg"},
+				},
+				Install: &ocv1.ClusterExtensionInstallConfig{AdoptionPolicy: adoptionPolicy},
+			},
+		}
+	}
+
+	t.Run("no OLMv0 Subscription manages the package", func(t *testing.T) {
+		testScheme := runtime.NewScheme()
+		require.NoError(t, ocv1.AddToScheme(testScheme))
+		require.NoError(t, v1alpha1.AddToScheme(testScheme))
+		cl := fake.NewClientBuilder().WithScheme(testScheme).Build()
+
+		ext := newExt(ocv1.AdoptionPolicyCreateOnly)
+		res, err := CheckOLMv0PackageConflict(cl)(context.Background(), &reconcileState{}, ext)
+		require.NoError(t, err)
+		require.Nil(t, res)
+
+		cond := meta.FindStatusCondition(ext.Status.Conditions, ocv1.TypeManagedByOLMv0)
+		require.NotNil(t, cond)
+		require.Equal(t, metav1.ConditionFalse, cond.Status)
+		require.Equal(t, ocv1.ReasonNotManagedByOLMv0, cond.Reason)
+	})
+
+	t.Run("an OLMv0 Subscription already manages the package", func(t *testing.T) {
+		testScheme := runtime.NewScheme()
+		require.NoError(t, ocv1.AddToScheme(testScheme))
+		require.NoError(t, v1alpha1.AddToScheme(testScheme))
+		sub := &v1alpha1.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: "pkg-sub", Namespace: "olm"},
+			Spec:       &v1alpha1.SubscriptionSpec{Package: "pkg"},
+		}
+		cl := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(sub).Build()
+
+		ext := newExt(ocv1.AdoptionPolicyCreateOnly)
+		res, err := CheckOLMv0PackageConflict(cl)(context.Background(), &reconcileState{}, ext)
+		require.Nil(t, res)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, reconcile.TerminalError(nil)))
+		require.Contains(t, err.Error(), "olm/pkg-sub")
+
+		cond := meta.FindStatusCondition(ext.Status.Conditions, ocv1.TypeManagedByOLMv0)
+		require.NotNil(t, cond)
+		require.Equal(t, metav1.ConditionTrue, cond.Status)
+		require.Equal(t, ocv1.ReasonManagedByOLMv0, cond.Reason)
+	})
+
+	t.Run("AdoptOLMV0 adoption policy skips the check", func(t *testing.T) {
+		testScheme := runtime.NewScheme()
+		require.NoError(t, ocv1.AddToScheme(testScheme))
+		require.NoError(t, v1alpha1.AddToScheme(testScheme))
+		sub := &v1alpha1.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: "pkg-sub", Namespace: "olm"},
+			Spec:       &v1alpha1.SubscriptionSpec{Package: "pkg"},
+		}
+		cl := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(sub).Build()
+
+		ext := newExt(ocv1.AdoptionPolicyAdoptOLMV0)
+		ext.Status.Conditions = []metav1.Condition{{
+			Type:               ocv1.TypeManagedByOLMv0,
+			Status:             metav1.ConditionTrue,
+			Reason:             ocv1.ReasonManagedByOLMv0,
+			Message:            "stale",
+			ObservedGeneration: 1,
+			LastTransitionTime: metav1.Now(),
+		}}
+		res, err := CheckOLMv0PackageConflict(cl)(context.Background(), &reconcileState{}, ext)
+		require.NoError(t, err)
+		require.Nil(t, res)
+		require.Nil(t, meta.FindStatusCondition(ext.Status.Conditions, ocv1.TypeManagedByOLMv0))
+	})
+
+	t.Run("OLMv0 isn't installed", func(t *testing.T) {
+		testScheme := runtime.NewScheme()
+		require.NoError(t, ocv1.AddToScheme(testScheme))
+		cl := fake.NewClientBuilder().WithScheme(testScheme).Build()
+
+		ext := newExt(ocv1.AdoptionPolicyCreateOnly)
+		res, err := CheckOLMv0PackageConflict(cl)(context.Background(), &reconcileState{}, ext)
+		require.NoError(t, err)
+		require.Nil(t, res)
+		require.Nil(t, meta.FindStatusCondition(ext.Status.Conditions, ocv1.TypeManagedByOLMv0))
+	})
+}
+
+func TestCheckPackageSingleton(t *testing.T) {
+	newExt := func(name string, allowMultiple bool) *ocv1.ClusterExtension {
+		return &ocv1.ClusterExtension{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: ocv1.ClusterExtensionSpec{
+				Source: ocv1.SourceConfig{
+					SourceType: ocv1.SourceTypeCatalog,
+					Catalog:    &ocv1.CatalogFilter{PackageName: "pkg"},
+				},
+				Install: &ocv1.ClusterExtensionInstallConfig{AllowMultiplePerPackage: allowMultiple},
+			},
+		}
+	}
+
+	t.Run("no other ClusterExtension installs the package", func(t *testing.T) {
+		testScheme := runtime.NewScheme()
+		require.NoError(t, ocv1.AddToScheme(testScheme))
+		cl := fake.NewClientBuilder().WithScheme(testScheme).Build()
+
+		ext := newExt("this-extension", false)
+		res, err := CheckPackageSingleton(cl)(context.Background(), &reconcileState{}, ext)
+		require.NoError(t, err)
+		require.Nil(t, res)
+
+		cond := meta.FindStatusCondition(ext.Status.Conditions, ocv1.TypeDuplicatePackageInstall)
+		require.NotNil(t, cond)
+		require.Equal(t, metav1.ConditionFalse, cond.Status)
+		require.Equal(t, ocv1.ReasonNoDuplicatePackageInstall, cond.Reason)
+	})
+
+	t.Run("another ClusterExtension already installs the package", func(t *testing.T) {
+		testScheme := runtime.NewScheme()
+		require.NoError(t, ocv1.AddToScheme(testScheme))
+		other := newExt("other-extension", false)
+		cl := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(other).Build()
+
+		ext := newExt("this-extension", false)
+		res, err := CheckPackageSingleton(cl)(context.Background(), &reconcileState{}, ext)
+		require.Nil(t, res)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, reconcile.TerminalError(nil)))
+		require.Contains(t, err.Error(), "other-extension")
+
+		cond := meta.FindStatusCondition(ext.Status.Conditions, ocv1.TypeDuplicatePackageInstall)
+		require.NotNil(t, cond)
+		require.Equal(t, metav1.ConditionTrue, cond.Status)
+		require.Equal(t, ocv1.ReasonDuplicatePackageInstall, cond.Reason)
+	})
+
+	t.Run("only the other ClusterExtension opted in via allowMultiplePerPackage", func(t *testing.T) {
+		testScheme := runtime.NewScheme()
+		require.NoError(t, ocv1.AddToScheme(testScheme))
+		other := newExt("other-extension", true)
+		cl := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(other).Build()
+
+		ext := newExt("this-extension", false)
+		res, err := CheckPackageSingleton(cl)(context.Background(), &reconcileState{}, ext)
+		require.Nil(t, res)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, reconcile.TerminalError(nil)))
+		require.Contains(t, err.Error(), "other-extension")
+	})
+
+	t.Run("only this ClusterExtension opted in via allowMultiplePerPackage", func(t *testing.T) {
+		testScheme := runtime.NewScheme()
+		require.NoError(t, ocv1.AddToScheme(testScheme))
+		other := newExt("other-extension", false)
+		cl := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(other).Build()
+
+		ext := newExt("this-extension", true)
+		res, err := CheckPackageSingleton(cl)(context.Background(), &reconcileState{}, ext)
+		require.Nil(t, res)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, reconcile.TerminalError(nil)))
+		require.Contains(t, err.Error(), "other-extension")
+	})
+
+	t.Run("both ClusterExtensions opted in via allowMultiplePerPackage", func(t *testing.T) {
+		testScheme := runtime.NewScheme()
+		require.NoError(t, ocv1.AddToScheme(testScheme))
+		other := newExt("other-extension", true)
+		cl := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(other).Build()
+
+		ext := newExt("this-extension", true)
+		ext.Status.Conditions = []metav1.Condition{{
+			Type:               ocv1.TypeDuplicatePackageInstall,
+			Status:             metav1.ConditionTrue,
+			Reason:             ocv1.ReasonDuplicatePackageInstall,
+			Message:            "stale",
+			ObservedGeneration: 1,
+			LastTransitionTime: metav1.Now(),
+		}}
+		res, err := CheckPackageSingleton(cl)(context.Background(), &reconcileState{}, ext)
+		require.NoError(t, err)
+		require.Nil(t, res)
+		require.Nil(t, meta.FindStatusCondition(ext.Status.Conditions, ocv1.TypeDuplicatePackageInstall))
+	})
+}
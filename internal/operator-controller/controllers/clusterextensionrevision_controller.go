@@ -440,6 +440,12 @@ func (c *ClusterExtensionRevisionReconciler) toBoxcutterRevision(ctx context.Con
 		previousObjs[i] = rev
 	}
 
+	// The ProgressProbeType probe gates phase-to-phase progression: boxcutter
+	// won't move on to a phase's dependents until every probed object in it
+	// reports success. Registering crdProbe here means a CustomResourceDefinition
+	// must reach Established=True (at the current generation) before boxcutter
+	// proceeds past the phase that owns it, so bundles that ship both a CRD and
+	// CRs of that CRD's type install reliably on the first pass.
 	opts := []boxcutter.RevisionReconcileOption{
 		boxcutter.WithPreviousOwners(previousObjs),
 		boxcutter.WithProbe(boxcutter.ProgressProbeType, probing.And{
@@ -7,10 +7,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	boxcuttertypes "pkg.package-operator.run/boxcutter/machinery/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -235,3 +237,61 @@ func (m *mockTrackingCacheInternal) Watch(ctx context.Context, user client.Objec
 func (m *mockTrackingCacheInternal) Source(h handler.EventHandler, predicates ...predicate.Predicate) source.Source {
 	return nil
 }
+
+func Test_crdProbe(t *testing.T) {
+	newCRD := func(generation int64, conditions ...map[string]any) *unstructured.Unstructured {
+		crd := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]any{
+				"name":       "widgets.example.com",
+				"generation": generation,
+			},
+		}}
+		if len(conditions) > 0 {
+			untypedConditions := make([]any, len(conditions))
+			for i, c := range conditions {
+				untypedConditions[i] = c
+			}
+			require.NoError(t, unstructured.SetNestedSlice(crd.Object, untypedConditions, "status", "conditions"))
+		}
+		return crd
+	}
+
+	for _, tc := range []struct {
+		name           string
+		obj            *unstructured.Unstructured
+		expectedStatus boxcuttertypes.ProbeStatus
+	}{
+		{
+			name:           "Established condition is True at the current generation",
+			obj:            newCRD(2, map[string]any{"type": "Established", "status": "True", "observedGeneration": int64(2)}),
+			expectedStatus: boxcuttertypes.ProbeStatusTrue,
+		},
+		{
+			name:           "Established condition is False",
+			obj:            newCRD(2, map[string]any{"type": "Established", "status": "False", "observedGeneration": int64(2)}),
+			expectedStatus: boxcuttertypes.ProbeStatusFalse,
+		},
+		{
+			name:           "Established condition is missing",
+			obj:            newCRD(2),
+			expectedStatus: boxcuttertypes.ProbeStatusUnknown,
+		},
+		{
+			name:           "Established condition is stale relative to the current generation",
+			obj:            newCRD(3, map[string]any{"type": "Established", "status": "True", "observedGeneration": int64(2)}),
+			expectedStatus: boxcuttertypes.ProbeStatusUnknown,
+		},
+		{
+			name:           "non-CRD objects are skipped and considered passing",
+			obj:            &unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "ConfigMap"}},
+			expectedStatus: boxcuttertypes.ProbeStatusTrue,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := crdProbe.Probe(tc.obj)
+			require.Equal(t, tc.expectedStatus, result.Status)
+		})
+	}
+}
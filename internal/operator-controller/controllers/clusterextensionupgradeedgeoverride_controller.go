@@ -0,0 +1,111 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	bsemver "github.com/blang/semver/v4"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+// ClusterExtensionUpgradeEdgeOverrideReconciler reconciles a ClusterExtensionUpgradeEdgeOverride
+// object.
+//
+// It only maintains the override's Valid condition. The override's edges are otherwise read
+// directly by resolve.CatalogResolver when resolving a bundle for a ClusterExtension; this
+// reconciler does not itself affect resolution.
+type ClusterExtensionUpgradeEdgeOverrideReconciler struct {
+	client.Client
+}
+
+//+kubebuilder:rbac:groups=olm.operatorframework.io,resources=clusterextensionupgradeedgeoverrides,verbs=get;list;watch
+//+kubebuilder:rbac:groups=olm.operatorframework.io,resources=clusterextensionupgradeedgeoverrides/status,verbs=update;patch
+
+func (r *ClusterExtensionUpgradeEdgeOverrideReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	l := log.FromContext(ctx).WithName("cluster-extension-upgrade-edge-override")
+	ctx = log.IntoContext(ctx, l)
+
+	l.Info("reconcile starting")
+	defer l.Info("reconcile ending")
+
+	existingOverride := &ocv1.ClusterExtensionUpgradeEdgeOverride{}
+	if err := r.Get(ctx, req.NamespacedName, existingOverride); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	reconciledOverride := existingOverride.DeepCopy()
+	res, reconcileErr := r.reconcile(ctx, reconciledOverride)
+
+	if !equality.Semantic.DeepEqual(existingOverride.Status, reconciledOverride.Status) {
+		if err := r.Status().Update(ctx, reconciledOverride); err != nil {
+			reconcileErr = errors.Join(reconcileErr, fmt.Errorf("error updating status: %v", err))
+		}
+	}
+
+	return res, reconcileErr
+}
+
+func (r *ClusterExtensionUpgradeEdgeOverrideReconciler) reconcile(_ context.Context, override *ocv1.ClusterExtensionUpgradeEdgeOverride) (ctrl.Result, error) {
+	for _, edge := range override.Spec.Edges {
+		if _, err := bsemver.Parse(edge.FromVersion); err != nil {
+			return r.invalid(override, fmt.Sprintf("fromVersion %q is not a valid semver version: %s", edge.FromVersion, err))
+		}
+		if _, err := bsemver.Parse(edge.ToVersion); err != nil {
+			return r.invalid(override, fmt.Sprintf("toVersion %q is not a valid semver version: %s", edge.ToVersion, err))
+		}
+	}
+
+	SetStatusCondition(&override.Status.Conditions, metav1.Condition{
+		Type:               ocv1.TypeValid,
+		Status:             metav1.ConditionTrue,
+		Reason:             ocv1.ReasonEdgesValid,
+		Message:            fmt.Sprintf("all %d edge(s) are well-formed", len(override.Spec.Edges)),
+		ObservedGeneration: override.GetGeneration(),
+	})
+	return ctrl.Result{}, nil
+}
+
+func (r *ClusterExtensionUpgradeEdgeOverrideReconciler) invalid(override *ocv1.ClusterExtensionUpgradeEdgeOverride, message string) (ctrl.Result, error) {
+	SetStatusCondition(&override.Status.Conditions, metav1.Condition{
+		Type:               ocv1.TypeValid,
+		Status:             metav1.ConditionFalse,
+		Reason:             ocv1.ReasonInvalidEdge,
+		Message:            message,
+		ObservedGeneration: override.GetGeneration(),
+	})
+	return ctrl.Result{}, reconcile.TerminalError(errors.New(message))
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterExtensionUpgradeEdgeOverrideReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	_, err := ctrl.NewControllerManagedBy(mgr).
+		Named("controller-operator-clusterextensionupgradeedgeoverride-controller").
+		For(&ocv1.ClusterExtensionUpgradeEdgeOverride{}).
+		Build(r)
+
+	return err
+}
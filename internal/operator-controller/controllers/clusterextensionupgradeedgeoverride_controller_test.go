@@ -0,0 +1,91 @@
+package controllers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/controllers"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/scheme"
+)
+
+func TestClusterExtensionUpgradeEdgeOverrideReconciler(t *testing.T) {
+	overrideKey := types.NamespacedName{Name: "test-override"}
+
+	for _, tt := range []struct {
+		name            string
+		override        *ocv1.ClusterExtensionUpgradeEdgeOverride
+		wantValidTrue   bool
+		wantTerminalErr bool
+	}{
+		{
+			name: "well-formed edges are valid",
+			override: &ocv1.ClusterExtensionUpgradeEdgeOverride{
+				ObjectMeta: metav1.ObjectMeta{Name: overrideKey.Name},
+				Spec: ocv1.ClusterExtensionUpgradeEdgeOverrideSpec{
+					PackageName: "foo",
+					Edges: []ocv1.UpgradeEdgeOverride{
+						{FromVersion: "1.0.0", ToVersion: "1.2.0"},
+					},
+				},
+			},
+			wantValidTrue: true,
+		},
+		{
+			name: "invalid fromVersion is a terminal error",
+			override: &ocv1.ClusterExtensionUpgradeEdgeOverride{
+				ObjectMeta: metav1.ObjectMeta{Name: overrideKey.Name},
+				Spec: ocv1.ClusterExtensionUpgradeEdgeOverrideSpec{
+					PackageName: "foo",
+					Edges: []ocv1.UpgradeEdgeOverride{
+						{FromVersion: "not-a-semver", ToVersion: "1.2.0"},
+					},
+				},
+			},
+			wantTerminalErr: true,
+		},
+		{
+			name: "invalid toVersion is a terminal error",
+			override: &ocv1.ClusterExtensionUpgradeEdgeOverride{
+				ObjectMeta: metav1.ObjectMeta{Name: overrideKey.Name},
+				Spec: ocv1.ClusterExtensionUpgradeEdgeOverrideSpec{
+					PackageName: "foo",
+					Edges: []ocv1.UpgradeEdgeOverride{
+						{FromVersion: "1.0.0", ToVersion: "not-a-semver"},
+					},
+				},
+			},
+			wantTerminalErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).
+				WithStatusSubresource(&ocv1.ClusterExtensionUpgradeEdgeOverride{}).
+				WithObjects(tt.override).Build()
+
+			reconciler := &controllers.ClusterExtensionUpgradeEdgeOverrideReconciler{Client: cl}
+			_, err := reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: overrideKey})
+
+			updated := &ocv1.ClusterExtensionUpgradeEdgeOverride{}
+			require.NoError(t, cl.Get(t.Context(), overrideKey, updated))
+			cond := apimeta.FindStatusCondition(updated.Status.Conditions, ocv1.TypeValid)
+			require.NotNil(t, cond)
+
+			if tt.wantTerminalErr {
+				require.Error(t, err)
+				require.Equal(t, metav1.ConditionFalse, cond.Status)
+				require.Equal(t, ocv1.ReasonInvalidEdge, cond.Reason)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, metav1.ConditionTrue, cond.Status)
+			require.Equal(t, ocv1.ReasonEdgesValid, cond.Reason)
+		})
+	}
+}
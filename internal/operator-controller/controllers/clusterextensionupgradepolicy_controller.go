@@ -0,0 +1,147 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crhandler "sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+// ClusterExtensionUpgradePolicyReconciler reconciles a ClusterExtensionUpgradePolicy object.
+//
+// It only maintains the policy's selection status: status.matchedExtensions and the
+// Selecting condition. Enforcing the policy's upgrade controls - windows, approval,
+// and the concurrency cap - against the selected ClusterExtensions' own reconciliation
+// is left for a follow-up; doing so means threading policy-derived constraints into
+// ClusterExtensionReconciler.ResolveBundle, which is a bigger, separate change.
+type ClusterExtensionUpgradePolicyReconciler struct {
+	client.Client
+}
+
+//+kubebuilder:rbac:groups=olm.operatorframework.io,resources=clusterextensionupgradepolicies,verbs=get;list;watch
+//+kubebuilder:rbac:groups=olm.operatorframework.io,resources=clusterextensionupgradepolicies/status,verbs=update;patch
+
+func (r *ClusterExtensionUpgradePolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	l := log.FromContext(ctx).WithName("cluster-extension-upgrade-policy")
+	ctx = log.IntoContext(ctx, l)
+
+	l.Info("reconcile starting")
+	defer l.Info("reconcile ending")
+
+	existingPolicy := &ocv1.ClusterExtensionUpgradePolicy{}
+	if err := r.Get(ctx, req.NamespacedName, existingPolicy); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	reconciledPolicy := existingPolicy.DeepCopy()
+	res, reconcileErr := r.reconcile(ctx, reconciledPolicy)
+
+	if !equality.Semantic.DeepEqual(existingPolicy.Status, reconciledPolicy.Status) {
+		if err := r.Status().Update(ctx, reconciledPolicy); err != nil {
+			reconcileErr = errors.Join(reconcileErr, fmt.Errorf("error updating status: %v", err))
+		}
+	}
+
+	return res, reconcileErr
+}
+
+func (r *ClusterExtensionUpgradePolicyReconciler) reconcile(ctx context.Context, policy *ocv1.ClusterExtensionUpgradePolicy) (ctrl.Result, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.Selector)
+	if err != nil {
+		SetStatusCondition(&policy.Status.Conditions, metav1.Condition{
+			Type:               ocv1.TypeSelecting,
+			Status:             metav1.ConditionFalse,
+			Reason:             ocv1.ReasonInvalidSelector,
+			Message:            fmt.Sprintf("invalid selector: %s", err),
+			ObservedGeneration: policy.GetGeneration(),
+		})
+		policy.Status.MatchedExtensions = nil
+		return ctrl.Result{}, reconcile.TerminalError(err)
+	}
+
+	extensionList := &ocv1.ClusterExtensionList{}
+	if err := r.List(ctx, extensionList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("listing ClusterExtensions matching selector: %w", err)
+	}
+
+	matched := make([]string, 0, len(extensionList.Items))
+	for _, ext := range extensionList.Items {
+		matched = append(matched, ext.Name)
+	}
+	sort.Strings(matched)
+	policy.Status.MatchedExtensions = matched
+
+	selectingCond := metav1.Condition{
+		Type:               ocv1.TypeSelecting,
+		Status:             metav1.ConditionTrue,
+		Reason:             ocv1.ReasonExtensionsSelected,
+		Message:            fmt.Sprintf("selected %d ClusterExtension(s)", len(matched)),
+		ObservedGeneration: policy.GetGeneration(),
+	}
+	if len(matched) == 0 {
+		selectingCond.Status = metav1.ConditionFalse
+		selectingCond.Reason = ocv1.ReasonNoExtensionsMatched
+		selectingCond.Message = "selector matched no ClusterExtensions"
+	}
+	SetStatusCondition(&policy.Status.Conditions, selectingCond)
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterExtensionUpgradePolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	_, err := ctrl.NewControllerManagedBy(mgr).
+		Named("controller-operator-clusterextensionupgradepolicy-controller").
+		For(&ocv1.ClusterExtensionUpgradePolicy{}).
+		Watches(&ocv1.ClusterExtension{},
+			crhandler.EnqueueRequestsFromMapFunc(allClusterExtensionUpgradePoliciesMapFunc(mgr.GetClient(), mgr.GetLogger()))).
+		Build(r)
+
+	return err
+}
+
+// allClusterExtensionUpgradePoliciesMapFunc re-reconciles every ClusterExtensionUpgradePolicy
+// whenever any ClusterExtension changes, since a policy has no way to know in advance which
+// ClusterExtensions a label change might newly match or stop matching.
+func allClusterExtensionUpgradePoliciesMapFunc(c client.Reader, logger logr.Logger) crhandler.MapFunc {
+	return func(ctx context.Context, _ client.Object) []reconcile.Request {
+		policies := &metav1.PartialObjectMetadataList{}
+		policies.SetGroupVersionKind(ocv1.GroupVersion.WithKind("ClusterExtensionUpgradePolicyList"))
+		if err := c.List(ctx, policies); err != nil {
+			logger.Error(err, "unable to enqueue cluster extension upgrade policies for cluster extension reconcile")
+			return nil
+		}
+		requests := make([]reconcile.Request, 0, len(policies.Items))
+		for _, policy := range policies.Items {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&policy)})
+		}
+		return requests
+	}
+}
@@ -0,0 +1,103 @@
+package controllers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/controllers"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/scheme"
+)
+
+func TestClusterExtensionUpgradePolicyReconciler(t *testing.T) {
+	policyKey := types.NamespacedName{Name: "test-policy"}
+
+	for _, tt := range []struct {
+		name              string
+		policy            *ocv1.ClusterExtensionUpgradePolicy
+		extensions        []ocv1.ClusterExtension
+		wantMatched       []string
+		wantSelectingTrue bool
+		wantTerminalErr   bool
+	}{
+		{
+			name: "selector matches some extensions",
+			policy: &ocv1.ClusterExtensionUpgradePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: policyKey.Name},
+				Spec: ocv1.ClusterExtensionUpgradePolicySpec{
+					Selector: metav1.LabelSelector{MatchLabels: map[string]string{"fleet": "a"}},
+				},
+			},
+			extensions: []ocv1.ClusterExtension{
+				{ObjectMeta: metav1.ObjectMeta{Name: "ext-b", Labels: map[string]string{"fleet": "a"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "ext-a", Labels: map[string]string{"fleet": "a"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "ext-c", Labels: map[string]string{"fleet": "b"}}},
+			},
+			wantMatched:       []string{"ext-a", "ext-b"},
+			wantSelectingTrue: true,
+		},
+		{
+			name: "selector matches nothing",
+			policy: &ocv1.ClusterExtensionUpgradePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: policyKey.Name},
+				Spec: ocv1.ClusterExtensionUpgradePolicySpec{
+					Selector: metav1.LabelSelector{MatchLabels: map[string]string{"fleet": "nonexistent"}},
+				},
+			},
+			extensions: []ocv1.ClusterExtension{
+				{ObjectMeta: metav1.ObjectMeta{Name: "ext-a", Labels: map[string]string{"fleet": "a"}}},
+			},
+			wantMatched:       nil,
+			wantSelectingTrue: false,
+		},
+		{
+			name: "invalid selector is a terminal error",
+			policy: &ocv1.ClusterExtensionUpgradePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: policyKey.Name},
+				Spec: ocv1.ClusterExtensionUpgradePolicySpec{
+					Selector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "fleet", Operator: "NotAnOperator"},
+					}},
+				},
+			},
+			wantTerminalErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			clientBuilder := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithStatusSubresource(&ocv1.ClusterExtensionUpgradePolicy{}).WithObjects(tt.policy)
+			for i := range tt.extensions {
+				clientBuilder = clientBuilder.WithObjects(&tt.extensions[i])
+			}
+			cl := clientBuilder.Build()
+
+			reconciler := &controllers.ClusterExtensionUpgradePolicyReconciler{Client: cl}
+			_, err := reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: policyKey})
+
+			if tt.wantTerminalErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			updated := &ocv1.ClusterExtensionUpgradePolicy{}
+			require.NoError(t, cl.Get(t.Context(), policyKey, updated))
+			require.Equal(t, tt.wantMatched, updated.Status.MatchedExtensions)
+
+			cond := apimeta.FindStatusCondition(updated.Status.Conditions, ocv1.TypeSelecting)
+			require.NotNil(t, cond)
+			if tt.wantSelectingTrue {
+				require.Equal(t, metav1.ConditionTrue, cond.Status)
+				require.Equal(t, ocv1.ReasonExtensionsSelected, cond.Reason)
+			} else {
+				require.Equal(t, metav1.ConditionFalse, cond.Status)
+				require.Equal(t, ocv1.ReasonNoExtensionsMatched, cond.Reason)
+			}
+		})
+	}
+}
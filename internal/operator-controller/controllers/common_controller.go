@@ -19,12 +19,19 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-multierror"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/authorization"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/health"
 )
 
 const (
@@ -32,6 +39,18 @@ const (
 	maxConditionMessageLength = 32768
 	// truncationSuffix is the suffix added when a message is cut.
 	truncationSuffix = "\n\n... [message truncated]"
+	// maxApplyErrors bounds the number of per-object errors recorded in
+	// ClusterExtensionStatus.ApplyErrors.
+	maxApplyErrors = 10
+	// maxMissingPermissions bounds the number of rendered Role/ClusterRole
+	// YAML documents recorded in ClusterExtensionStatus.MissingPermissions.
+	maxMissingPermissions = 10
+	// maxAvailableUpgradeEdges bounds the number of entries recorded in
+	// ClusterExtensionStatus.AvailableUpgradeEdges, keeping the highest versions.
+	maxAvailableUpgradeEdges = 20
+	// helmUpdateErrorSeparator is the separator the Helm SDK uses to join
+	// per-object errors encountered while updating existing resources.
+	helmUpdateErrorSeparator = " && "
 )
 
 // truncateMessage cuts long messages to fit Kubernetes condition limits
@@ -67,6 +86,14 @@ func setInstalledStatusFromRevisionStates(ext *ocv1.ClusterExtension, revisionSt
 	installStatus := &ocv1.ClusterExtensionInstallStatus{
 		Bundle: revisionStates.Installed.BundleMetadata,
 	}
+	// installedAt tracks when this bundle version was first installed, so it
+	// must carry over across reconciles that keep reapplying the same version.
+	if prev := ext.Status.Install; prev != nil && prev.Bundle == installStatus.Bundle {
+		installStatus.InstalledAt = prev.InstalledAt
+	}
+	if installStatus.InstalledAt == nil {
+		installStatus.InstalledAt = ptr.To(metav1.Now())
+	}
 	setInstallStatus(ext, installStatus)
 	setInstalledStatusConditionSuccess(ext, fmt.Sprintf("Installed bundle %s successfully", revisionStates.Installed.Image))
 }
@@ -104,6 +131,17 @@ func setInstalledStatusConditionUnknown(ext *ocv1.ClusterExtension, message stri
 	})
 }
 
+// admissionDeniedPattern and quotaExceededPattern recognize the apiserver
+// error messages produced when applying a bundle's manifests is rejected by
+// a validating/mutating admission webhook or by quota/limit-range
+// enforcement, so setStatusProgressing can surface a more specific Reason
+// than the default Retrying. Both messages already carry the offending
+// webhook or quota name, which ends up in the condition's Message.
+var (
+	admissionDeniedPattern = regexp.MustCompile(`admission webhook "[^"]+" denied the request`)
+	quotaExceededPattern   = regexp.MustCompile(`exceeded quota: |exceeds limit range`)
+)
+
 func setInstallStatus(ext *ocv1.ClusterExtension, installStatus *ocv1.ClusterExtensionInstallStatus) {
 	ext.Status.Install = installStatus
 }
@@ -119,6 +157,18 @@ func setStatusProgressing(ext *ocv1.ClusterExtension, err error) {
 
 	if err != nil {
 		progressingCond.Reason = ocv1.ReasonRetrying
+		switch {
+		case ext.GetDeletionTimestamp() != nil:
+			progressingCond.Reason = ocv1.ReasonTerminating
+		case errors.Is(err, errUnpackTimeout):
+			progressingCond.Reason = ocv1.ReasonUnpackTimeout
+		case errors.Is(err, errBlockedByUpgradeConstraints):
+			progressingCond.Reason = ocv1.ReasonBlockedByUpgradeConstraints
+		case admissionDeniedPattern.MatchString(err.Error()):
+			progressingCond.Reason = ocv1.ReasonAdmissionDenied
+		case quotaExceededPattern.MatchString(err.Error()):
+			progressingCond.Reason = ocv1.ReasonQuotaExceeded
+		}
 		progressingCond.Message = err.Error()
 	}
 
@@ -129,3 +179,115 @@ func setStatusProgressing(ext *ocv1.ClusterExtension, err error) {
 
 	SetStatusCondition(&ext.Status.Conditions, progressingCond)
 }
+
+// setHealthyStatus sets the Healthy condition and the bounded list of
+// unhealthy objects from the applier's health.Result. When the result was
+// not evaluated (e.g. the active applier doesn't yet support it), the
+// condition is set to Unknown rather than making a claim either way.
+func setHealthyStatus(ext *ocv1.ClusterExtension, result health.Result) {
+	// If the spec changed since the Healthy condition was last set, restart
+	// the wait clock: a resource that failed to become healthy under the
+	// previous spec shouldn't count against the timeout for the new one.
+	if prev := apimeta.FindStatusCondition(ext.Status.Conditions, ocv1.TypeHealthy); prev != nil && prev.ObservedGeneration != ext.GetGeneration() {
+		apimeta.RemoveStatusCondition(&ext.Status.Conditions, ocv1.TypeHealthy)
+	}
+
+	if !result.Evaluated {
+		SetStatusCondition(&ext.Status.Conditions, metav1.Condition{
+			Type:               ocv1.TypeHealthy,
+			Status:             metav1.ConditionUnknown,
+			Reason:             ocv1.ReasonHealthUnknown,
+			Message:            "health of applied resources has not been evaluated",
+			ObservedGeneration: ext.GetGeneration(),
+		})
+		ext.Status.UnhealthyObjects = nil
+		return
+	}
+
+	healthyCond := metav1.Condition{
+		Type:               ocv1.TypeHealthy,
+		Status:             metav1.ConditionTrue,
+		Reason:             ocv1.ReasonSucceeded,
+		Message:            "all applied resources are healthy",
+		ObservedGeneration: ext.GetGeneration(),
+	}
+	if !result.Healthy {
+		healthyCond.Status = metav1.ConditionFalse
+		healthyCond.Reason = ocv1.ReasonFailed
+		healthyCond.Message = fmt.Sprintf("%d unhealthy object(s): %s", len(result.UnhealthyObjects), strings.Join(result.UnhealthyObjects, "; "))
+		if result.Truncated {
+			healthyCond.Message += "; ... (truncated)"
+		}
+	}
+	SetStatusCondition(&ext.Status.Conditions, healthyCond)
+	ext.Status.UnhealthyObjects = result.UnhealthyObjects
+}
+
+// checkHealthTimeout marks the Installed condition False with reason Timeout
+// once the Healthy condition has been continuously False for longer than
+// spec.install.timeout. The Healthy condition's LastTransitionTime is used as
+// the start of the wait; setHealthyStatus resets it whenever the generation
+// changes, so the wait is automatically restarted on spec changes.
+func checkHealthTimeout(ext *ocv1.ClusterExtension) {
+	if ext.Spec.Install == nil || ext.Spec.Install.Timeout == nil {
+		return
+	}
+	cond := apimeta.FindStatusCondition(ext.Status.Conditions, ocv1.TypeHealthy)
+	if cond == nil || cond.Status != metav1.ConditionFalse {
+		return
+	}
+	if time.Since(cond.LastTransitionTime.Time) < ext.Spec.Install.Timeout.Duration {
+		return
+	}
+	setInstalledStatusConditionFalse(ext, ocv1.ReasonTimeout, fmt.Sprintf("timed out after %s waiting for applied resources to become healthy: %s", ext.Spec.Install.Timeout.Duration, cond.Message))
+}
+
+// applyErrorList extracts a bounded list of individual error messages from
+// err, an error returned from applying a bundle's contents. The Helm SDK
+// aggregates per-object failures (e.g. one RBAC object forbidden) into a
+// single error, either a *multierror.Error (object creation) or a string
+// joined with helmUpdateErrorSeparator (object updates); each underlying
+// Kubernetes API error already identifies the offending object by kind,
+// namespace, and name. If err doesn't match either shape, it is returned as
+// a single entry.
+func applyErrorList(err error) []string {
+	if err == nil {
+		return nil
+	}
+
+	var messages []string
+	var merr *multierror.Error
+	if errors.As(err, &merr) {
+		for _, e := range merr.Errors {
+			messages = append(messages, e.Error())
+		}
+	} else {
+		messages = strings.Split(err.Error(), helmUpdateErrorSeparator)
+	}
+
+	if len(messages) > maxApplyErrors {
+		messages = messages[:maxApplyErrors]
+	}
+	return messages
+}
+
+// missingPermissionsList extracts, from err, a bounded list of ready-to-apply
+// Role/ClusterRole YAML documents granting the RBAC rules a preflight
+// permission check found missing. It returns nil if err doesn't wrap a
+// *authorization.MissingRulesError, i.e. the apply failure wasn't caused by
+// missing permissions.
+func missingPermissionsList(extName string, err error) []string {
+	var missingRulesErr *authorization.MissingRulesError
+	if !errors.As(err, &missingRulesErr) {
+		return nil
+	}
+
+	docs, renderErr := authorization.RenderMissingRulesYAML(extName, missingRulesErr.MissingRules)
+	if renderErr != nil {
+		return []string{fmt.Sprintf("error rendering missing permissions: %s", renderErr)}
+	}
+	if len(docs) > maxMissingPermissions {
+		docs = docs[:maxMissingPermissions]
+	}
+	return docs
+}
@@ -5,15 +5,18 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/authorization"
 )
 
 func TestSetStatusProgressing(t *testing.T) {
@@ -56,6 +59,52 @@ func TestSetStatusProgressing(t *testing.T) {
 				Message: "terminal error: boom",
 			},
 		},
+		{
+			name:             "non-nil ClusterExtension, admission webhook error, Progressing condition has status True with reason AdmissionDenied",
+			err:              errors.New(`Deployment.apps "my-operator" is invalid: admission webhook "policy.example.com" denied the request: image tag ":latest" is not allowed`),
+			clusterExtension: &ocv1.ClusterExtension{},
+			expected: metav1.Condition{
+				Type:    ocv1.TypeProgressing,
+				Status:  metav1.ConditionTrue,
+				Reason:  ocv1.ReasonAdmissionDenied,
+				Message: `Deployment.apps "my-operator" is invalid: admission webhook "policy.example.com" denied the request: image tag ":latest" is not allowed`,
+			},
+		},
+		{
+			name:             "non-nil ClusterExtension, resource quota error, Progressing condition has status True with reason QuotaExceeded",
+			err:              errors.New(`pods "my-operator-abc" is forbidden: exceeded quota: compute-quota, requested: pods=1, used: pods=5, limited: pods=5`),
+			clusterExtension: &ocv1.ClusterExtension{},
+			expected: metav1.Condition{
+				Type:    ocv1.TypeProgressing,
+				Status:  metav1.ConditionTrue,
+				Reason:  ocv1.ReasonQuotaExceeded,
+				Message: `pods "my-operator-abc" is forbidden: exceeded quota: compute-quota, requested: pods=1, used: pods=5, limited: pods=5`,
+			},
+		},
+		{
+			name:             "non-nil ClusterExtension, error wrapping errBlockedByUpgradeConstraints, Progressing condition has status True with reason BlockedByUpgradeConstraints",
+			err:              fmt.Errorf("%w: %w", errBlockedByUpgradeConstraints, errors.New("no bundle satisfies the upgrade edge constraints")),
+			clusterExtension: &ocv1.ClusterExtension{},
+			expected: metav1.Condition{
+				Type:    ocv1.TypeProgressing,
+				Status:  metav1.ConditionTrue,
+				Reason:  ocv1.ReasonBlockedByUpgradeConstraints,
+				Message: "blocked by upgrade constraints: no bundle satisfies the upgrade edge constraints",
+			},
+		},
+		{
+			name: "ClusterExtension being deleted, finalizer still waiting on objects, Progressing condition has status True with reason Terminating",
+			err:  errors.New("waiting for 2 release object(s) to be deleted, e.g. Service \"ns-a/service-a\""),
+			clusterExtension: &ocv1.ClusterExtension{
+				ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &metav1.Time{Time: time.Now()}, Finalizers: []string{"olm.operatorframework.io/cleanup-release"}},
+			},
+			expected: metav1.Condition{
+				Type:    ocv1.TypeProgressing,
+				Status:  metav1.ConditionTrue,
+				Reason:  ocv1.ReasonTerminating,
+				Message: `waiting for 2 release object(s) to be deleted, e.g. Service "ns-a/service-a"`,
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			setStatusProgressing(tc.clusterExtension, tc.err)
@@ -67,6 +116,28 @@ func TestSetStatusProgressing(t *testing.T) {
 	}
 }
 
+func TestMissingPermissionsList(t *testing.T) {
+	t.Run("nil for an error that isn't a MissingRulesError", func(t *testing.T) {
+		require.Nil(t, missingPermissionsList("my-extension", errors.New("boom")))
+	})
+
+	t.Run("renders missing permissions YAML for a MissingRulesError", func(t *testing.T) {
+		err := &authorization.MissingRulesError{
+			Err: errors.New("pre-authorization failed"),
+			MissingRules: []authorization.ScopedPolicyRules{
+				{
+					Namespace:    "test-namespace",
+					MissingRules: []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"serviceaccounts"}, Verbs: []string{"create"}}},
+				},
+			},
+		}
+		docs := missingPermissionsList("my-extension", err)
+		require.Len(t, docs, 1)
+		require.Contains(t, docs[0], "kind: Role")
+		require.Contains(t, docs[0], "namespace: test-namespace")
+	})
+}
+
 func TestTruncateMessage(t *testing.T) {
 	tests := []struct {
 		name     string
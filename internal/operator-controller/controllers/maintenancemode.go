@@ -0,0 +1,83 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MaintenanceModeConfigMapKey is the ConfigMap data key ConfigMapMaintenanceModeChecker
+// reads to decide whether cluster-wide maintenance mode is enabled.
+const MaintenanceModeConfigMapKey = "maintenanceMode"
+
+// MaintenanceModeChecker reports whether cluster-wide maintenance mode is currently
+// enabled. See CheckMaintenanceMode for how the result is applied during reconciliation.
+type MaintenanceModeChecker interface {
+	IsEnabled(ctx context.Context) (bool, error)
+}
+
+// StaticMaintenanceModeChecker is a MaintenanceModeChecker whose value is fixed for the
+// lifetime of the process, for the --maintenance-mode flag.
+type StaticMaintenanceModeChecker bool
+
+func (s StaticMaintenanceModeChecker) IsEnabled(_ context.Context) (bool, error) {
+	return bool(s), nil
+}
+
+// ConfigMapMaintenanceModeChecker is a MaintenanceModeChecker backed by a ConfigMap's
+// MaintenanceModeConfigMapKey data key, letting an admin toggle maintenance mode without
+// restarting the controller. A missing ConfigMap, or a missing or unrecognized value for
+// the key, is treated as disabled rather than an error, so deleting the ConfigMap is a
+// valid way to turn maintenance mode back off.
+type ConfigMapMaintenanceModeChecker struct {
+	Client       client.Client
+	ConfigMapKey client.ObjectKey
+}
+
+func (c ConfigMapMaintenanceModeChecker) IsEnabled(ctx context.Context) (bool, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.Client.Get(ctx, c.ConfigMapKey, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("getting maintenance mode configmap %q: %w", c.ConfigMapKey, err)
+	}
+	return cm.Data[MaintenanceModeConfigMapKey] == "true", nil
+}
+
+// AnyMaintenanceModeChecker reports maintenance mode as enabled if any of its checkers
+// does, so the --maintenance-mode flag and the --maintenance-mode-configmap ConfigMap can
+// each independently switch it on.
+type AnyMaintenanceModeChecker []MaintenanceModeChecker
+
+func (a AnyMaintenanceModeChecker) IsEnabled(ctx context.Context) (bool, error) {
+	for _, checker := range a {
+		enabled, err := checker.IsEnabled(ctx)
+		if err != nil {
+			return false, err
+		}
+		if enabled {
+			return true, nil
+		}
+	}
+	return false, nil
+}
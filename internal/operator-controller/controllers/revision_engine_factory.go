@@ -65,13 +65,15 @@ func (f *defaultRevisionEngineFactory) CreateRevisionEngine(_ context.Context, r
 		return nil, err
 	}
 
+	fieldOwner := f.fieldOwner(rev)
+
 	return machinery.NewRevisionEngine(
 		machinery.NewPhaseEngine(
 			machinery.NewObjectEngine(
 				f.Scheme, f.TrackingCache, scopedClient,
 				ownerhandling.NewNative(f.Scheme),
-				machinery.NewComparator(ownerhandling.NewNative(f.Scheme), f.DiscoveryClient, f.Scheme, f.FieldOwnerPrefix),
-				f.FieldOwnerPrefix, f.FieldOwnerPrefix,
+				machinery.NewComparator(ownerhandling.NewNative(f.Scheme), f.DiscoveryClient, f.Scheme, fieldOwner),
+				fieldOwner, fieldOwner,
 			),
 			validation.NewClusterPhaseValidator(f.RESTMapper, scopedClient),
 		),
@@ -79,6 +81,18 @@ func (f *defaultRevisionEngineFactory) CreateRevisionEngine(_ context.Context, r
 	), nil
 }
 
+// fieldOwner returns the field manager identity used for server-side apply
+// of rev's objects. It is scoped to the owning ClusterExtension so that
+// distinct extensions never contend for ownership of the same field, even
+// if their rendered manifests happen to overlap.
+func (f *defaultRevisionEngineFactory) fieldOwner(rev *ocv1.ClusterExtensionRevision) string {
+	ownerName := strings.TrimSpace(rev.GetLabels()[labels.OwnerNameKey])
+	if ownerName == "" {
+		return f.FieldOwnerPrefix
+	}
+	return fmt.Sprintf("%s/%s", f.FieldOwnerPrefix, ownerName)
+}
+
 func (f *defaultRevisionEngineFactory) getServiceAccount(rev *ocv1.ClusterExtensionRevision) (string, string, error) {
 	annotations := rev.GetAnnotations()
 	if annotations == nil {
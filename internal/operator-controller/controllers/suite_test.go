@@ -22,6 +22,7 @@ import (
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
@@ -32,6 +33,7 @@ import (
 
 	ocv1 "github.com/operator-framework/operator-controller/api/v1"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/controllers"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/health"
 	"github.com/operator-framework/operator-controller/internal/operator-controller/resolve"
 	"github.com/operator-framework/operator-controller/internal/shared/util/image"
 	"github.com/operator-framework/operator-controller/test"
@@ -74,8 +76,8 @@ type MockApplier struct {
 	err              error
 }
 
-func (m *MockApplier) Apply(_ context.Context, _ fs.FS, _ *ocv1.ClusterExtension, _ map[string]string, _ map[string]string) (bool, string, error) {
-	return m.installCompleted, m.installStatus, m.err
+func (m *MockApplier) Apply(_ context.Context, _ fs.FS, _ *ocv1.ClusterExtension, _ map[string]string, _ map[string]string) (bool, string, health.Result, error) {
+	return m.installCompleted, m.installStatus, health.Result{}, m.err
 }
 
 type reconcilerOption func(*deps)
@@ -109,7 +111,7 @@ func newClientAndReconciler(t *testing.T, opts ...reconcilerOption) (client.Clie
 		reconciler.ReconcileSteps = append(reconciler.ReconcileSteps, controllers.ResolveBundle(r, cl))
 	}
 	if i := d.ImagePuller; i != nil {
-		reconciler.ReconcileSteps = append(reconciler.ReconcileSteps, controllers.UnpackBundle(i, d.ImageCache))
+		reconciler.ReconcileSteps = append(reconciler.ReconcileSteps, controllers.UnpackBundle(i, d.ImageCache, time.Minute))
 	}
 	if a := d.Applier; a != nil {
 		reconciler.ReconcileSteps = append(reconciler.ReconcileSteps, controllers.ApplyBundle(a))
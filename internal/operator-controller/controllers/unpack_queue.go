@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sync"
+	"time"
+
+	imageutil "github.com/operator-framework/operator-controller/internal/shared/util/image"
+)
+
+// unpackPollInterval bounds how long UnpackBundleAsync waits before re-checking an in-flight
+// unpack job, when the ClusterExtension's own RequeueAfter isn't otherwise more frequent.
+const unpackPollInterval = 1 * time.Second
+
+// unpackJob is the result of pulling a single image reference, shared by every ClusterExtension
+// waiting on it. done is closed once the pull completes (successfully or not); imageFS and err are
+// only safe to read after done has been closed.
+type unpackJob struct {
+	done    chan struct{}
+	imageFS fs.FS
+	err     error
+}
+
+// UnpackQueue runs image pulls in a bounded pool of background workers, deduplicating concurrent
+// requests for the same image reference into a single pull, so that reconciling many
+// ClusterExtensions that share a bundle (or a slow, large one) doesn't tie up a reconcile worker
+// per ClusterExtension for the duration of the pull.
+type UnpackQueue struct {
+	puller imageutil.Puller
+	cache  imageutil.Cache
+	sem    chan struct{}
+
+	mu   sync.Mutex
+	jobs map[string]*unpackJob
+}
+
+// NewUnpackQueue returns an UnpackQueue that runs at most maxConcurrentPulls pulls at once,
+// fetched via puller and stored in cache. A maxConcurrentPulls of 0 or less is treated as 1.
+func NewUnpackQueue(puller imageutil.Puller, cache imageutil.Cache, maxConcurrentPulls int) *UnpackQueue {
+	if maxConcurrentPulls <= 0 {
+		maxConcurrentPulls = 1
+	}
+	return &UnpackQueue{
+		puller: puller,
+		cache:  cache,
+		sem:    make(chan struct{}, maxConcurrentPulls),
+		jobs:   map[string]*unpackJob{},
+	}
+}
+
+// Submit starts pulling ref on a background worker, keyed by ref (which, for a resolved
+// ClusterExtension bundle, is already pinned to a digest), unless a pull for ref is already
+// running or has completed and not yet been cleared by Forget. It returns immediately with the
+// job so the caller can poll it without blocking on the pull.
+func (q *UnpackQueue) Submit(ownerID, ref string, timeout time.Duration) *unpackJob {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if job, ok := q.jobs[ref]; ok {
+		return job
+	}
+
+	job := &unpackJob{done: make(chan struct{})}
+	q.jobs[ref] = job
+	go q.run(job, ownerID, ref, timeout)
+	return job
+}
+
+// Forget drops the completed job for ref, so a later Submit for the same ref starts a fresh pull
+// instead of replaying a stale result (for example after the cache entry it produced has been
+// garbage collected, or after a transient error).
+func (q *UnpackQueue) Forget(ref string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.jobs, ref)
+}
+
+func (q *UnpackQueue) run(job *unpackJob, ownerID, ref string, timeout time.Duration) {
+	q.sem <- struct{}{}
+	defer func() { <-q.sem }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	job.imageFS, _, _, job.err = q.puller.Pull(ctx, ownerID, ref, q.cache)
+	if job.err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		job.err = fmt.Errorf("%w: unpacking did not complete within %s: %w", errUnpackTimeout, timeout, job.err)
+	}
+	close(job.done)
+}
+
+// Done reports whether job has finished, and if so, its result.
+func (j *unpackJob) Done() (imageFS fs.FS, err error, done bool) {
+	select {
+	case <-j.done:
+		return j.imageFS, j.err, true
+	default:
+		return nil, nil, false
+	}
+}
@@ -0,0 +1,109 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.podman.io/image/v5/docker/reference"
+
+	imageutil "github.com/operator-framework/operator-controller/internal/shared/util/image"
+)
+
+type fakeSlowPuller struct {
+	delay       time.Duration
+	calls       atomic.Int32
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+	failRef     string
+}
+
+func (p *fakeSlowPuller) Pull(ctx context.Context, _, ref string, _ imageutil.Cache) (fs.FS, reference.Canonical, time.Time, error) {
+	p.calls.Add(1)
+	n := p.inFlight.Add(1)
+	for {
+		mx := p.maxInFlight.Load()
+		if n <= mx || p.maxInFlight.CompareAndSwap(mx, n) {
+			break
+		}
+	}
+	defer p.inFlight.Add(-1)
+
+	select {
+	case <-time.After(p.delay):
+	case <-ctx.Done():
+		return nil, nil, time.Time{}, ctx.Err()
+	}
+	if ref == p.failRef {
+		return nil, nil, time.Time{}, errors.New("boom")
+	}
+	return nil, nil, time.Time{}, nil
+}
+
+func waitDone(t *testing.T, job *unpackJob) (fs.FS, error) {
+	t.Helper()
+	for {
+		if imageFS, err, ok := job.Done(); ok {
+			return imageFS, err
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestUnpackQueueDedupesAndBoundsConcurrency(t *testing.T) {
+	puller := &fakeSlowPuller{delay: 100 * time.Millisecond}
+	queue := NewUnpackQueue(puller, imageutil.MockCache{}, 2)
+
+	// Five concurrent submissions for the same ref should result in a single pull.
+	done := make(chan struct{}, 8)
+	for i := 0; i < 5; i++ {
+		go func() {
+			_, err := waitDone(t, queue.Submit("owner-a", "same-ref", 5*time.Second))
+			require.NoError(t, err)
+			done <- struct{}{}
+		}()
+	}
+	// Three concurrent submissions for distinct refs should each pull once, bounded to 2 at a
+	// time by the worker pool.
+	for i := 0; i < 3; i++ {
+		ref := fmt.Sprintf("distinct-ref-%d", i)
+		go func(ref string) {
+			_, err := waitDone(t, queue.Submit("owner-b", ref, 5*time.Second))
+			require.NoError(t, err)
+			done <- struct{}{}
+		}(ref)
+	}
+	for i := 0; i < 8; i++ {
+		<-done
+	}
+
+	require.EqualValues(t, 4, puller.calls.Load())
+	require.LessOrEqual(t, puller.maxInFlight.Load(), int32(2))
+}
+
+func TestUnpackQueueWrapsTimeout(t *testing.T) {
+	puller := &fakeSlowPuller{delay: 100 * time.Millisecond}
+	queue := NewUnpackQueue(puller, imageutil.MockCache{}, 1)
+
+	_, err := waitDone(t, queue.Submit("owner", "slow-ref", 10*time.Millisecond))
+	require.ErrorIs(t, err, errUnpackTimeout)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestUnpackQueueForgetStartsFreshPull(t *testing.T) {
+	puller := &fakeSlowPuller{delay: 10 * time.Millisecond}
+	queue := NewUnpackQueue(puller, imageutil.MockCache{}, 1)
+
+	_, err := waitDone(t, queue.Submit("owner", "ref", time.Second))
+	require.NoError(t, err)
+	queue.Forget("ref")
+	_, err = waitDone(t, queue.Submit("owner", "ref", time.Second))
+	require.NoError(t, err)
+
+	require.EqualValues(t, 2, puller.calls.Load())
+}
@@ -16,8 +16,21 @@ const (
 	SyntheticPermissions              featuregate.Feature = "SyntheticPermissions"
 	WebhookProviderCertManager        featuregate.Feature = "WebhookProviderCertManager"
 	WebhookProviderOpenshiftServiceCA featuregate.Feature = "WebhookProviderOpenshiftServiceCA"
+	WebhookProviderSelfSigned         featuregate.Feature = "WebhookProviderSelfSigned"
 	HelmChartSupport                  featuregate.Feature = "HelmChartSupport"
 	BoxcutterRuntime                  featuregate.Feature = "BoxcutterRuntime"
+	ControllerManagedRBAC             featuregate.Feature = "ControllerManagedRBAC"
+	PodSecurityPreflight              featuregate.Feature = "PodSecurityPreflight"
+	ClusterExtensionValidatingWebhook featuregate.Feature = "ClusterExtensionValidatingWebhook"
+	OwnershipConflictPreflight        featuregate.Feature = "OwnershipConflictPreflight"
+	WorkloadIdentityRegistryAuth      featuregate.Feature = "WorkloadIdentityRegistryAuth"
+	RBACEscalationPreflight           featuregate.Feature = "RBACEscalationPreflight"
+	PreUpgradeHookPreflight           featuregate.Feature = "PreUpgradeHookPreflight"
+	ForceSemverUpgradeConstraints     featuregate.Feature = "ForceSemverUpgradeConstraints"
+	GitOpsManifestExport              featuregate.Feature = "GitOpsManifestExport"
+	OLMv0ConflictPreflight            featuregate.Feature = "OLMv0ConflictPreflight"
+	PackageSingletonPreflight         featuregate.Feature = "PackageSingletonPreflight"
+	ProvenancePreflight               featuregate.Feature = "ProvenancePreflight"
 )
 
 var operatorControllerFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
@@ -66,6 +79,18 @@ var operatorControllerFeatureGates = map[featuregate.Feature]featuregate.Feature
 		LockToDefault: false,
 	},
 
+	// WebhookProviderSelfSigned enables support for installing
+	// registry+v1 cluster extensions that include validating,
+	// mutating, and/or conversion webhooks using a self-signed
+	// CA and certificate that operator-controller issues and
+	// renews itself, without requiring CertManager or the
+	// Openshift Service CA operator to be installed.
+	WebhookProviderSelfSigned: {
+		Default:       false,
+		PreRelease:    featuregate.Alpha,
+		LockToDefault: false,
+	},
+
 	// HelmChartSupport enables support for installing,
 	// updating and uninstalling Helm Charts via Cluster Extensions.
 	HelmChartSupport: {
@@ -80,6 +105,144 @@ var operatorControllerFeatureGates = map[featuregate.Feature]featuregate.Feature
 		PreRelease:    featuregate.Alpha,
 		LockToDefault: false,
 	},
+
+	// ControllerManagedRBAC lets the controller create a ClusterExtension's
+	// configured ServiceAccount, along with the least-privilege Role/
+	// ClusterRole bindings it needs, when that ServiceAccount doesn't already
+	// exist. It's meant for single-tenant clusters that don't need the
+	// stricter pre-provisioned-ServiceAccount model.
+	ControllerManagedRBAC: {
+		Default:       false,
+		PreRelease:    featuregate.Alpha,
+		LockToDefault: false,
+	},
+
+	// PodSecurityPreflight enables a preflight check that evaluates a
+	// bundle's rendered Pod templates against the Pod Security Standards
+	// level enforced on the install namespace, failing the install/upgrade
+	// early with field-level violations instead of letting the workload be
+	// rejected by admission control after installation has started.
+	PodSecurityPreflight: {
+		Default:       false,
+		PreRelease:    featuregate.Alpha,
+		LockToDefault: false,
+	},
+
+	// ClusterExtensionValidatingWebhook registers a mutating and validating
+	// admission webhook for the ClusterExtension resource. Defaulting fills
+	// in fields with a documented default (upgradeConstraintPolicy,
+	// pruneBehavior, adoptionPolicy, createNamespace.deletionPolicy) and
+	// normalizes the version field so stored objects are complete. Validation
+	// rejects malformed requests synchronously at admission time (invalid
+	// semver ranges, serviceAccount/impersonate misconfiguration, immutable
+	// field changes) instead of only surfacing them later via status
+	// conditions.
+	ClusterExtensionValidatingWebhook: {
+		Default:       false,
+		PreRelease:    featuregate.Alpha,
+		LockToDefault: false,
+	},
+
+	// OwnershipConflictPreflight enables a preflight check that fails an
+	// install/upgrade when a bundle would apply an object that already
+	// exists on the cluster and is labeled as owned by a different
+	// ClusterExtension, naming the conflicting owner in the error instead
+	// of silently taking ownership of the object.
+	OwnershipConflictPreflight: {
+		Default:       false,
+		PreRelease:    featuregate.Alpha,
+		LockToDefault: false,
+	},
+
+	// WorkloadIdentityRegistryAuth lets the unpacker fall back to a cloud
+	// workload identity credential helper (docker-credential-ecr-login,
+	// docker-credential-gcr, docker-credential-acr-env) for registries that
+	// the synced imagePullSecret auth file has no credentials for, so
+	// bundle images in ECR/GCR/ACR can be pulled without a long-lived pull
+	// secret. Only helpers actually installed on the binary's PATH are
+	// used; clusters without any of them installed are unaffected.
+	WorkloadIdentityRegistryAuth: {
+		Default:       false,
+		PreRelease:    featuregate.Alpha,
+		LockToDefault: false,
+	},
+
+	// RBACEscalationPreflight enables a preflight check that rejects a
+	// bundle's rendered ClusterRoles and Roles when they grant a wildcard
+	// verbs=["*"] on apiGroups=["*"], resources=["*"] rule, naming the
+	// offending rules in the error instead of letting the bundle install
+	// with cluster-admin-equivalent permissions.
+	RBACEscalationPreflight: {
+		Default:       false,
+		PreRelease:    featuregate.Alpha,
+		LockToDefault: false,
+	},
+
+	// PreUpgradeHookPreflight enables a preflight check that runs a bundle's
+	// rendered Jobs annotated "olm.operatorframework.io/hook: pre-upgrade"
+	// and blocks the upgrade until each one completes successfully, so a
+	// bundle can run backups or schema checks before its new version is
+	// applied.
+	PreUpgradeHookPreflight: {
+		Default:       false,
+		PreRelease:    featuregate.Alpha,
+		LockToDefault: false,
+	},
+
+	// ForceSemverUpgradeConstraints makes the resolver enforce the catalog's upgrade graph even
+	// for ClusterExtensions with UpgradeConstraintPolicy set to SelfCertified, so an admin can no
+	// longer opt a ClusterExtension out of upgrade edge enforcement cluster-wide.
+	ForceSemverUpgradeConstraints: {
+		Default:       false,
+		PreRelease:    featuregate.Alpha,
+		LockToDefault: false,
+	},
+
+	// GitOpsManifestExport switches the ClusterExtension reconciler from applying a resolved
+	// bundle's rendered manifests directly to exporting them into a ConfigMap in the install
+	// namespace instead, so a GitOps tool like Argo CD or Flux can sync them onward. With this
+	// enabled, operator-controller acts as a resolution and rendering engine rather than the thing
+	// that actually puts the objects on the cluster.
+	GitOpsManifestExport: {
+		Default:       false,
+		PreRelease:    featuregate.Alpha,
+		LockToDefault: false,
+	},
+
+	// OLMv0ConflictPreflight enables a check that fails an install early, with a dedicated
+	// ManagedByOLMv0 condition, when an OLMv0 Subscription already manages the same package -
+	// instead of letting the install proceed and fight OLMv0 for ownership of the package's CRDs
+	// and Deployments object-by-object. A ClusterExtension that sets
+	// spec.install.adoptionPolicy: AdoptOLMV0 is exempt, since that's the supported path for
+	// taking the package over from OLMv0.
+	OLMv0ConflictPreflight: {
+		Default:       false,
+		PreRelease:    featuregate.Alpha,
+		LockToDefault: false,
+	},
+
+	// PackageSingletonPreflight enables a check, enforced both at admission and at reconcile
+	// time, that fails an install when another ClusterExtension already installs the same
+	// catalog package - instead of letting both proceed and fight over ownership of the same
+	// CRDs and Deployments, failing late and confusingly once their rendered manifests collide.
+	// A ClusterExtension may opt out by setting spec.install.allowMultiplePerPackage: true; this
+	// is intended for use once each ClusterExtension scopes itself to a distinct watch namespace
+	// via spec.config, so they don't actually manage the same resources.
+	PackageSingletonPreflight: {
+		Default:       false,
+		PreRelease:    featuregate.Alpha,
+		LockToDefault: false,
+	},
+
+	// ProvenancePreflight enables a preflight check that fetches a bundle image's SLSA
+	// provenance attestation and verifies it against the configured --provenance-builder-id
+	// and/or --provenance-source-repository policy before the image is unpacked and installed,
+	// rejecting images built by an untrusted builder or from an untrusted source repository.
+	ProvenancePreflight: {
+		Default:       false,
+		PreRelease:    featuregate.Alpha,
+		LockToDefault: false,
+	},
 }
 
 var OperatorControllerFeatureGate featuregate.MutableFeatureGate = featuregate.NewFeatureGate()
@@ -0,0 +1,77 @@
+// Package health computes the aggregate health of the set of objects that
+// make up an installed ClusterExtension release, using kstatus to interpret
+// each object's live status regardless of its kind.
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/cli-utils/pkg/kstatus/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MaxUnhealthyObjects bounds the number of unhealthy object identifiers
+// recorded in a Result, so that a badly failing release cannot grow
+// ClusterExtension status without bound.
+const MaxUnhealthyObjects = 10
+
+// Result is the aggregate outcome of evaluating the health of every object
+// that makes up a release. The zero value represents "not evaluated" and
+// must not be interpreted as either healthy or unhealthy.
+type Result struct {
+	// Evaluated is true if Evaluate ran over the release's objects. Callers
+	// must check this before trusting Healthy.
+	Evaluated bool
+
+	// Healthy is true only when every evaluated object reports a Current
+	// kstatus status.
+	Healthy bool
+
+	// UnhealthyObjects identifies objects that are not Current, formatted as
+	// "<kind> <namespace>/<name>: <reason>". It is truncated to
+	// MaxUnhealthyObjects entries; see Truncated.
+	UnhealthyObjects []string
+
+	// Truncated is true if there were more unhealthy objects than could be
+	// recorded in UnhealthyObjects.
+	Truncated bool
+}
+
+func (r *Result) recordUnhealthy(entry string) {
+	r.Healthy = false
+	if len(r.UnhealthyObjects) >= MaxUnhealthyObjects {
+		r.Truncated = true
+		return
+	}
+	r.UnhealthyObjects = append(r.UnhealthyObjects, entry)
+}
+
+// Evaluate fetches the current cluster state of each of objs and computes
+// its kstatus status, rolling the results up into a single Result. Objects
+// that can no longer be found or whose status cannot be computed are
+// treated as unhealthy rather than failing the evaluation outright, since a
+// single missing or malformed object shouldn't prevent reporting on the
+// health of the rest of the release.
+func Evaluate(ctx context.Context, cl client.Client, objs []client.Object) Result {
+	result := Result{Evaluated: true, Healthy: true}
+	for _, obj := range objs {
+		gvk := obj.GetObjectKind().GroupVersionKind()
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(gvk)
+		if err := cl.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+			result.recordUnhealthy(fmt.Sprintf("%s %s/%s: %v", gvk.Kind, obj.GetNamespace(), obj.GetName(), err))
+			continue
+		}
+		res, err := status.Compute(live)
+		if err != nil {
+			result.recordUnhealthy(fmt.Sprintf("%s %s/%s: %v", gvk.Kind, live.GetNamespace(), live.GetName(), err))
+			continue
+		}
+		if res.Status != status.CurrentStatus {
+			result.recordUnhealthy(fmt.Sprintf("%s %s/%s: %s", gvk.Kind, live.GetNamespace(), live.GetName(), res.Message))
+		}
+	}
+	return result
+}
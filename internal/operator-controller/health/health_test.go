@@ -0,0 +1,89 @@
+package health_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/health"
+)
+
+func configMapStub(namespace, name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind("ConfigMap")
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	return u
+}
+
+func TestEvaluate(t *testing.T) {
+	now := metav1.Now()
+	terminating := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              "terminating",
+			DeletionTimestamp: &now,
+			Finalizers:        []string{"example.com/finalizer"},
+		},
+	}
+	current := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "current"},
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(terminating, current).Build()
+
+	for _, tc := range []struct {
+		name             string
+		objs             []client.Object
+		wantHealthy      bool
+		wantUnhealthyLen int
+	}{
+		{
+			name:        "all current",
+			objs:        []client.Object{configMapStub("default", "current")},
+			wantHealthy: true,
+		},
+		{
+			name:             "terminating object is unhealthy",
+			objs:             []client.Object{configMapStub("default", "current"), configMapStub("default", "terminating")},
+			wantHealthy:      false,
+			wantUnhealthyLen: 1,
+		},
+		{
+			name:             "missing object is unhealthy",
+			objs:             []client.Object{configMapStub("default", "does-not-exist")},
+			wantHealthy:      false,
+			wantUnhealthyLen: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := health.Evaluate(context.Background(), cl, tc.objs)
+			require.True(t, result.Evaluated)
+			require.Equal(t, tc.wantHealthy, result.Healthy)
+			require.Len(t, result.UnhealthyObjects, tc.wantUnhealthyLen)
+			require.False(t, result.Truncated)
+		})
+	}
+}
+
+func TestEvaluateTruncatesUnhealthyObjects(t *testing.T) {
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+	objs := make([]client.Object, 0, health.MaxUnhealthyObjects+2)
+	for i := range health.MaxUnhealthyObjects + 2 {
+		objs = append(objs, configMapStub("default", string(rune('a'+i))))
+	}
+
+	result := health.Evaluate(context.Background(), cl, objs)
+	require.False(t, result.Healthy)
+	require.Len(t, result.UnhealthyObjects, health.MaxUnhealthyObjects)
+	require.True(t, result.Truncated)
+}
@@ -39,4 +39,18 @@ const (
 	// so that the effective ServiceAccount identity used for
 	// ClusterExtensionRevision operations is preserved.
 	ServiceAccountNamespaceKey = "olm.operatorframework.io/service-account-namespace"
+
+	// ForceSkipUninstallHookKey is the annotation key a user sets to "true" on a
+	// ClusterExtension to skip running its pre-uninstall hook Job, if any, during
+	// finalization. It's an escape hatch for a hook that is hung or otherwise
+	// unable to complete, so deletion isn't blocked on it indefinitely.
+	ForceSkipUninstallHookKey = "olm.operatorframework.io/force-skip-uninstall-hook"
+
+	// CatalogSelectorAnnotationKey is the annotation key an admin sets on a namespace to
+	// restrict which ClusterCatalogs a ClusterExtension installing into that namespace may
+	// resolve from, to a label selector matching a subset of ClusterCatalogs on the cluster.
+	// Its value is a label selector string in the same format as kubectl's --selector flag
+	// (e.g. "tier=gold,!internal"). A namespace without this annotation has no restriction
+	// beyond what the ClusterExtension's own spec.source.catalog.selector already applies.
+	CatalogSelectorAnnotationKey = "olm.operatorframework.io/catalog-selector"
 )
@@ -0,0 +1,193 @@
+// Package metrics defines Prometheus metrics that let fleet operators
+// observe and alert on the state of ClusterExtensions across a cluster,
+// without having to poll every ClusterExtension's status conditions.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+)
+
+var (
+	// ClusterExtensionStatus reflects the current status conditions of every
+	// ClusterExtension. The value is 1 when the named condition is True for
+	// the ClusterExtension, and 0 otherwise, so an alert can fire on e.g.
+	// clusterextension_status{condition="Installed"} == 0.
+	ClusterExtensionStatus = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "clusterextension_status",
+			Help: "The status of a ClusterExtension's conditions, by installed version",
+		},
+		[]string{"package", "installed_version", "condition"},
+	)
+
+	// InstallsTotal counts the number of times a ClusterExtension has been
+	// installed for the first time.
+	InstallsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "clusterextension_installs_total",
+			Help: "The number of successful ClusterExtension installs",
+		},
+		[]string{"package"},
+	)
+
+	// UpgradesTotal counts the number of times a ClusterExtension has been
+	// upgraded to a newer version.
+	UpgradesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "clusterextension_upgrades_total",
+			Help: "The number of successful ClusterExtension upgrades",
+		},
+		[]string{"package"},
+	)
+
+	// AutomaticUpgradesTotal counts the number of times a ClusterExtension has been upgraded by
+	// channel-head tracking, i.e. it does not pin spec.source.catalog.version to an exact version
+	// and was moved to a newer one without any action beyond the original apply. It's a subset of
+	// UpgradesTotal, separated out so a fleet operator can tell tracked upgrades apart from the
+	// (much rarer) case of a pinned version being edited to a newer exact version by hand.
+	AutomaticUpgradesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "clusterextension_automatic_upgrades_total",
+			Help: "The number of successful ClusterExtension upgrades performed by channel-head tracking, without a pinned version",
+		},
+		[]string{"package"},
+	)
+
+	// RollbacksTotal counts the number of times a ClusterExtension has moved
+	// to an older version than the one previously installed.
+	RollbacksTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "clusterextension_rollbacks_total",
+			Help: "The number of ClusterExtension rollbacks to a previously installed version",
+		},
+		[]string{"package"},
+	)
+
+	// ResolutionFailuresTotal counts the number of times bundle resolution
+	// has failed for a ClusterExtension.
+	ResolutionFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "clusterextension_resolution_failures_total",
+			Help: "The number of ClusterExtension bundle resolution failures",
+		},
+		[]string{"package"},
+	)
+
+	// UpgradeConstraintsBlockedTotal counts the number of times bundle
+	// resolution has failed for a ClusterExtension specifically because its
+	// upgrade edges or UpgradeConstraintPolicy ruled out every otherwise
+	// matching bundle, as opposed to no matching bundle existing at all.
+	UpgradeConstraintsBlockedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "clusterextension_upgrade_constraints_blocked_total",
+			Help: "The number of times a ClusterExtension's bundle resolution was blocked by upgrade constraints",
+		},
+		[]string{"package"},
+	)
+
+	// InstalledVersionMissingFromCatalogTotal counts the number of times bundle resolution has
+	// failed for a ClusterExtension because its installed version no longer exists in any
+	// matching catalog, as opposed to a transient error or an upgrade constraint.
+	InstalledVersionMissingFromCatalogTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "clusterextension_installed_version_missing_from_catalog_total",
+			Help: "The number of times a ClusterExtension's installed version was found to no longer exist in any matching catalog",
+		},
+		[]string{"package"},
+	)
+
+	// ReconcileStageSkippedTotal counts the number of times the unpack and apply stages of a
+	// ClusterExtension reconcile were skipped because the resolved bundle digest, rendered config,
+	// and currently installed release were all unchanged since the last reconcile.
+	ReconcileStageSkippedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "clusterextension_reconcile_stage_skipped_total",
+			Help: "The number of times a ClusterExtension's unpack and apply stages were skipped as a no-op",
+		},
+		[]string{"package"},
+	)
+
+	// ReconcileStageExecutedTotal counts the number of times the unpack and apply stages of a
+	// ClusterExtension reconcile actually ran, i.e. were not skipped as a no-op.
+	ReconcileStageExecutedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "clusterextension_reconcile_stage_executed_total",
+			Help: "The number of times a ClusterExtension's unpack and apply stages were executed",
+		},
+		[]string{"package"},
+	)
+
+	// ManagedContentInformersActive reports, per GroupVersionKind, whether a ClusterExtension's
+	// managed content cache currently has an informer running for it. It's a gauge rather than a
+	// counter because informers for a GVK are started and stopped as the set of installed bundles
+	// that reference it changes, so the interesting signal is what's active right now, not a
+	// cumulative total. Summing across ClusterExtensions shows that informer count tracks what's
+	// actually installed rather than growing unboundedly with every GVK ever seen.
+	ManagedContentInformersActive = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "clusterextension_managed_content_informers_active",
+			Help: "Whether a managed content informer is currently running (1) or not (0), by GroupVersionKind",
+		},
+		[]string{"group_version_kind"},
+	)
+
+	// ClusterExtensionConditionReason reflects the specific Reason currently reported for each of a
+	// ClusterExtension's condition types. Unlike ClusterExtensionStatus, which only reports whether a
+	// condition is True or False, this partitions by Reason (e.g. Progressing/Retrying vs
+	// Progressing/UnpackTimeout vs Progressing/BlockedByUpgradeConstraints), so dashboards and alerts
+	// can be built on why a fleet of ClusterExtensions isn't converging, not just that it isn't. The
+	// value is 1 for the Reason currently reported, and 0 for every other Reason that condition type
+	// can report, so summing by (condition, reason) across ClusterExtensions gives an exact count.
+	ClusterExtensionConditionReason = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "clusterextension_condition_reason",
+			Help: "Whether a ClusterExtension's condition currently reports a given reason (1) or not (0), by condition type and reason",
+		},
+		[]string{"package", "condition", "reason"},
+	)
+)
+
+// conditionTypes are the ClusterExtension condition types surfaced via
+// ClusterExtensionStatus.
+var conditionTypes = []string{ocv1.TypeInstalled, ocv1.TypeProgressing, ocv1.TypeHealthy, ocv1.TypeDeprecated, ocv1.TypeUpgradeAvailable}
+
+// reasonsByConditionType enumerates every Reason each of conditionTypes can report. It drives
+// ClusterExtensionConditionReason: whenever a ClusterExtension's condition changes reason, the old
+// reason's gauge is zeroed in the same call that sets the new one to 1, so a reason never keeps
+// reporting a stale ClusterExtension as active.
+var reasonsByConditionType = map[string][]string{
+	ocv1.TypeInstalled:        {ocv1.ReasonSucceeded, ocv1.ReasonFailed, ocv1.ReasonAbsent, ocv1.ReasonTimeout, ocv1.ReasonProgressDeadlineExceeded},
+	ocv1.TypeProgressing:      {ocv1.ReasonSucceeded, ocv1.ReasonRollingOut, ocv1.ReasonRetrying, ocv1.ReasonBlocked, ocv1.ReasonUnpackTimeout, ocv1.ReasonQuotaExceeded, ocv1.ReasonAdmissionDenied, ocv1.ReasonTerminating, ocv1.ReasonBlockedByUpgradeConstraints},
+	ocv1.TypeHealthy:          {ocv1.ReasonSucceeded, ocv1.ReasonFailed, ocv1.ReasonHealthUnknown},
+	ocv1.TypeDeprecated:       {ocv1.ReasonDeprecated, ocv1.ReasonNotDeprecated, ocv1.ReasonDeprecationStatusUnknown},
+	ocv1.TypeUpgradeAvailable: {ocv1.ReasonUpgradeAvailable, ocv1.ReasonNoUpgradeAvailable, ocv1.ReasonUpgradeAvailableUnknown},
+}
+
+// SetClusterExtensionStatus updates ClusterExtensionStatus and
+// ClusterExtensionConditionReason for ext from its current status conditions. installedVersion is
+// the version reported by ext's Installed condition, or empty if nothing is installed yet. Both
+// gauges are updated here, from the same snapshot of conditions, so a dashboard never observes one
+// updated without the other.
+func SetClusterExtensionStatus(pkg, installedVersion string, conditions []metav1.Condition) {
+	for _, conditionType := range conditionTypes {
+		c := apimeta.FindStatusCondition(conditions, conditionType)
+
+		value := 0.0
+		if c != nil && c.Status == metav1.ConditionTrue {
+			value = 1.0
+		}
+		ClusterExtensionStatus.WithLabelValues(pkg, installedVersion, conditionType).Set(value)
+
+		for _, reason := range reasonsByConditionType[conditionType] {
+			reasonValue := 0.0
+			if c != nil && c.Reason == reason {
+				reasonValue = 1.0
+			}
+			ClusterExtensionConditionReason.WithLabelValues(pkg, conditionType, reason).Set(reasonValue)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package metrics_test
+
+import (
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/metrics"
+)
+
+func gaugeValue(t *testing.T, pkg, installedVersion, condition string) float64 {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, metrics.ClusterExtensionStatus.WithLabelValues(pkg, installedVersion, condition).Write(m))
+	return m.GetGauge().GetValue()
+}
+
+func conditionReasonValue(t *testing.T, pkg, condition, reason string) float64 {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, metrics.ClusterExtensionConditionReason.WithLabelValues(pkg, condition, reason).Write(m))
+	return m.GetGauge().GetValue()
+}
+
+func TestSetClusterExtensionStatus(t *testing.T) {
+	conditions := []metav1.Condition{
+		{Type: ocv1.TypeInstalled, Status: metav1.ConditionTrue},
+		{Type: ocv1.TypeProgressing, Status: metav1.ConditionFalse, Reason: ocv1.ReasonRetrying},
+	}
+
+	metrics.SetClusterExtensionStatus("test-package", "1.0.0", conditions)
+
+	require.Equal(t, float64(1), gaugeValue(t, "test-package", "1.0.0", ocv1.TypeInstalled))
+	require.Equal(t, float64(0), gaugeValue(t, "test-package", "1.0.0", ocv1.TypeProgressing))
+	// A condition type that isn't present on the ClusterExtension is reported as false.
+	require.Equal(t, float64(0), gaugeValue(t, "test-package", "1.0.0", ocv1.TypeHealthy))
+}
+
+func TestSetClusterExtensionStatusConditionReason(t *testing.T) {
+	conditions := []metav1.Condition{
+		{Type: ocv1.TypeProgressing, Status: metav1.ConditionTrue, Reason: ocv1.ReasonRetrying},
+	}
+
+	metrics.SetClusterExtensionStatus("test-package", "1.0.0", conditions)
+	require.Equal(t, float64(1), conditionReasonValue(t, "test-package", ocv1.TypeProgressing, ocv1.ReasonRetrying))
+	require.Equal(t, float64(0), conditionReasonValue(t, "test-package", ocv1.TypeProgressing, ocv1.ReasonUnpackTimeout))
+
+	// Moving to a different reason zeroes out the old one in the same call.
+	conditions[0].Reason = ocv1.ReasonUnpackTimeout
+	metrics.SetClusterExtensionStatus("test-package", "1.0.0", conditions)
+	require.Equal(t, float64(0), conditionReasonValue(t, "test-package", ocv1.TypeProgressing, ocv1.ReasonRetrying))
+	require.Equal(t, float64(1), conditionReasonValue(t, "test-package", ocv1.TypeProgressing, ocv1.ReasonUnpackTimeout))
+}
@@ -0,0 +1,95 @@
+// Package notify implements a pluggable sink for ClusterExtension lifecycle
+// events, so operators can wire UpgradeAvailable, Installed/Failed,
+// RolledBack, and Deprecated status transitions out to an external system
+// without polling status.conditions themselves.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Event describes a single status condition transition on a ClusterExtension
+// that a Notifier should be told about.
+type Event struct {
+	ExtensionName string    `json:"extensionName"`
+	ConditionType string    `json:"conditionType"`
+	Reason        string    `json:"reason"`
+	Message       string    `json:"message"`
+	Time          time.Time `json:"time"`
+}
+
+// Notifier is told about lifecycle Events. Notify is called synchronously
+// during reconciliation, so implementations must apply their own timeout and
+// must not let a slow or unreachable sink stall reconciliation indefinitely.
+type Notifier interface {
+	Notify(ctx context.Context, event Event) error
+}
+
+// WebhookNotifier posts each Event as JSON to a configured URL.
+type WebhookNotifier struct {
+	// URL is the webhook endpoint Events are POSTed to.
+	URL string
+
+	// SlackFormat, when true, POSTs a Slack incoming-webhook compatible
+	// payload ({"text": "..."}) instead of the raw Event JSON.
+	SlackFormat bool
+
+	// Client is the http.Client used to send notifications. When nil,
+	// http.DefaultClient is used.
+	Client *http.Client
+}
+
+// NewWebhookNotifier returns a WebhookNotifier that posts to url, using
+// http.DefaultClient.
+func NewWebhookNotifier(url string, slackFormat bool) *WebhookNotifier {
+	return &WebhookNotifier{URL: url, SlackFormat: slackFormat}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, event Event) error {
+	body, err := n.payload(event)
+	if err != nil {
+		return fmt.Errorf("encoding notification payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building notification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("sending notification: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("notification webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (n *WebhookNotifier) httpClient() *http.Client {
+	if n.Client != nil {
+		return n.Client
+	}
+	return http.DefaultClient
+}
+
+func (n *WebhookNotifier) payload(event Event) ([]byte, error) {
+	if n.SlackFormat {
+		return json.Marshal(slackPayload{
+			Text: fmt.Sprintf("[%s] %s on ClusterExtension %q: %s", event.ConditionType, event.Reason, event.ExtensionName, event.Message),
+		})
+	}
+	return json.Marshal(event)
+}
+
+// slackPayload is the minimal body accepted by a Slack incoming webhook.
+type slackPayload struct {
+	Text string `json:"text"`
+}
@@ -0,0 +1,79 @@
+package notify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookNotifierNotify(t *testing.T) {
+	event := Event{
+		ExtensionName: "test-extension",
+		ConditionType: "UpgradeAvailable",
+		Reason:        "UpgradeAvailable",
+		Message:       "a newer version is available",
+		Time:          time.Now(),
+	}
+
+	for _, tc := range []struct {
+		name        string
+		slackFormat bool
+		assertBody  func(t *testing.T, body []byte)
+	}{
+		{
+			name: "default format posts the raw event as JSON",
+			assertBody: func(t *testing.T, body []byte) {
+				var got Event
+				require.NoError(t, json.Unmarshal(body, &got))
+				require.Equal(t, event.ExtensionName, got.ExtensionName)
+				require.Equal(t, event.ConditionType, got.ConditionType)
+				require.Equal(t, event.Message, got.Message)
+			},
+		},
+		{
+			name:        "slack format posts a text payload",
+			slackFormat: true,
+			assertBody: func(t *testing.T, body []byte) {
+				var got struct {
+					Text string `json:"text"`
+				}
+				require.NoError(t, json.Unmarshal(body, &got))
+				require.Contains(t, got.Text, event.ExtensionName)
+				require.Contains(t, got.Text, event.Message)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotContentType string
+			var gotBody []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotContentType = r.Header.Get("Content-Type")
+				buf := make([]byte, r.ContentLength)
+				_, _ = r.Body.Read(buf)
+				gotBody = buf
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			n := NewWebhookNotifier(server.URL, tc.slackFormat)
+			require.NoError(t, n.Notify(t.Context(), event))
+			require.Equal(t, "application/json", gotContentType)
+			tc.assertBody(t, gotBody)
+		})
+	}
+}
+
+func TestWebhookNotifierNotifyErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	n := NewWebhookNotifier(server.URL, false)
+	err := n.Notify(t.Context(), Event{})
+	require.ErrorContains(t, err, "status 500")
+}
@@ -30,6 +30,59 @@ type ValidationFunc func(*declcfg.Bundle) error
 type CatalogResolver struct {
 	WalkCatalogsFunc func(context.Context, string, CatalogWalkFunc, ...client.ListOption) error
 	Validations      []ValidationFunc
+
+	// EdgeOverridesFunc, when set, returns the upgrade edges whitelisted for packageName by
+	// any valid ClusterExtensionUpgradeEdgeOverride. These edges are consulted alongside the
+	// catalog's own upgrade graph when enforcing upgrade constraints, letting an admin approve
+	// a version jump the catalog doesn't otherwise connect without relaxing
+	// UpgradeConstraintPolicy. A nil EdgeOverridesFunc disables edge overrides.
+	EdgeOverridesFunc func(ctx context.Context, packageName string) ([]ocv1.UpgradeEdgeOverride, error)
+
+	// ForceSemverUpgradeConstraints, when true, enforces the catalog's upgrade graph even for
+	// ClusterExtensions with UpgradeConstraintPolicy set to SelfCertified. It's set from the
+	// ForceSemverUpgradeConstraints feature gate.
+	ForceSemverUpgradeConstraints bool
+
+	// NamespaceCatalogSelectorFunc, when set, returns the label selector restricting which
+	// ClusterCatalogs a ClusterExtension installing into namespace may resolve from. It's
+	// combined with the ClusterExtension's own spec.source.catalog.selector (both must match),
+	// so tenants can't widen their catalog access beyond what this selector permits, letting
+	// multi-tenant clusters reserve catalogs (e.g. by requiring a "tier=gold" label) that
+	// namespaces aren't entitled to install from. A nil NamespaceCatalogSelectorFunc disables
+	// the restriction entirely.
+	NamespaceCatalogSelectorFunc func(ctx context.Context, namespace string) (labels.Selector, error)
+}
+
+// catalogSelectorFor returns the label selector restricting which ClusterCatalogs a
+// ClusterExtension with this catalog source and namespace may resolve from: its own
+// spec.source.catalog.selector, intersected with whatever r.NamespaceCatalogSelectorFunc permits
+// for its install namespace.
+func (r *CatalogResolver) catalogSelectorFor(ctx context.Context, catalogSource *ocv1.CatalogFilter, namespace string) (labels.Selector, error) {
+	selector := labels.Everything()
+	if catalogSource != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(catalogSource.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("desired catalog selector is invalid: %w", err)
+		}
+		// A nothing (empty) selector selects everything
+		if selector == labels.Nothing() {
+			selector = labels.Everything()
+		}
+	}
+
+	if r.NamespaceCatalogSelectorFunc == nil {
+		return selector, nil
+	}
+	tenantSelector, err := r.NamespaceCatalogSelectorFunc(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("getting catalog selector for namespace %q: %w", namespace, err)
+	}
+	if tenantSelector == nil {
+		return selector, nil
+	}
+	requirements, _ := tenantSelector.Requirements()
+	return selector.Add(requirements...), nil
 }
 
 type foundBundle struct {
@@ -40,23 +93,142 @@ type foundBundle struct {
 
 // Resolve returns a Bundle from a catalog that needs to get installed on the cluster.
 func (r *CatalogResolver) Resolve(ctx context.Context, ext *ocv1.ClusterExtension, installedBundle *ocv1.BundleMetadata) (*declcfg.Bundle, *bundle.VersionRelease, *declcfg.Deprecation, error) {
-	l := log.FromContext(ctx)
+	return r.resolve(ctx, ext, installedBundle, true)
+}
+
+// ResolveNewestExisting returns the newest bundle that satisfies ext's package selection
+// constraints (channels, version range, selector), ignoring the upgrade edge constraints that
+// Resolve enforces against ext's currently installed bundle. It's used to compute the
+// UpgradeAvailable condition, so an admin can see that an update exists in the catalog even while
+// it's blocked by UpgradeConstraintPolicy or a broken upgrade graph.
+func (r *CatalogResolver) ResolveNewestExisting(ctx context.Context, ext *ocv1.ClusterExtension) (*bundle.VersionRelease, error) {
+	_, version, _, err := r.resolve(ctx, ext, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+// ResolveUpgradeEdges returns every catalog-provided upgrade edge available from installedBundle
+// that still satisfies ext's package selection constraints (channels, selector), ignoring the
+// upgrade edge constraints that Resolve enforces. Edges reachable from more than one catalog are
+// deduplicated by bundle name, preferring the catalog with the highest ClusterCatalog priority.
+// The result is sorted by descending bundle version.
+func (r *CatalogResolver) ResolveUpgradeEdges(ctx context.Context, ext *ocv1.ClusterExtension, installedBundle ocv1.BundleMetadata) ([]ocv1.AvailableUpgradeEdge, error) {
 	packageName := ext.Spec.Source.Catalog.PackageName
-	versionRange := ext.Spec.Source.Catalog.Version
 	channels := ext.Spec.Source.Catalog.Channels
 
-	// unless overridden, default to selecting all bundles
-	var selector = labels.Everything()
-	var err error
-	if ext.Spec.Source.Catalog != nil {
-		selector, err = metav1.LabelSelectorAsSelector(ext.Spec.Source.Catalog.Selector)
+	selector, err := r.catalogSelectorFor(ctx, ext.Spec.Source.Catalog, ext.Spec.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	type foundEdge struct {
+		edge     ocv1.AvailableUpgradeEdge
+		priority int32
+	}
+	edgesByName := map[string]foundEdge{}
+
+	listOptions := []client.ListOption{
+		client.MatchingLabelsSelector{Selector: selector},
+	}
+	if err := r.WalkCatalogsFunc(ctx, packageName, func(ctx context.Context, cat *ocv1.ClusterCatalog, packageFBC *declcfg.DeclarativeConfig, err error) error {
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("desired catalog selector is invalid: %w", err)
+			return fmt.Errorf("error getting package %q from catalog %q: %w", packageName, cat.Name, err)
 		}
-		// A nothing (empty) selector selects everything
-		if selector == labels.Nothing() {
-			selector = labels.Everything()
+		if isFBCEmpty(packageFBC) {
+			return nil
+		}
+
+		channelsForPackage := packageFBC.Channels
+		if len(channels) > 0 {
+			channelSet := sets.New(channels...)
+			channelsForPackage = slices.DeleteFunc(slices.Clone(channelsForPackage), func(c declcfg.Channel) bool {
+				return !channelSet.Has(c.Name)
+			})
+		}
+
+		edges, err := filter.UpgradeEdges(installedBundle, packageFBC.Bundles, channelsForPackage...)
+		if err != nil {
+			return fmt.Errorf("error finding upgrade edges: %w", err)
+		}
+
+		for _, edge := range edges {
+			existing, ok := edgesByName[edge.Bundle.Name]
+			if !ok || cat.Spec.Priority > existing.priority {
+				edgesByName[edge.Bundle.Name] = foundEdge{edge: edge, priority: cat.Spec.Priority}
+			}
+		}
+		return nil
+	}, listOptions...); err != nil {
+		return nil, fmt.Errorf("error walking catalogs: %w", err)
+	}
+
+	edges := make([]ocv1.AvailableUpgradeEdge, 0, len(edgesByName))
+	for _, fe := range edgesByName {
+		edges = append(edges, fe.edge)
+	}
+	slices.SortFunc(edges, func(a, b ocv1.AvailableUpgradeEdge) int {
+		av, aErr := bsemver.Parse(a.Bundle.Version)
+		bv, bErr := bsemver.Parse(b.Bundle.Version)
+		if aErr != nil || bErr != nil {
+			return 0
 		}
+		return bv.Compare(av)
+	})
+
+	return edges, nil
+}
+
+// InstalledBundleExists reports whether installedBundle still exists anywhere in the catalogs
+// matching ext's package selector, ignoring channel, version range, and upgrade edge constraints
+// (unlike resolve, which enforces all of them). A resolution failure despite this returning true
+// means the installed bundle is still there but unreachable under ext's current constraints; a
+// false means it's genuinely gone from the catalog.
+func (r *CatalogResolver) InstalledBundleExists(ctx context.Context, ext *ocv1.ClusterExtension, installedBundle ocv1.BundleMetadata) (bool, error) {
+	packageName := ext.Spec.Source.Catalog.PackageName
+
+	selector, err := r.catalogSelectorFor(ctx, ext.Spec.Source.Catalog, ext.Spec.Namespace)
+	if err != nil {
+		return false, err
+	}
+
+	exists := false
+	listOptions := []client.ListOption{
+		client.MatchingLabelsSelector{Selector: selector},
+	}
+	if err := r.WalkCatalogsFunc(ctx, packageName, func(ctx context.Context, cat *ocv1.ClusterCatalog, packageFBC *declcfg.DeclarativeConfig, err error) error {
+		if err != nil {
+			return fmt.Errorf("error getting package %q from catalog %q: %w", packageName, cat.Name, err)
+		}
+		if isFBCEmpty(packageFBC) || exists {
+			return nil
+		}
+		if slices.ContainsFunc(packageFBC.Bundles, func(b declcfg.Bundle) bool { return b.Name == installedBundle.Name }) {
+			exists = true
+		}
+		return nil
+	}, listOptions...); err != nil {
+		return false, fmt.Errorf("error walking catalogs: %w", err)
+	}
+
+	return exists, nil
+}
+
+// resolve returns a Bundle from a catalog that satisfies ext's package selection constraints. When
+// enforceUpgradeConstraints is true and installedBundle is non-nil, candidates are additionally
+// restricted to bundles reachable from installedBundle via an upgrade edge (unless
+// UpgradeConstraintPolicy is SelfCertified and r.ForceSemverUpgradeConstraints is false).
+func (r *CatalogResolver) resolve(ctx context.Context, ext *ocv1.ClusterExtension, installedBundle *ocv1.BundleMetadata, enforceUpgradeConstraints bool) (*declcfg.Bundle, *bundle.VersionRelease, *declcfg.Deprecation, error) {
+	l := log.FromContext(ctx)
+	packageName := ext.Spec.Source.Catalog.PackageName
+	versionRange := ext.Spec.Source.Catalog.Version
+	channels := ext.Spec.Source.Catalog.Channels
+
+	// unless overridden, default to selecting all bundles
+	selector, err := r.catalogSelectorFor(ctx, ext.Spec.Source.Catalog, ext.Spec.Namespace)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
 	var versionRangeConstraints bsemver.Range
@@ -67,14 +239,7 @@ func (r *CatalogResolver) Resolve(ctx context.Context, ext *ocv1.ClusterExtensio
 		}
 	}
 
-	type catStat struct {
-		CatalogName    string `json:"catalogName"`
-		PackageFound   bool   `json:"packageFound"`
-		TotalBundles   int    `json:"totalBundles"`
-		MatchedBundles int    `json:"matchedBundles"`
-	}
-
-	var catStats []*catStat
+	var catStats []*CatalogStat
 
 	var resolvedBundles []foundBundle
 	var priorDeprecation *declcfg.Deprecation
@@ -87,7 +252,7 @@ func (r *CatalogResolver) Resolve(ctx context.Context, ext *ocv1.ClusterExtensio
 			return fmt.Errorf("error getting package %q from catalog %q: %w", packageName, cat.Name, err)
 		}
 
-		cs := catStat{CatalogName: cat.Name}
+		cs := CatalogStat{CatalogName: cat.Name}
 		catStats = append(catStats, &cs)
 
 		if isFBCEmpty(packageFBC) {
@@ -110,12 +275,29 @@ func (r *CatalogResolver) Resolve(ctx context.Context, ext *ocv1.ClusterExtensio
 			predicates = append(predicates, filter.InSemverRange(versionRangeConstraints))
 		}
 
-		if ext.Spec.Source.Catalog.UpgradeConstraintPolicy != ocv1.UpgradeConstraintPolicySelfCertified && installedBundle != nil {
+		selfCertified := ext.Spec.Source.Catalog.UpgradeConstraintPolicy == ocv1.UpgradeConstraintPolicySelfCertified && !r.ForceSemverUpgradeConstraints
+		if enforceUpgradeConstraints && !selfCertified && installedBundle != nil {
 			successorPredicate, err := filter.SuccessorsOf(*installedBundle, packageFBC.Channels...)
 			if err != nil {
 				return fmt.Errorf("error finding upgrade edges: %w", err)
 			}
-			predicates = append(predicates, successorPredicate)
+			upgradePredicate := successorPredicate
+
+			if r.EdgeOverridesFunc != nil {
+				overrides, err := r.EdgeOverridesFunc(ctx, packageName)
+				if err != nil {
+					return fmt.Errorf("error getting upgrade edge overrides: %w", err)
+				}
+				if len(overrides) > 0 {
+					installedVersion, err := bsemver.Parse(installedBundle.Version)
+					if err != nil {
+						return fmt.Errorf("error parsing installed bundle version: %w", err)
+					}
+					upgradePredicate = filterutil.Or(upgradePredicate, filter.WhitelistedUpgradeEdge(installedVersion, overrides))
+				}
+			}
+
+			predicates = append(predicates, upgradePredicate)
 		}
 
 		// Apply the predicates to get the candidate bundles
@@ -181,12 +363,13 @@ func (r *CatalogResolver) Resolve(ctx context.Context, ext *ocv1.ClusterExtensio
 	// Check for ambiguity
 	if len(resolvedBundles) != 1 {
 		l.Info("resolution failed", "stats", catStats)
-		return nil, nil, nil, resolutionError{
+		return nil, nil, nil, ResolutionError{
 			PackageName:     packageName,
 			Version:         versionRange,
 			Channels:        channels,
 			InstalledBundle: installedBundle,
 			ResolvedBundles: resolvedBundles,
+			CatalogStats:    catStats,
 		}
 	}
 	resolvedBundle := resolvedBundles[0].bundle
@@ -209,15 +392,31 @@ func (r *CatalogResolver) Resolve(ctx context.Context, ext *ocv1.ClusterExtensio
 	return resolvedBundle, resolvedBundleVersion, priorDeprecation, nil
 }
 
-type resolutionError struct {
+// CatalogStat reports, for a single catalog consulted during resolution, how many of the
+// package's bundles were present and how many survived the channel/version/upgrade-edge
+// predicates applied for that resolution. It's attached to ResolutionError so callers that want
+// to explain a resolution failure in more detail than its Error() string don't have to
+// re-implement the predicates themselves to figure out where candidates were filtered out.
+type CatalogStat struct {
+	CatalogName    string `json:"catalogName"`
+	PackageFound   bool   `json:"packageFound"`
+	TotalBundles   int    `json:"totalBundles"`
+	MatchedBundles int    `json:"matchedBundles"`
+}
+
+// ResolutionError is returned by Resolve when a package's resolution constraints matched zero or
+// more than one bundle. CatalogStats breaks the outcome down per catalog, for callers (such as a
+// CLI explaining why a resolution failed) that want more than the summary Error() string.
+type ResolutionError struct {
 	PackageName     string
 	Version         string
 	Channels        []string
 	InstalledBundle *ocv1.BundleMetadata
 	ResolvedBundles []foundBundle
+	CatalogStats    []*CatalogStat
 }
 
-func (rei resolutionError) Error() string {
+func (rei ResolutionError) Error() string {
 	var sb strings.Builder
 	if rei.InstalledBundle != nil {
 		sb.WriteString(fmt.Sprintf("error upgrading from currently installed version %q: ", rei.InstalledBundle.Version))
@@ -474,6 +474,121 @@ func TestUpgradeNotFoundLegacy(t *testing.T) {
 	assert.EqualError(t, err, fmt.Sprintf(`error upgrading from currently installed version "0.1.0": no bundles found for package %q matching version "<1.0.0 >=2.0.0"`, pkgName))
 }
 
+func TestInstalledBundleExists(t *testing.T) {
+	pkgName := randPkg()
+	w := staticCatalogWalker{
+		"a": func() (*declcfg.DeclarativeConfig, *ocv1.ClusterCatalogSpec, error) {
+			return genPackage(pkgName), nil, nil
+		},
+	}
+	r := CatalogResolver{WalkCatalogsFunc: w.WalkCatalogs}
+	ce := buildFooClusterExtension(pkgName, []string{}, "", ocv1.UpgradeConstraintPolicyCatalogProvided)
+
+	exists, err := r.InstalledBundleExists(context.Background(), ce, ocv1.BundleMetadata{
+		Name:    bundleName(pkgName, "1.0.1"),
+		Version: "1.0.1",
+	})
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestInstalledBundleMissing(t *testing.T) {
+	pkgName := randPkg()
+	w := staticCatalogWalker{
+		"a": func() (*declcfg.DeclarativeConfig, *ocv1.ClusterCatalogSpec, error) {
+			return genPackage(pkgName), nil, nil
+		},
+	}
+	r := CatalogResolver{WalkCatalogsFunc: w.WalkCatalogs}
+	ce := buildFooClusterExtension(pkgName, []string{}, "", ocv1.UpgradeConstraintPolicyCatalogProvided)
+
+	// This version was never part of genPackage's fixture, simulating a version that's been
+	// pulled from the catalog (or an extension pointed at a catalog that never had it).
+	exists, err := r.InstalledBundleExists(context.Background(), ce, ocv1.BundleMetadata{
+		Name:    bundleName(pkgName, "9.9.9"),
+		Version: "9.9.9",
+	})
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestResolveUpgradeEdgesSkipRangeOnly(t *testing.T) {
+	pkgName := randPkg()
+	w := staticCatalogWalker{
+		"a": func() (*declcfg.DeclarativeConfig, *ocv1.ClusterCatalogSpec, error) {
+			return genPackage(pkgName), nil, nil
+		},
+	}
+	r := CatalogResolver{WalkCatalogsFunc: w.WalkCatalogs}
+	// Restrict to the "alpha" channel, whose entries are reachable from 0.1.0 only via
+	// skipRange (no replaces/skips directives at all), to exercise skipRange-only upgrade
+	// edge detection end to end.
+	ce := buildFooClusterExtension(pkgName, []string{"alpha"}, "", ocv1.UpgradeConstraintPolicyCatalogProvided)
+	installedBundle := ocv1.BundleMetadata{
+		Name:    bundleName(pkgName, "0.1.0"),
+		Version: "0.1.0",
+	}
+	edges, err := r.ResolveUpgradeEdges(context.Background(), ce, installedBundle)
+	require.NoError(t, err)
+	// 2.0.0's skipRange is ">=1.0.0 <2.0.0", which doesn't cover the installed 0.1.0, so it's
+	// not an edge here; only the three entries whose skipRange actually spans 0.1.0 are.
+	assert.Equal(t, []ocv1.AvailableUpgradeEdge{
+		{Bundle: ocv1.BundleMetadata{Name: bundleName(pkgName, "1.0.2"), Version: "1.0.2"}, Reason: ocv1.UpgradeEdgeReasonSkipRange},
+		{Bundle: ocv1.BundleMetadata{Name: bundleName(pkgName, "1.0.1"), Version: "1.0.1"}, Reason: ocv1.UpgradeEdgeReasonSkipRange},
+		{Bundle: ocv1.BundleMetadata{Name: bundleName(pkgName, "1.0.0"), Version: "1.0.0"}, Reason: ocv1.UpgradeEdgeReasonSkipRange},
+	}, edges)
+}
+
+func TestUpgradeEdgeOverrideAllowsNonSuccessorJump(t *testing.T) {
+	pkgName := randPkg()
+	w := staticCatalogWalker{
+		"a": func() (*declcfg.DeclarativeConfig, *ocv1.ClusterCatalogSpec, error) {
+			return genPackage(pkgName), nil, nil
+		},
+	}
+	r := CatalogResolver{
+		WalkCatalogsFunc: w.WalkCatalogs,
+		EdgeOverridesFunc: func(_ context.Context, gotPkgName string) ([]ocv1.UpgradeEdgeOverride, error) {
+			assert.Equal(t, pkgName, gotPkgName)
+			return []ocv1.UpgradeEdgeOverride{{FromVersion: "0.1.0", ToVersion: "3.0.0"}}, nil
+		},
+	}
+	ce := buildFooClusterExtension(pkgName, []string{}, "", ocv1.UpgradeConstraintPolicyCatalogProvided)
+	installedBundle := &ocv1.BundleMetadata{
+		Name:    bundleName(pkgName, "0.1.0"),
+		Version: "0.1.0",
+	}
+	// 3.0.0's skipRange only covers >=2.0.0 <3.0.0, so 0.1.0 -> 3.0.0 is not a successor edge;
+	// it's only reachable because of the whitelisted override.
+	gotBundle, gotVersion, _, err := r.Resolve(context.Background(), ce, installedBundle)
+	require.NoError(t, err)
+	assert.Equal(t, genBundle(pkgName, "3.0.0"), *gotBundle)
+	assert.Equal(t, bundle.VersionRelease{Version: bsemver.MustParse("3.0.0")}, *gotVersion)
+}
+
+func TestUpgradeEdgeOverrideIgnoredForDifferentInstalledVersion(t *testing.T) {
+	pkgName := randPkg()
+	w := staticCatalogWalker{
+		"a": func() (*declcfg.DeclarativeConfig, *ocv1.ClusterCatalogSpec, error) {
+			return genPackage(pkgName), nil, nil
+		},
+	}
+	r := CatalogResolver{
+		WalkCatalogsFunc: w.WalkCatalogs,
+		EdgeOverridesFunc: func(context.Context, string) ([]ocv1.UpgradeEdgeOverride, error) {
+			// This edge only whitelists a jump from 1.0.2, not from the 0.1.0 installed here.
+			return []ocv1.UpgradeEdgeOverride{{FromVersion: "1.0.2", ToVersion: "3.0.0"}}, nil
+		},
+	}
+	ce := buildFooClusterExtension(pkgName, []string{}, "3.0.0", ocv1.UpgradeConstraintPolicyCatalogProvided)
+	installedBundle := &ocv1.BundleMetadata{
+		Name:    bundleName(pkgName, "0.1.0"),
+		Version: "0.1.0",
+	}
+	_, _, _, err := r.Resolve(context.Background(), ce, installedBundle)
+	assert.EqualError(t, err, fmt.Sprintf(`error upgrading from currently installed version "0.1.0": no bundles found for package %q matching version "3.0.0"`, pkgName))
+}
+
 func TestDowngradeFound(t *testing.T) {
 	pkgName := randPkg()
 	w := staticCatalogWalker{
@@ -502,6 +617,31 @@ func TestDowngradeFound(t *testing.T) {
 	assert.Equal(t, ptr.To(packageDeprecation(pkgName)), gotDeprecation)
 }
 
+func TestForceSemverUpgradeConstraintsOverridesSelfCertified(t *testing.T) {
+	pkgName := randPkg()
+	w := staticCatalogWalker{
+		"a": func() (*declcfg.DeclarativeConfig, *ocv1.ClusterCatalogSpec, error) {
+			return &declcfg.DeclarativeConfig{}, nil, nil
+		},
+		"b": func() (*declcfg.DeclarativeConfig, *ocv1.ClusterCatalogSpec, error) {
+			return &declcfg.DeclarativeConfig{}, nil, nil
+		},
+		"c": func() (*declcfg.DeclarativeConfig, *ocv1.ClusterCatalogSpec, error) {
+			return genPackage(pkgName), nil, nil
+		},
+	}
+	r := CatalogResolver{WalkCatalogsFunc: w.WalkCatalogs, ForceSemverUpgradeConstraints: true}
+	ce := buildFooClusterExtension(pkgName, []string{}, "<1.0.2", ocv1.UpgradeConstraintPolicySelfCertified)
+	installedBundle := &ocv1.BundleMetadata{
+		Name:    bundleName(pkgName, "1.0.2"),
+		Version: "1.0.2",
+	}
+	// 1.0.2 => 0.1.0 would be an allowed downgrade under UpgradeConstraintPolicySelfCertified, but
+	// ForceSemverUpgradeConstraints makes the upgrade graph apply regardless of the policy.
+	_, _, _, err := r.Resolve(context.Background(), ce, installedBundle)
+	assert.EqualError(t, err, fmt.Sprintf(`error upgrading from currently installed version "1.0.2": no bundles found for package %q matching version "<1.0.2"`, pkgName))
+}
+
 func TestDowngradeNotFound(t *testing.T) {
 	pkgName := randPkg()
 	w := staticCatalogWalker{
@@ -797,6 +937,65 @@ func TestClusterExtensionMatchLabel(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNamespaceCatalogSelectorRestrictsCatalogs(t *testing.T) {
+	pkgName := randPkg()
+	w := staticCatalogWalker{
+		"a": func() (*declcfg.DeclarativeConfig, *ocv1.ClusterCatalogSpec, error) {
+			return genPackage(pkgName), nil, nil
+		},
+		"b": func() (*declcfg.DeclarativeConfig, *ocv1.ClusterCatalogSpec, error) {
+			return genPackage(pkgName), nil, nil
+		},
+	}
+	r := CatalogResolver{
+		WalkCatalogsFunc: w.WalkCatalogs,
+		NamespaceCatalogSelectorFunc: func(_ context.Context, namespace string) (labels.Selector, error) {
+			assert.Equal(t, "default", namespace)
+			return labels.SelectorFromSet(labels.Set{"olm.operatorframework.io/metadata.name": "b"}), nil
+		},
+	}
+	ce := buildFooClusterExtension(pkgName, []string{}, "", ocv1.UpgradeConstraintPolicyCatalogProvided)
+
+	resolvedBundle, _, _, err := r.Resolve(context.Background(), ce, nil)
+	require.NoError(t, err)
+	require.Equal(t, bundleName(pkgName, "3.0.0"), resolvedBundle.Name)
+}
+
+func TestNamespaceCatalogSelectorCombinesWithClusterExtensionSelector(t *testing.T) {
+	pkgName := randPkg()
+	w := staticCatalogWalker{
+		"a": func() (*declcfg.DeclarativeConfig, *ocv1.ClusterCatalogSpec, error) {
+			return genPackage(pkgName), nil, nil
+		},
+	}
+	r := CatalogResolver{
+		WalkCatalogsFunc: w.WalkCatalogs,
+		NamespaceCatalogSelectorFunc: func(context.Context, string) (labels.Selector, error) {
+			return labels.SelectorFromSet(labels.Set{"olm.operatorframework.io/metadata.name": "b"}), nil
+		},
+	}
+	ce := buildFooClusterExtension(pkgName, []string{}, "", ocv1.UpgradeConstraintPolicyCatalogProvided)
+	ce.Spec.Source.Catalog.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"olm.operatorframework.io/metadata.name": "a"},
+	}
+
+	_, _, _, err := r.Resolve(context.Background(), ce, nil)
+	assert.ErrorContains(t, err, "no bundles found for package")
+}
+
+func TestNamespaceCatalogSelectorFuncError(t *testing.T) {
+	r := CatalogResolver{
+		NamespaceCatalogSelectorFunc: func(context.Context, string) (labels.Selector, error) {
+			return nil, fmt.Errorf("fake error")
+		},
+	}
+	pkgName := randPkg()
+	ce := buildFooClusterExtension(pkgName, []string{}, "", ocv1.UpgradeConstraintPolicyCatalogProvided)
+
+	_, _, _, err := r.Resolve(context.Background(), ce, nil)
+	assert.EqualError(t, err, `getting catalog selector for namespace "default": fake error`)
+}
+
 func TestClusterExtensionNoMatchLabel(t *testing.T) {
 	pkgName := randPkg()
 	w := staticCatalogWalker{
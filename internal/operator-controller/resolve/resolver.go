@@ -13,6 +13,34 @@ type Resolver interface {
 	Resolve(ctx context.Context, ext *ocv1.ClusterExtension, installedBundle *ocv1.BundleMetadata) (*declcfg.Bundle, *bundle.VersionRelease, *declcfg.Deprecation, error)
 }
 
+// NewestExistingResolver is implemented by Resolvers that can additionally report the newest bundle
+// version satisfying a ClusterExtension's package selection constraints (channels, version range,
+// selector), ignoring the upgrade edge constraints normally enforced against the currently installed
+// bundle. This lets a caller tell an update that's genuinely unavailable apart from one that exists
+// in the catalog but is blocked by UpgradeConstraintPolicy or a broken upgrade graph.
+type NewestExistingResolver interface {
+	ResolveNewestExisting(ctx context.Context, ext *ocv1.ClusterExtension) (*bundle.VersionRelease, error)
+}
+
+// UpgradeEdgesResolver is implemented by Resolvers that can additionally report every
+// catalog-provided upgrade edge available from installedBundle, rather than just the single
+// bundle Resolve would install. This is used to populate the AvailableUpgradeEdges status field,
+// so an admin can see the full set of versions they could skip/skipRange to, not only the one
+// Resolve would currently pick.
+type UpgradeEdgesResolver interface {
+	ResolveUpgradeEdges(ctx context.Context, ext *ocv1.ClusterExtension, installedBundle ocv1.BundleMetadata) ([]ocv1.AvailableUpgradeEdge, error)
+}
+
+// InstalledBundleChecker is implemented by Resolvers that can additionally report whether the
+// currently installed bundle still exists anywhere in the catalogs matching ext's package
+// selection, ignoring channel, version range, and upgrade edge constraints. This distinguishes a
+// resolution failure caused by the installed version having been pulled from the catalog (or the
+// extension having been pointed at a catalog that never had it) from other resolution failures,
+// so the former can be surfaced distinctly instead of as a generic, indefinitely-retried error.
+type InstalledBundleChecker interface {
+	InstalledBundleExists(ctx context.Context, ext *ocv1.ClusterExtension, installedBundle ocv1.BundleMetadata) (bool, error)
+}
+
 type Func func(ctx context.Context, ext *ocv1.ClusterExtension, installedBundle *ocv1.BundleMetadata) (*declcfg.Bundle, *bundle.VersionRelease, *declcfg.Deprecation, error)
 
 func (f Func) Resolve(ctx context.Context, ext *ocv1.ClusterExtension, installedBundle *ocv1.BundleMetadata) (*declcfg.Bundle, *bundle.VersionRelease, *declcfg.Deprecation, error) {
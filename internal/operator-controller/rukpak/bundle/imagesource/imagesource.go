@@ -0,0 +1,28 @@
+// Package imagesource loads registry+v1 bundle content directly from an OCI image reference, for
+// callers that need a bundle's manifests without going through a ClusterExtension reconcile loop
+// (for example, an offline render command).
+package imagesource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/bundle"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/bundle/source"
+	imageutil "github.com/operator-framework/operator-controller/internal/shared/util/image"
+)
+
+// Load pulls ref as a registry+v1 bundle image, using puller and cache exactly as the controller's
+// unpack queue does, and parses the result into a bundle.RegistryV1. ownerID namespaces the pulled
+// content within cache.
+func Load(ctx context.Context, puller imageutil.Puller, cache imageutil.Cache, ownerID, ref string) (bundle.RegistryV1, error) {
+	fsys, _, _, err := puller.Pull(ctx, ownerID, ref, cache)
+	if err != nil {
+		return bundle.RegistryV1{}, fmt.Errorf("pulling bundle image %q: %w", ref, err)
+	}
+	rv1, err := source.FromFS(fsys).GetBundle()
+	if err != nil {
+		return bundle.RegistryV1{}, fmt.Errorf("parsing bundle image %q: %w", ref, err)
+	}
+	return rv1, nil
+}
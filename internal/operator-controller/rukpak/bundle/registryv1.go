@@ -17,6 +17,13 @@ import (
 const (
 	BundleConfigWatchNamespaceKey   = "watchNamespace"
 	BundleConfigDeploymentConfigKey = "deploymentConfig"
+
+	// BundleConfigSchemaAnnotationKey is a CSV annotation an operator author can use to extend
+	// the generated configuration schema with their own properties, e.g. for operator-specific
+	// settings that don't fit watchNamespace/deploymentConfig. Its value must be a JSON-encoded
+	// JSON Schema object; only "properties" and "required" are read out of it. Declared property
+	// names must not collide with the built-in watchNamespace/deploymentConfig properties.
+	BundleConfigSchemaAnnotationKey = "olm.operatorframework.io/config-schema"
 )
 
 var (
@@ -31,14 +38,57 @@ type RegistryV1 struct {
 	Others      []unstructured.Unstructured
 }
 
-// GetConfigSchema builds a validation schema based on what install modes the operator supports.
+// GetConfigSchema builds a validation schema based on what install modes the operator supports,
+// extended with any custom properties the bundle declares via the BundleConfigSchemaAnnotationKey
+// CSV annotation.
 //
 // For registry+v1 bundles, we look at the CSV's install modes and generate a schema
 // that matches. For example, if the operator only supports OwnNamespace mode, we'll
 // require the user to provide a watchNamespace that equals the install namespace.
 func (rv1 *RegistryV1) GetConfigSchema() (map[string]any, error) {
 	installModes := sets.New(rv1.CSV.Spec.InstallModes...)
-	return buildBundleConfigSchema(installModes)
+	schema, err := buildBundleConfigSchema(installModes)
+	if err != nil {
+		return nil, err
+	}
+
+	if rawSchema, ok := rv1.CSV.GetAnnotations()[BundleConfigSchemaAnnotationKey]; ok {
+		if err := mergeBundleDeclaredSchema(schema, rawSchema); err != nil {
+			return nil, fmt.Errorf("failed to apply bundle-declared config schema from %q annotation: %w", BundleConfigSchemaAnnotationKey, err)
+		}
+	}
+
+	return schema, nil
+}
+
+// mergeBundleDeclaredSchema merges the bundle-declared schema fragment in rawSchema - a
+// JSON-encoded JSON Schema object - into baseSchema, adding its properties and required fields
+// alongside the built-in watchNamespace/deploymentConfig ones.
+func mergeBundleDeclaredSchema(baseSchema map[string]any, rawSchema string) error {
+	var declared struct {
+		Properties map[string]any `json:"properties"`
+		Required   []string       `json:"required"`
+	}
+	if err := json.Unmarshal([]byte(rawSchema), &declared); err != nil {
+		return fmt.Errorf("annotation value is not a valid JSON Schema object: %w", err)
+	}
+
+	properties, ok := baseSchema["properties"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("base schema missing properties")
+	}
+	for name, propSchema := range declared.Properties {
+		if name == BundleConfigWatchNamespaceKey || name == BundleConfigDeploymentConfigKey {
+			return fmt.Errorf("declared property %q conflicts with a built-in configuration property", name)
+		}
+		properties[name] = propSchema
+	}
+
+	for _, name := range declared.Required {
+		addToRequired(baseSchema, name)
+	}
+
+	return nil
 }
 
 // buildBundleConfigSchema loads the base bundle config schema and modifies it based on
@@ -6,6 +6,9 @@ import (
 	"github.com/santhosh-tekuri/jsonschema/v6"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 )
 
 func TestGetBundleConfigSchemaMap(t *testing.T) {
@@ -117,3 +120,78 @@ func TestSchemaCompilation(t *testing.T) {
 	require.NoError(t, err, "schema should compile without errors - this verifies all $ref targets are resolvable")
 	require.NotNil(t, compiledSchema, "compiled schema should not be nil")
 }
+
+func newTestCSV(annotations map[string]string) v1alpha1.ClusterServiceVersion {
+	return v1alpha1.ClusterServiceVersion{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: annotations,
+		},
+		Spec: v1alpha1.ClusterServiceVersionSpec{
+			InstallModes: []v1alpha1.InstallMode{
+				{Type: v1alpha1.InstallModeTypeAllNamespaces, Supported: true},
+			},
+		},
+	}
+}
+
+func TestGetConfigSchema_BundleDeclaredProperties(t *testing.T) {
+	t.Run("no annotation leaves the base schema untouched", func(t *testing.T) {
+		rv1 := &RegistryV1{CSV: newTestCSV(nil)}
+		schema, err := rv1.GetConfigSchema()
+		require.NoError(t, err)
+
+		properties, ok := schema["properties"].(map[string]any)
+		require.True(t, ok)
+		assert.NotContains(t, properties, "logLevel")
+	})
+
+	t.Run("annotation adds custom properties and required fields", func(t *testing.T) {
+		rv1 := &RegistryV1{CSV: newTestCSV(map[string]string{
+			BundleConfigSchemaAnnotationKey: `{
+				"properties": {
+					"logLevel": {"type": "string", "enum": ["debug", "info", "error"]}
+				},
+				"required": ["logLevel"]
+			}`,
+		})}
+
+		schema, err := rv1.GetConfigSchema()
+		require.NoError(t, err)
+
+		properties, ok := schema["properties"].(map[string]any)
+		require.True(t, ok)
+		assert.Contains(t, properties, "logLevel")
+		assert.Contains(t, properties, "deploymentConfig")
+
+		required, ok := schema["required"].([]any)
+		require.True(t, ok)
+		assert.Contains(t, required, "logLevel")
+
+		compiler := jsonschema.NewCompiler()
+		require.NoError(t, compiler.AddResource("schema.json", schema))
+		compiledSchema, err := compiler.Compile("schema.json")
+		require.NoError(t, err)
+
+		require.Error(t, compiledSchema.Validate(map[string]any{}))
+		require.NoError(t, compiledSchema.Validate(map[string]any{"logLevel": "debug"}))
+	})
+
+	t.Run("annotation colliding with a built-in property is rejected", func(t *testing.T) {
+		rv1 := &RegistryV1{CSV: newTestCSV(map[string]string{
+			BundleConfigSchemaAnnotationKey: `{"properties": {"watchNamespace": {"type": "string"}}}`,
+		})}
+
+		_, err := rv1.GetConfigSchema()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "watchNamespace")
+	})
+
+	t.Run("malformed annotation value is rejected", func(t *testing.T) {
+		rv1 := &RegistryV1{CSV: newTestCSV(map[string]string{
+			BundleConfigSchemaAnnotationKey: `not json`,
+		})}
+
+		_, err := rv1.GetConfigSchema()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,219 @@
+// Package hook implements a preflight check that runs bundle-declared
+// pre-upgrade hook Jobs and gates the upgrade on their success, the same way
+// Helm hooks gate a release. A Job in the bundle's rendered manifests opts in
+// by carrying the HookAnnotation set to HookPreUpgrade; every other object is
+// left untouched, to be applied as normal once all hook Jobs have succeeded.
+package hook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/labels"
+)
+
+const (
+	// HookAnnotation marks a Job in a bundle's rendered manifests as a
+	// lifecycle hook. Its value selects which lifecycle event the Job gates.
+	// A Job carrying this annotation is run and waited on instead of being
+	// applied as a normal release object.
+	HookAnnotation = "olm.operatorframework.io/hook"
+
+	// HookPreUpgrade gates an upgrade on the annotated Job completing
+	// successfully before the rest of the release is applied.
+	HookPreUpgrade = "pre-upgrade"
+
+	// HookPreUninstall gates release deletion on the annotated Job completing,
+	// so a bundle can deregister itself from external systems before its
+	// resources are torn down. See RunPreUninstallHooks for how it differs
+	// from HookPreUpgrade: it must never block deletion indefinitely.
+	HookPreUninstall = "pre-uninstall"
+
+	// HookTimeoutAnnotation optionally overrides defaultPreUninstallHookTimeout for a
+	// single pre-uninstall hook Job, as a time.ParseDuration-parseable string (e.g.
+	// "2m"). An invalid value is ignored in favor of the default.
+	HookTimeoutAnnotation = "olm.operatorframework.io/hook-timeout"
+)
+
+// defaultPreUninstallHookTimeout bounds how long a pre-uninstall hook Job is given to
+// complete, unless overridden by HookTimeoutAnnotation, before uninstallation proceeds
+// without it.
+const defaultPreUninstallHookTimeout = 5 * time.Minute
+
+// Preflight runs bundle-declared pre-upgrade hook Jobs and blocks the
+// upgrade until each one reports success.
+type Preflight struct {
+	Client client.Client
+}
+
+// NewPreflight returns a new Preflight that creates and polls hook Jobs via cl.
+func NewPreflight(cl client.Client) *Preflight {
+	return &Preflight{Client: cl}
+}
+
+// Install is a no-op: pre-upgrade hooks only gate upgrades of an already
+// installed bundle, not the first install.
+func (p *Preflight) Install(ctx context.Context, objs []client.Object) error {
+	return nil
+}
+
+func (p *Preflight) Upgrade(ctx context.Context, objs []client.Object) error {
+	for _, obj := range objs {
+		job, ok, err := jobFor(obj)
+		if err != nil {
+			return err
+		}
+		if !ok || job.Annotations[HookAnnotation] != HookPreUpgrade {
+			continue
+		}
+		if err := p.runHookJob(ctx, job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runHookJob ensures job exists on the cluster and reports its completion
+// status. A Job that doesn't exist yet is created and reported as still
+// running, since Jobs don't complete synchronously; the controller's normal
+// requeue-on-error behavior re-checks it on the next reconcile.
+func (p *Preflight) runHookJob(ctx context.Context, job *batchv1.Job) error {
+	key := client.ObjectKeyFromObject(job)
+
+	existing := &batchv1.Job{}
+	err := p.Client.Get(ctx, key, existing)
+	switch {
+	case apierrors.IsNotFound(err):
+		toCreate := job.DeepCopy()
+		toCreate.ResourceVersion = ""
+		if createErr := p.Client.Create(ctx, toCreate); createErr != nil && !apierrors.IsAlreadyExists(createErr) {
+			return fmt.Errorf("creating pre-upgrade hook job %q: %w", key, createErr)
+		}
+		return fmt.Errorf("waiting for pre-upgrade hook job %q to complete", key)
+	case err != nil:
+		return fmt.Errorf("getting pre-upgrade hook job %q: %w", key, err)
+	}
+
+	for _, cond := range existing.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return nil
+		case batchv1.JobFailed:
+			return reconcile.TerminalError(fmt.Errorf("pre-upgrade hook job %q failed: %s (see `kubectl logs -n %s -l job-name=%s` for details)", key, cond.Message, job.Namespace, job.Name))
+		}
+	}
+	return fmt.Errorf("waiting for pre-upgrade hook job %q to complete", key)
+}
+
+// RunPreUninstallHooks runs every Job in objs carrying HookAnnotation=HookPreUninstall and
+// waits for each to complete, so a release's objects aren't deleted until any bundle-declared
+// deregistration or cleanup work (e.g. removing the extension from an external system) has
+// had a chance to run.
+//
+// Unlike a pre-upgrade hook, a pre-uninstall hook must never block deletion indefinitely: a
+// ClusterExtension being deleted needs to eventually go away even if its hook Job is stuck or
+// fails. Each hook Job is therefore given a timeout (HookTimeoutAnnotation on the Job, or
+// defaultPreUninstallHookTimeout) after which - like a failed Job - it's logged and treated as
+// done, letting uninstallation proceed anyway. Setting labels.ForceSkipUninstallHookKey to
+// "true" on ext skips running pre-uninstall hooks entirely.
+func RunPreUninstallHooks(ctx context.Context, cl client.Client, ext client.Object, objs []client.Object) error {
+	if ext.GetAnnotations()[labels.ForceSkipUninstallHookKey] == "true" {
+		return nil
+	}
+	for _, obj := range objs {
+		job, ok, err := jobFor(obj)
+		if err != nil {
+			return err
+		}
+		if !ok || job.Annotations[HookAnnotation] != HookPreUninstall {
+			continue
+		}
+		if err := runPreUninstallHookJob(ctx, cl, job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runPreUninstallHookJob ensures job exists on the cluster and reports whether
+// uninstallation may proceed. Like runHookJob, a Job that doesn't exist yet is created and
+// reported as still running. Unlike runHookJob, a failed Job or one that has run longer than
+// its timeout is logged and treated as complete rather than blocking the caller forever.
+func runPreUninstallHookJob(ctx context.Context, cl client.Client, job *batchv1.Job) error {
+	l := log.FromContext(ctx)
+	key := client.ObjectKeyFromObject(job)
+
+	existing := &batchv1.Job{}
+	err := cl.Get(ctx, key, existing)
+	switch {
+	case apierrors.IsNotFound(err):
+		toCreate := job.DeepCopy()
+		toCreate.ResourceVersion = ""
+		if createErr := cl.Create(ctx, toCreate); createErr != nil && !apierrors.IsAlreadyExists(createErr) {
+			return fmt.Errorf("creating pre-uninstall hook job %q: %w", key, createErr)
+		}
+		return fmt.Errorf("waiting for pre-uninstall hook job %q to complete", key)
+	case err != nil:
+		return fmt.Errorf("getting pre-uninstall hook job %q: %w", key, err)
+	}
+
+	for _, cond := range existing.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return nil
+		case batchv1.JobFailed:
+			l.Info("pre-uninstall hook job failed; proceeding with uninstall anyway", "job", key, "message", cond.Message)
+			return nil
+		}
+	}
+
+	if existing.Status.StartTime != nil && time.Since(existing.Status.StartTime.Time) > preUninstallHookTimeout(job) {
+		l.Info("pre-uninstall hook job did not complete within its timeout; proceeding with uninstall anyway", "job", key)
+		return nil
+	}
+	return fmt.Errorf("waiting for pre-uninstall hook job %q to complete", key)
+}
+
+// preUninstallHookTimeout returns job's HookTimeoutAnnotation value, or
+// defaultPreUninstallHookTimeout if it's unset or unparseable.
+func preUninstallHookTimeout(job *batchv1.Job) time.Duration {
+	if v := job.Annotations[HookTimeoutAnnotation]; v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return defaultPreUninstallHookTimeout
+}
+
+// jobFor converts obj to a *batchv1.Job if its GroupVersionKind is Job,
+// returning ok=false for every other kind of object.
+func jobFor(obj client.Object) (*batchv1.Job, bool, error) {
+	if obj.GetObjectKind().GroupVersionKind() != batchv1.SchemeGroupVersion.WithKind("Job") {
+		return nil, false, nil
+	}
+
+	uMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, false, fmt.Errorf("converting object %q to unstructured: %w", obj.GetName(), err)
+	}
+	job := &batchv1.Job{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(uMap, job); err != nil {
+		return nil, false, fmt.Errorf("converting unstructured to Job object: %w", err)
+	}
+	return job, true, nil
+}
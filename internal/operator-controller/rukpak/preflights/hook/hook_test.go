@@ -0,0 +1,194 @@
+package hook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/labels"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/preflights/hook"
+)
+
+func hookJob(t *testing.T, annotations map[string]string, conditions ...batchv1.JobCondition) *unstructured.Unstructured {
+	t.Helper()
+	job := &batchv1.Job{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "test-namespace", Annotations: annotations},
+		Status:     batchv1.JobStatus{Conditions: conditions},
+	}
+	uMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(job)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: uMap}
+}
+
+func hookJobRunningSince(t *testing.T, annotations map[string]string, startTime time.Time) *unstructured.Unstructured {
+	t.Helper()
+	job := &batchv1.Job{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "test-namespace", Annotations: annotations},
+		Status:     batchv1.JobStatus{StartTime: &metav1.Time{Time: startTime}},
+	}
+	uMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(job)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: uMap}
+}
+
+func TestPreflightInstall(t *testing.T) {
+	cl := fake.NewClientBuilder().Build()
+	p := hook.NewPreflight(cl)
+
+	err := p.Install(context.Background(), []client.Object{
+		hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUpgrade}),
+	})
+
+	require.NoError(t, err, "install never runs pre-upgrade hooks")
+}
+
+func TestPreflightUpgrade(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		obj        *unstructured.Unstructured
+		existing   []client.Object
+		requireErr require.ErrorAssertionFunc
+	}{
+		{
+			name:       "object without the hook annotation is ignored",
+			obj:        hookJob(t, nil),
+			requireErr: require.NoError,
+		},
+		{
+			name: "hook job doesn't exist yet: created and reported as running",
+			obj:  hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUpgrade}),
+			requireErr: func(t require.TestingT, err error, _ ...any) {
+				require.Error(t, err)
+				require.ErrorContains(t, err, `waiting for pre-upgrade hook job "test-namespace/backup" to complete`)
+			},
+		},
+		{
+			name: "hook job still running",
+			obj:  hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUpgrade}),
+			existing: []client.Object{func() client.Object {
+				j := hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUpgrade})
+				j.SetResourceVersion("1")
+				return j
+			}()},
+			requireErr: func(t require.TestingT, err error, _ ...any) {
+				require.Error(t, err)
+				require.ErrorContains(t, err, "waiting for pre-upgrade hook job")
+			},
+		},
+		{
+			name: "hook job succeeded",
+			obj:  hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUpgrade}),
+			existing: []client.Object{hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUpgrade},
+				batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue})},
+			requireErr: require.NoError,
+		},
+		{
+			name: "hook job failed",
+			obj:  hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUpgrade}),
+			existing: []client.Object{hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUpgrade},
+				batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "backup script exited 1"})},
+			requireErr: func(t require.TestingT, err error, _ ...any) {
+				require.Error(t, err)
+				require.ErrorContains(t, err, "backup script exited 1")
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().WithObjects(tc.existing...).Build()
+			p := hook.NewPreflight(cl)
+
+			err := p.Upgrade(context.Background(), []client.Object{tc.obj})
+
+			tc.requireErr(t, err)
+		})
+	}
+}
+
+func TestRunPreUninstallHooks(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		ext        client.Object
+		obj        *unstructured.Unstructured
+		existing   []client.Object
+		requireErr require.ErrorAssertionFunc
+	}{
+		{
+			name:       "object without the hook annotation is ignored",
+			ext:        &corev1.Namespace{},
+			obj:        hookJob(t, nil),
+			requireErr: require.NoError,
+		},
+		{
+			name: "force-skip annotation skips the hook entirely",
+			ext: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{labels.ForceSkipUninstallHookKey: "true"},
+			}},
+			obj:        hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUninstall}),
+			requireErr: require.NoError,
+		},
+		{
+			name: "hook job doesn't exist yet: created and reported as running",
+			ext:  &corev1.Namespace{},
+			obj:  hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUninstall}),
+			requireErr: func(t require.TestingT, err error, _ ...any) {
+				require.Error(t, err)
+				require.ErrorContains(t, err, `waiting for pre-uninstall hook job "test-namespace/backup" to complete`)
+			},
+		},
+		{
+			name: "hook job succeeded",
+			ext:  &corev1.Namespace{},
+			obj:  hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUninstall}),
+			existing: []client.Object{hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUninstall},
+				batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue})},
+			requireErr: require.NoError,
+		},
+		{
+			name: "hook job failed: logged and uninstall proceeds anyway",
+			ext:  &corev1.Namespace{},
+			obj:  hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUninstall}),
+			existing: []client.Object{hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUninstall},
+				batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "deregister script exited 1"})},
+			requireErr: require.NoError,
+		},
+		{
+			name: "hook job exceeded its timeout: logged and uninstall proceeds anyway",
+			ext:  &corev1.Namespace{},
+			obj:  hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUninstall, hook.HookTimeoutAnnotation: "1ms"}),
+			existing: []client.Object{hookJobRunningSince(t,
+				map[string]string{hook.HookAnnotation: hook.HookPreUninstall, hook.HookTimeoutAnnotation: "1ms"},
+				time.Now().Add(-time.Hour))},
+			requireErr: require.NoError,
+		},
+		{
+			name: "hook job still running and within its timeout",
+			ext:  &corev1.Namespace{},
+			obj:  hookJob(t, map[string]string{hook.HookAnnotation: hook.HookPreUninstall}),
+			existing: []client.Object{hookJobRunningSince(t,
+				map[string]string{hook.HookAnnotation: hook.HookPreUninstall}, time.Now())},
+			requireErr: func(t require.TestingT, err error, _ ...any) {
+				require.Error(t, err)
+				require.ErrorContains(t, err, "waiting for pre-uninstall hook job")
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().WithObjects(tc.existing...).Build()
+
+			err := hook.RunPreUninstallHooks(context.Background(), cl, tc.ext, []client.Object{tc.obj})
+
+			tc.requireErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Package ownership implements a preflight check that detects when a bundle
+// is about to apply an object that already exists on the cluster and is
+// labeled as owned by a different ClusterExtension, failing fast with an
+// error that names the conflicting owner instead of letting Server-Side
+// Apply silently take over fields the other owner still manages.
+package ownership
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/labels"
+)
+
+// Preflight checks that every object about to be applied either doesn't
+// exist yet, or is already owned by the ClusterExtension performing the
+// apply.
+type Preflight struct {
+	Client client.Reader
+}
+
+// NewPreflight returns a new Preflight that looks up existing objects via cl.
+func NewPreflight(cl client.Reader) *Preflight {
+	return &Preflight{Client: cl}
+}
+
+func (p *Preflight) Install(ctx context.Context, objs []client.Object) error {
+	return p.runPreflight(ctx, objs)
+}
+
+func (p *Preflight) Upgrade(ctx context.Context, objs []client.Object) error {
+	return p.runPreflight(ctx, objs)
+}
+
+func (p *Preflight) runPreflight(ctx context.Context, objs []client.Object) error {
+	var conflictErrors []error
+	for _, obj := range objs {
+		desiredOwner, ok := obj.GetLabels()[labels.OwnerNameKey]
+		if !ok {
+			continue
+		}
+
+		existing := obj.DeepCopyObject().(client.Object)
+		err := p.Client.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("checking for existing owner of %q %q: %w", obj.GetObjectKind().GroupVersionKind().Kind, client.ObjectKeyFromObject(obj), err)
+		}
+
+		existingOwner, ok := existing.GetLabels()[labels.OwnerNameKey]
+		if !ok || existingOwner == desiredOwner {
+			continue
+		}
+
+		conflictErrors = append(conflictErrors, fmt.Errorf("%q %q is already owned by ClusterExtension %q",
+			obj.GetObjectKind().GroupVersionKind().Kind, client.ObjectKeyFromObject(obj), existingOwner))
+	}
+	return errors.Join(conflictErrors...)
+}
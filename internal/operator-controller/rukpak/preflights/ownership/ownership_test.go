@@ -0,0 +1,86 @@
+package ownership_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/labels"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/preflights/ownership"
+)
+
+func deployment(t *testing.T, name string, objLabels map[string]string) *unstructured.Unstructured {
+	t.Helper()
+	dep := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-namespace", Labels: objLabels},
+	}
+	uMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(dep)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: uMap}
+}
+
+func TestPreflightInstall(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		existing   []client.Object
+		obj        *unstructured.Unstructured
+		requireErr require.ErrorAssertionFunc
+	}{
+		{
+			name:       "object does not exist yet",
+			obj:        deployment(t, "test-operator", map[string]string{labels.OwnerNameKey: "ext-a"}),
+			requireErr: require.NoError,
+		},
+		{
+			name:       "object has no owner label",
+			obj:        deployment(t, "test-operator", nil),
+			requireErr: require.NoError,
+		},
+		{
+			name:       "object already owned by the same ClusterExtension",
+			existing:   []client.Object{deployment(t, "test-operator", map[string]string{labels.OwnerNameKey: "ext-a"})},
+			obj:        deployment(t, "test-operator", map[string]string{labels.OwnerNameKey: "ext-a"}),
+			requireErr: require.NoError,
+		},
+		{
+			name:     "object already owned by a different ClusterExtension",
+			existing: []client.Object{deployment(t, "test-operator", map[string]string{labels.OwnerNameKey: "ext-a"})},
+			obj:      deployment(t, "test-operator", map[string]string{labels.OwnerNameKey: "ext-b"}),
+			requireErr: func(t require.TestingT, err error, _ ...any) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), `already owned by ClusterExtension "ext-a"`)
+			},
+		},
+		{
+			name:       "existing object has no owner label",
+			existing:   []client.Object{deployment(t, "test-operator", nil)},
+			obj:        deployment(t, "test-operator", map[string]string{labels.OwnerNameKey: "ext-b"}),
+			requireErr: require.NoError,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().WithObjects(tc.existing...).Build()
+			p := ownership.NewPreflight(cl)
+			err := p.Install(context.Background(), []client.Object{tc.obj})
+			tc.requireErr(t, err)
+		})
+	}
+}
+
+func TestPreflightUpgrade(t *testing.T) {
+	existing := deployment(t, "test-operator", map[string]string{labels.OwnerNameKey: "ext-a"})
+	cl := fake.NewClientBuilder().WithObjects(existing).Build()
+	p := ownership.NewPreflight(cl)
+
+	obj := deployment(t, "test-operator", map[string]string{labels.OwnerNameKey: "ext-b"})
+	err := p.Upgrade(context.Background(), []client.Object{obj})
+	require.ErrorContains(t, err, `already owned by ClusterExtension "ext-a"`)
+}
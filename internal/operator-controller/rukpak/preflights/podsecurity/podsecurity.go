@@ -0,0 +1,231 @@
+// Package podsecurity implements a preflight check that evaluates the
+// Pod templates in a bundle's rendered manifests against the Pod Security
+// Standards level enforced on the install namespace, so that violations
+// are reported as a single, actionable error before the objects are
+// applied, rather than being rejected piecemeal by admission control
+// after installation has already started.
+package podsecurity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// enforceLabel is the namespace label Pod Security admission uses to
+// determine the level enforced against Pods created in that namespace.
+// See https://kubernetes.io/docs/concepts/security/pod-security-admission/
+const enforceLabel = "pod-security.kubernetes.io/enforce"
+
+// level is a Pod Security Standards level.
+type level string
+
+const (
+	levelPrivileged level = "privileged"
+	levelBaseline   level = "baseline"
+	levelRestricted level = "restricted"
+)
+
+// Preflight checks that the Pod templates in a bundle's rendered manifests
+// satisfy the Pod Security Standards level enforced on the target
+// namespace.
+type Preflight struct {
+	namespaceClient corev1client.NamespaceInterface
+}
+
+// NewPreflight returns a new Preflight that looks up the enforced Pod
+// Security level via namespaceClient.
+func NewPreflight(namespaceClient corev1client.NamespaceInterface) *Preflight {
+	return &Preflight{namespaceClient: namespaceClient}
+}
+
+func (p *Preflight) Install(ctx context.Context, objs []client.Object) error {
+	return p.runPreflight(ctx, objs)
+}
+
+func (p *Preflight) Upgrade(ctx context.Context, objs []client.Object) error {
+	return p.runPreflight(ctx, objs)
+}
+
+func (p *Preflight) runPreflight(ctx context.Context, objs []client.Object) error {
+	levelsByNamespace := map[string]level{}
+	var validateErrors []error
+	for _, obj := range objs {
+		podSpec, found, err := podSpecOf(obj)
+		if err != nil {
+			return fmt.Errorf("extracting pod template from %q %q: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		if !found {
+			continue
+		}
+
+		ns := obj.GetNamespace()
+		lvl, ok := levelsByNamespace[ns]
+		if !ok {
+			lvl, err = p.enforcedLevel(ctx, ns)
+			if err != nil {
+				return fmt.Errorf("determining pod security level for namespace %q: %w", ns, err)
+			}
+			levelsByNamespace[ns] = lvl
+		}
+		if lvl == levelPrivileged {
+			continue
+		}
+
+		if violations := checkPod(lvl, podSpec); len(violations) > 0 {
+			validateErrors = append(validateErrors, fmt.Errorf("%q %q violates the %q Pod Security Standard for namespace %q: %s",
+				obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), lvl, ns, joinViolations(violations)))
+		}
+	}
+	return errors.Join(validateErrors...)
+}
+
+// enforcedLevel returns the Pod Security Standards level enforced on ns. If
+// the namespace does not exist yet (it will be created as part of this
+// install) or does not carry an enforce label, the privileged level is
+// returned, since no restrictions are in effect in that case.
+func (p *Preflight) enforcedLevel(ctx context.Context, ns string) (level, error) {
+	namespace, err := p.namespaceClient.Get(ctx, ns, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return levelPrivileged, nil
+		}
+		return "", err
+	}
+	switch level(namespace.Labels[enforceLabel]) {
+	case levelBaseline:
+		return levelBaseline, nil
+	case levelRestricted:
+		return levelRestricted, nil
+	default:
+		return levelPrivileged, nil
+	}
+}
+
+// podSpecOf extracts the Pod template spec from obj, if obj is a workload
+// kind that embeds one.
+func podSpecOf(obj client.Object) (*corev1.PodSpec, bool, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, false, nil
+	}
+
+	var templatePath []string
+	switch u.GroupVersionKind().GroupKind().String() {
+	case "Deployment.apps", "StatefulSet.apps", "DaemonSet.apps", "ReplicaSet.apps", "Job.batch":
+		templatePath = []string{"spec", "template", "spec"}
+	case "CronJob.batch":
+		templatePath = []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	case "Pod":
+		templatePath = []string{"spec"}
+	default:
+		return nil, false, nil
+	}
+
+	specMap, found, err := unstructured.NestedMap(u.Object, templatePath...)
+	if err != nil || !found {
+		return nil, found, err
+	}
+
+	podSpec := &corev1.PodSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(specMap, podSpec); err != nil {
+		return nil, false, err
+	}
+	return podSpec, true, nil
+}
+
+// checkPod evaluates podSpec against the given Pod Security Standards level
+// and returns a human-readable violation for each field that fails. This is
+// not a full reimplementation of the upstream Pod Security admission
+// policies; it covers the checks that are most commonly hit by operator
+// workloads.
+func checkPod(lvl level, podSpec *corev1.PodSpec) []string {
+	var violations []string
+
+	if podSpec.HostNetwork {
+		violations = append(violations, "hostNetwork=true")
+	}
+	if podSpec.HostPID {
+		violations = append(violations, "hostPID=true")
+	}
+	if podSpec.HostIPC {
+		violations = append(violations, "hostIPC=true")
+	}
+	for _, vol := range podSpec.Volumes {
+		if vol.HostPath != nil {
+			violations = append(violations, fmt.Sprintf("volume %q uses hostPath", vol.Name))
+		}
+	}
+
+	if lvl != levelRestricted {
+		return violations
+	}
+
+	podRunAsNonRoot := podSpec.SecurityContext != nil && podSpec.SecurityContext.RunAsNonRoot != nil && *podSpec.SecurityContext.RunAsNonRoot
+	podSeccompOK := podSpec.SecurityContext != nil && podSpec.SecurityContext.SeccompProfile != nil &&
+		(podSpec.SecurityContext.SeccompProfile.Type == corev1.SeccompProfileTypeRuntimeDefault || podSpec.SecurityContext.SeccompProfile.Type == corev1.SeccompProfileTypeLocalhost)
+
+	allContainers := slices.Concat(podSpec.InitContainers, podSpec.Containers)
+	for _, ec := range podSpec.EphemeralContainers {
+		allContainers = append(allContainers, corev1.Container(ec.EphemeralContainerCommon))
+	}
+	for _, c := range allContainers {
+		sc := c.SecurityContext
+
+		runAsNonRoot := podRunAsNonRoot
+		if sc != nil && sc.RunAsNonRoot != nil {
+			runAsNonRoot = *sc.RunAsNonRoot
+		}
+		if !runAsNonRoot {
+			violations = append(violations, fmt.Sprintf("container %q does not set runAsNonRoot=true", c.Name))
+		}
+
+		if sc == nil || sc.AllowPrivilegeEscalation == nil || *sc.AllowPrivilegeEscalation {
+			violations = append(violations, fmt.Sprintf("container %q does not set allowPrivilegeEscalation=false", c.Name))
+		}
+		if sc != nil && sc.Privileged != nil && *sc.Privileged {
+			violations = append(violations, fmt.Sprintf("container %q is privileged", c.Name))
+		}
+
+		if sc == nil || sc.Capabilities == nil || !slices.Contains(sc.Capabilities.Drop, corev1.Capability("ALL")) {
+			violations = append(violations, fmt.Sprintf("container %q does not drop the ALL capability", c.Name))
+		}
+		if sc != nil && sc.Capabilities != nil {
+			for _, cap := range sc.Capabilities.Add {
+				if cap != corev1.Capability("NET_BIND_SERVICE") {
+					violations = append(violations, fmt.Sprintf("container %q adds disallowed capability %q", c.Name, cap))
+				}
+			}
+		}
+
+		seccompOK := podSeccompOK
+		if sc != nil && sc.SeccompProfile != nil {
+			seccompOK = sc.SeccompProfile.Type == corev1.SeccompProfileTypeRuntimeDefault || sc.SeccompProfile.Type == corev1.SeccompProfileTypeLocalhost
+		}
+		if !seccompOK {
+			violations = append(violations, fmt.Sprintf("container %q does not set a RuntimeDefault or Localhost seccompProfile", c.Name))
+		}
+	}
+
+	return violations
+}
+
+func joinViolations(violations []string) string {
+	msg := ""
+	for i, v := range violations {
+		if i > 0 {
+			msg += "; "
+		}
+		msg += v
+	}
+	return msg
+}
@@ -0,0 +1,117 @@
+package podsecurity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/preflights/podsecurity"
+)
+
+func namespace(name, enforceLevel string) *corev1.Namespace {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if enforceLevel != "" {
+		ns.Labels = map[string]string{"pod-security.kubernetes.io/enforce": enforceLevel}
+	}
+	return ns
+}
+
+func deployment(t *testing.T, ns string, podSpec corev1.PodSpec) client.Object {
+	t.Helper()
+	dep := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-operator", Namespace: ns},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{Spec: podSpec},
+		},
+	}
+	uMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(dep)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: uMap}
+}
+
+func restrictedContainer() corev1.Container {
+	return corev1.Container{
+		Name: "manager",
+		SecurityContext: &corev1.SecurityContext{
+			RunAsNonRoot:             ptrBool(true),
+			AllowPrivilegeEscalation: ptrBool(false),
+			Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+			SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		},
+	}
+}
+
+func ptrBool(b bool) *bool { return &b }
+
+func TestPreflightInstall(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		namespace  *corev1.Namespace
+		obj        func(t *testing.T) client.Object
+		requireErr require.ErrorAssertionFunc
+	}{
+		{
+			name:      "no enforce label allows anything",
+			namespace: namespace("my-ns", ""),
+			obj: func(t *testing.T) client.Object {
+				return deployment(t, "my-ns", corev1.PodSpec{HostNetwork: true, Containers: []corev1.Container{{Name: "manager"}}})
+			},
+			requireErr: require.NoError,
+		},
+		{
+			name:      "baseline rejects hostNetwork",
+			namespace: namespace("my-ns", "baseline"),
+			obj: func(t *testing.T) client.Object {
+				return deployment(t, "my-ns", corev1.PodSpec{HostNetwork: true, Containers: []corev1.Container{{Name: "manager"}}})
+			},
+			requireErr: func(t require.TestingT, err error, _ ...interface{}) {
+				require.ErrorContains(t, err, "hostNetwork=true")
+			},
+		},
+		{
+			name:      "restricted rejects missing runAsNonRoot",
+			namespace: namespace("my-ns", "restricted"),
+			obj: func(t *testing.T) client.Object {
+				return deployment(t, "my-ns", corev1.PodSpec{Containers: []corev1.Container{{Name: "manager"}}})
+			},
+			requireErr: func(t require.TestingT, err error, _ ...interface{}) {
+				require.ErrorContains(t, err, `container "manager" does not set runAsNonRoot=true`)
+			},
+		},
+		{
+			name:      "restricted allows a fully compliant pod",
+			namespace: namespace("my-ns", "restricted"),
+			obj: func(t *testing.T) client.Object {
+				return deployment(t, "my-ns", corev1.PodSpec{Containers: []corev1.Container{restrictedContainer()}})
+			},
+			requireErr: require.NoError,
+		},
+		{
+			name: "namespace not found (namespace being created) allows anything",
+			obj: func(t *testing.T) client.Object {
+				return deployment(t, "not-yet-created", corev1.PodSpec{HostNetwork: true, Containers: []corev1.Container{{Name: "manager"}}})
+			},
+			requireErr: require.NoError,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var objs []runtime.Object
+			if tc.namespace != nil {
+				objs = append(objs, tc.namespace)
+			}
+			fakeClient := fake.NewClientset(objs...)
+			p := podsecurity.NewPreflight(fakeClient.CoreV1().Namespaces())
+			err := p.Install(context.Background(), []client.Object{tc.obj(t)})
+			tc.requireErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Package rbacescalation implements a preflight check that rejects a
+// bundle's rendered ClusterRoles and Roles when they grant a wildcard
+// verbs=["*"] on apiGroups=["*"], resources=["*"] rule, i.e. the
+// cluster-admin-equivalent capability to do anything to anything. This
+// catches bundles that over-request permissions well beyond what the
+// package actually needs, before the objects are ever applied.
+package rbacescalation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Preflight rejects rendered ClusterRoles and Roles that grant a wildcard
+// verbs=["*"] on apiGroups=["*"], resources=["*"] rule.
+type Preflight struct{}
+
+// NewPreflight returns a new Preflight.
+func NewPreflight() *Preflight {
+	return &Preflight{}
+}
+
+func (p *Preflight) Install(ctx context.Context, objs []client.Object) error {
+	return p.runPreflight(objs)
+}
+
+func (p *Preflight) Upgrade(ctx context.Context, objs []client.Object) error {
+	return p.runPreflight(objs)
+}
+
+func (p *Preflight) runPreflight(objs []client.Object) error {
+	var validateErrors []error
+	for _, obj := range objs {
+		rules, found, err := policyRulesOf(obj)
+		if err != nil {
+			return fmt.Errorf("extracting rules from %q %q: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		if !found {
+			continue
+		}
+
+		if escalating := wildcardRules(rules); len(escalating) > 0 {
+			validateErrors = append(validateErrors, fmt.Errorf("%q %q grants cluster-admin-equivalent permissions, which is not allowed: %s",
+				obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), joinRules(escalating)))
+		}
+	}
+	return errors.Join(validateErrors...)
+}
+
+// policyRulesOf extracts the PolicyRules from obj, if obj is a ClusterRole
+// or Role.
+func policyRulesOf(obj client.Object) ([]rbacv1.PolicyRule, bool, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, false, nil
+	}
+
+	switch u.GroupVersionKind().GroupKind().String() {
+	case "ClusterRole.rbac.authorization.k8s.io", "Role.rbac.authorization.k8s.io":
+	default:
+		return nil, false, nil
+	}
+
+	if _, found, err := unstructured.NestedSlice(u.Object, "rules"); err != nil || !found {
+		return nil, found, err
+	}
+
+	// ClusterRole and Role share the same "rules" field shape, so decoding
+	// either kind into a ClusterRole is sufficient to read it out.
+	role := &rbacv1.ClusterRole{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, role); err != nil {
+		return nil, false, err
+	}
+	return role.Rules, true, nil
+}
+
+// wildcardRules returns the subset of rules that grant verbs=["*"] on
+// apiGroups=["*"], resources=["*"].
+func wildcardRules(rules []rbacv1.PolicyRule) []rbacv1.PolicyRule {
+	var escalating []rbacv1.PolicyRule
+	for _, rule := range rules {
+		if isWildcard(rule.Verbs) && isWildcard(rule.APIGroups) && isWildcard(rule.Resources) {
+			escalating = append(escalating, rule)
+		}
+	}
+	return escalating
+}
+
+func isWildcard(values []string) bool {
+	return len(values) == 1 && values[0] == "*"
+}
+
+func joinRules(rules []rbacv1.PolicyRule) string {
+	msg := ""
+	for i, rule := range rules {
+		if i > 0 {
+			msg += "; "
+		}
+		msg += fmt.Sprintf("verbs=%v apiGroups=%v resources=%v", rule.Verbs, rule.APIGroups, rule.Resources)
+	}
+	return msg
+}
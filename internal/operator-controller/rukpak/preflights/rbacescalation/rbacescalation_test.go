@@ -0,0 +1,92 @@
+package rbacescalation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/preflights/rbacescalation"
+)
+
+func clusterRole(t *testing.T, rules ...rbacv1.PolicyRule) client.Object {
+	t.Helper()
+	cr := &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-operator"},
+		Rules:      rules,
+	}
+	uMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cr)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: uMap}
+}
+
+func role(t *testing.T, rules ...rbacv1.PolicyRule) client.Object {
+	t.Helper()
+	r := &rbacv1.Role{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "Role"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-operator", Namespace: "test-namespace"},
+		Rules:      rules,
+	}
+	uMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: uMap}
+}
+
+func TestPreflightInstall(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		obj        client.Object
+		requireErr require.ErrorAssertionFunc
+	}{
+		{
+			name:       "narrowly scoped ClusterRole",
+			obj:        clusterRole(t, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}}),
+			requireErr: require.NoError,
+		},
+		{
+			name: "cluster-admin-equivalent ClusterRole",
+			obj:  clusterRole(t, rbacv1.PolicyRule{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}),
+			requireErr: func(t require.TestingT, err error, _ ...any) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "grants cluster-admin-equivalent permissions")
+			},
+		},
+		{
+			name: "cluster-admin-equivalent Role",
+			obj:  role(t, rbacv1.PolicyRule{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}),
+			requireErr: func(t require.TestingT, err error, _ ...any) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "grants cluster-admin-equivalent permissions")
+			},
+		},
+		{
+			name:       "wildcard verbs alone is not escalating",
+			obj:        clusterRole(t, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"*"}}),
+			requireErr: require.NoError,
+		},
+		{
+			name:       "non-RBAC object is ignored",
+			obj:        &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{"name": "test"}}},
+			requireErr: require.NoError,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := rbacescalation.NewPreflight()
+			err := p.Install(context.Background(), []client.Object{tc.obj})
+			tc.requireErr(t, err)
+		})
+	}
+}
+
+func TestPreflightUpgrade(t *testing.T) {
+	p := rbacescalation.NewPreflight()
+	obj := clusterRole(t, rbacv1.PolicyRule{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}})
+	err := p.Upgrade(context.Background(), []client.Object{obj})
+	require.ErrorContains(t, err, "grants cluster-admin-equivalent permissions")
+}
@@ -0,0 +1,217 @@
+package certproviders
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/render"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/util"
+)
+
+const selfSignedKeySize = 2048
+
+// selfSignedCertBundle holds a self-signed CA and the leaf serving
+// certificate it issued, along with the leaf's expiry so the bundle can be
+// renewed in place once it gets close to expiring.
+type selfSignedCertBundle struct {
+	caCertPEM   []byte
+	leafCertPEM []byte
+	leafKeyPEM  []byte
+	notAfter    time.Time
+}
+
+// SelfSignedCertificateProvider provisions webhook and conversion serving
+// certificates without depending on an external operator such as CertManager
+// or the Openshift Service CA operator. For every deployment that owns a
+// webhook it issues its own self-signed CA and a leaf certificate signed by
+// that CA, embeds the serving certificate and key into the rendered Secret
+// (using the same secret name and keys the other providers use, so the
+// generated deployment volumes in generators.go need no special casing),
+// and writes the CA straight into the CABundle field of the rendered
+// webhook/CRD conversion configuration.
+//
+// Rendering a bundle is otherwise a pure function of its inputs, but a
+// certificate must survive across renders to avoid invalidating connections
+// that already trust it every time the ClusterExtension is reconciled.
+// SelfSignedCertificateProvider therefore caches the bundle it issues for a
+// given namespace/cert name in memory, reusing it on subsequent renders
+// until the leaf certificate is within olmv0RenewBefore of expiring, at
+// which point both the CA and the leaf are reissued together. The cache
+// only lives as long as the operator-controller process, so a restart
+// forces a one-time reissuance.
+type SelfSignedCertificateProvider struct {
+	mu    sync.Mutex
+	certs map[string]*selfSignedCertBundle
+}
+
+var _ render.CertificateProvider = (*SelfSignedCertificateProvider)(nil)
+
+func (p *SelfSignedCertificateProvider) InjectCABundle(obj client.Object, cfg render.CertificateProvisionerConfig) error {
+	bundle, err := p.bundleFor(cfg)
+	if err != nil {
+		return err
+	}
+	switch o := obj.(type) {
+	case *admissionregistrationv1.ValidatingWebhookConfiguration:
+		for i := range o.Webhooks {
+			o.Webhooks[i].ClientConfig.CABundle = bundle.caCertPEM
+		}
+	case *admissionregistrationv1.MutatingWebhookConfiguration:
+		for i := range o.Webhooks {
+			o.Webhooks[i].ClientConfig.CABundle = bundle.caCertPEM
+		}
+	case *apiextensionsv1.CustomResourceDefinition:
+		if o.Spec.Conversion != nil && o.Spec.Conversion.Webhook != nil && o.Spec.Conversion.Webhook.ClientConfig != nil {
+			o.Spec.Conversion.Webhook.ClientConfig.CABundle = bundle.caCertPEM
+		}
+	}
+	return nil
+}
+
+func (p *SelfSignedCertificateProvider) GetCertSecretInfo(cfg render.CertificateProvisionerConfig) render.CertSecretInfo {
+	return render.CertSecretInfo{
+		SecretName:     cfg.CertName,
+		PrivateKeyKey:  "tls.key",
+		CertificateKey: "tls.crt",
+	}
+}
+
+func (p *SelfSignedCertificateProvider) AdditionalObjects(cfg render.CertificateProvisionerConfig) ([]unstructured.Unstructured, error) {
+	bundle, err := p.bundleFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cfg.CertName,
+			Namespace: cfg.Namespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       bundle.leafCertPEM,
+			corev1.TLSPrivateKeyKey: bundle.leafKeyPEM,
+		},
+	}
+	secretObj, err := util.ToUnstructured(secret)
+	if err != nil {
+		return nil, err
+	}
+	return []unstructured.Unstructured{*secretObj}, nil
+}
+
+// bundleFor returns the cached certificate bundle for cfg's namespace and
+// cert name, issuing or renewing it first if it is missing or close to
+// expiring.
+func (p *SelfSignedCertificateProvider) bundleFor(cfg render.CertificateProvisionerConfig) (*selfSignedCertBundle, error) {
+	key := cfg.Namespace + "/" + cfg.CertName
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.certs == nil {
+		p.certs = map[string]*selfSignedCertBundle{}
+	}
+	if bundle, ok := p.certs[key]; ok && time.Until(bundle.notAfter) > olmv0RenewBefore {
+		return bundle, nil
+	}
+
+	bundle, err := issueSelfSignedCertBundle(cfg)
+	if err != nil {
+		return nil, err
+	}
+	p.certs[key] = bundle
+	return bundle, nil
+}
+
+func issueSelfSignedCertBundle(cfg render.CertificateProvisionerConfig) (*selfSignedCertBundle, error) {
+	now := time.Now()
+	notAfter := now.Add(olmv0RotationPeriod)
+
+	caKey, err := rsa.GenerateKey(rand.Reader, selfSignedKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("generating self-signed CA key: %w", err)
+	}
+	caSerial, err := newCertSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          caSerial,
+		Subject:               pkix.Name{CommonName: fmt.Sprintf("%s-ca", cfg.CertName)},
+		NotBefore:             now,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caCertDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("creating self-signed CA certificate: %w", err)
+	}
+	caCert, err := x509.ParseCertificate(caCertDER)
+	if err != nil {
+		return nil, fmt.Errorf("parsing self-signed CA certificate: %w", err)
+	}
+
+	leafKey, err := rsa.GenerateKey(rand.Reader, selfSignedKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("generating serving certificate key: %w", err)
+	}
+	leafSerial, err := newCertSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+	commonName := fmt.Sprintf("%s.%s", cfg.ServiceName, cfg.Namespace)
+	leafTemplate := &x509.Certificate{
+		SerialNumber: leafSerial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames: []string{
+			commonName,
+			fmt.Sprintf("%s.%s.svc", cfg.ServiceName, cfg.Namespace),
+			fmt.Sprintf("%s.%s.svc.cluster.local", cfg.ServiceName, cfg.Namespace),
+		},
+	}
+	leafCertDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, caCert, &leafKey.PublicKey, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("creating serving certificate: %w", err)
+	}
+
+	return &selfSignedCertBundle{
+		caCertPEM:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCertDER}),
+		leafCertPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafCertDER}),
+		leafKeyPEM:  pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(leafKey)}),
+		notAfter:    notAfter,
+	}, nil
+}
+
+func newCertSerialNumber() (*big.Int, error) {
+	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
+	serial, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, fmt.Errorf("generating certificate serial number: %w", err)
+	}
+	return serial, nil
+}
@@ -0,0 +1,148 @@
+package certproviders_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/render"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/render/certproviders"
+)
+
+func selfSignedCfg() render.CertificateProvisionerConfig {
+	return render.CertificateProvisionerConfig{
+		ServiceName: "webhook-service",
+		Namespace:   "namespace",
+		CertName:    "cert-name",
+	}
+}
+
+func Test_SelfSignedProvider_GetCertSecretInfo(t *testing.T) {
+	certProvider := &certproviders.SelfSignedCertificateProvider{}
+	certInfo := certProvider.GetCertSecretInfo(selfSignedCfg())
+	require.Equal(t, render.CertSecretInfo{
+		SecretName:     "cert-name",
+		PrivateKeyKey:  "tls.key",
+		CertificateKey: "tls.crt",
+	}, certInfo)
+}
+
+func Test_SelfSignedProvider_AdditionalObjects(t *testing.T) {
+	certProvider := &certproviders.SelfSignedCertificateProvider{}
+	objs, err := certProvider.AdditionalObjects(selfSignedCfg())
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+
+	secret := secretFromUnstructured(t, &objs[0])
+	require.Equal(t, "cert-name", secret.Name)
+	require.Equal(t, "namespace", secret.Namespace)
+	require.Equal(t, corev1.SecretTypeTLS, secret.Type)
+
+	leafCert := parsePEMCertificate(t, secret.Data[corev1.TLSCertKey])
+	require.Equal(t, "webhook-service.namespace", leafCert.Subject.CommonName)
+	require.ElementsMatch(t, []string{
+		"webhook-service.namespace",
+		"webhook-service.namespace.svc",
+		"webhook-service.namespace.svc.cluster.local",
+	}, leafCert.DNSNames)
+	require.NotEmpty(t, secret.Data[corev1.TLSPrivateKeyKey])
+}
+
+func Test_SelfSignedProvider_InjectCABundle(t *testing.T) {
+	certProvider := &certproviders.SelfSignedCertificateProvider{}
+	cfg := selfSignedCfg()
+
+	// obtain the issued leaf certificate to verify it was signed by whatever CA bundle gets injected
+	objs, err := certProvider.AdditionalObjects(cfg)
+	require.NoError(t, err)
+	leafCert := parsePEMCertificate(t, secretFromUnstructured(t, &objs[0]).Data[corev1.TLSCertKey])
+
+	t.Run("injects the CA bundle into a validating webhook configuration", func(t *testing.T) {
+		obj := &admissionregistrationv1.ValidatingWebhookConfiguration{
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{{}},
+		}
+		require.NoError(t, certProvider.InjectCABundle(obj, cfg))
+		require.NotEmpty(t, obj.Webhooks[0].ClientConfig.CABundle)
+		verifyIssuedBy(t, leafCert, obj.Webhooks[0].ClientConfig.CABundle)
+	})
+
+	t.Run("injects the CA bundle into a mutating webhook configuration", func(t *testing.T) {
+		obj := &admissionregistrationv1.MutatingWebhookConfiguration{
+			Webhooks: []admissionregistrationv1.MutatingWebhook{{}},
+		}
+		require.NoError(t, certProvider.InjectCABundle(obj, cfg))
+		require.NotEmpty(t, obj.Webhooks[0].ClientConfig.CABundle)
+		verifyIssuedBy(t, leafCert, obj.Webhooks[0].ClientConfig.CABundle)
+	})
+
+	t.Run("injects the CA bundle into a custom resource definition's conversion webhook", func(t *testing.T) {
+		obj := &apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Conversion: &apiextensionsv1.CustomResourceConversion{
+					Webhook: &apiextensionsv1.WebhookConversion{
+						ClientConfig: &apiextensionsv1.WebhookClientConfig{},
+					},
+				},
+			},
+		}
+		require.NoError(t, certProvider.InjectCABundle(obj, cfg))
+		require.NotEmpty(t, obj.Spec.Conversion.Webhook.ClientConfig.CABundle)
+		verifyIssuedBy(t, leafCert, obj.Spec.Conversion.Webhook.ClientConfig.CABundle)
+	})
+
+	t.Run("ignores other objects", func(t *testing.T) {
+		obj := &corev1.Service{}
+		require.NoError(t, certProvider.InjectCABundle(obj, cfg))
+		require.Equal(t, &corev1.Service{}, obj)
+	})
+}
+
+func Test_SelfSignedProvider_ReusesCertUntilNearExpiry(t *testing.T) {
+	certProvider := &certproviders.SelfSignedCertificateProvider{}
+	cfg := selfSignedCfg()
+
+	first, err := certProvider.AdditionalObjects(cfg)
+	require.NoError(t, err)
+	second, err := certProvider.AdditionalObjects(cfg)
+	require.NoError(t, err)
+
+	firstCert := secretFromUnstructured(t, &first[0]).Data[corev1.TLSCertKey]
+	secondCert := secretFromUnstructured(t, &second[0]).Data[corev1.TLSCertKey]
+	require.Equal(t, firstCert, secondCert)
+}
+
+func secretFromUnstructured(t *testing.T, u *unstructured.Unstructured) *corev1.Secret {
+	t.Helper()
+	secret := &corev1.Secret{}
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, secret))
+	return secret
+}
+
+func parsePEMCertificate(t *testing.T, pemBytes []byte) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode(pemBytes)
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return cert
+}
+
+func verifyIssuedBy(t *testing.T, leafCert *x509.Certificate, caBundlePEM []byte) {
+	t.Helper()
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM(caBundlePEM))
+	_, err := leafCert.Verify(x509.VerifyOptions{
+		Roots:       pool,
+		CurrentTime: leafCert.NotBefore.Add(time.Minute),
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	require.NoError(t, err)
+}
@@ -10,9 +10,11 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
@@ -28,6 +30,11 @@ import (
 
 const (
 	labelKubernetesNamespaceMetadataName = "kubernetes.io/metadata.name"
+
+	trustedCABundleVolumeName    = "trusted-ca-bundle"
+	trustedCABundleMountPath     = "/etc/pki/ca-trust/extracted/pem"
+	trustedCABundleConfigMapKey  = "ca-bundle.crt"
+	trustedCABundleConfigMapFile = "tls-ca-bundle.pem"
 )
 
 type certVolumeConfig struct {
@@ -98,6 +105,22 @@ func BundleCSVDeploymentGenerator(rv1 *bundle.RegistryV1, opts render.Options) (
 			ensureCorrectDeploymentCertVolumes(deploymentResource, *secretInfo)
 		}
 
+		if opts.TrustedCABundleConfigMap != "" {
+			ensureTrustedCABundleVolume(deploymentResource, opts.TrustedCABundleConfigMap)
+		}
+		if len(opts.ProxyEnv) > 0 {
+			ensureProxyEnv(deploymentResource, opts.ProxyEnv)
+		}
+		if len(opts.DefaultNodeSelector) > 0 {
+			deploymentResource.Spec.Template.Spec.NodeSelector = util.MergeMaps(opts.DefaultNodeSelector, deploymentResource.Spec.Template.Spec.NodeSelector)
+		}
+		if len(opts.DefaultTolerations) > 0 {
+			ensureDefaultTolerations(deploymentResource, opts.DefaultTolerations)
+		}
+		if len(opts.ImageMirror) > 0 {
+			ensureMirroredImages(deploymentResource, opts.ImageMirror)
+		}
+
 		objs = append(objs, deploymentResource)
 	}
 	return objs, nil
@@ -277,13 +300,14 @@ func BundleAdditionalResourcesGenerator(rv1 *bundle.RegistryV1, opts render.Opti
 		return nil, fmt.Errorf("bundle cannot be nil")
 	}
 	objs := make([]client.Object, 0, len(rv1.Others))
-	for _, res := range rv1.Others {
+	for i := range rv1.Others {
+		res := &rv1.Others[i]
 		supported, namespaced := registrybundle.IsSupported(res.GetKind())
 		if !supported {
 			return nil, fmt.Errorf("bundle contains unsupported resource: Name: %v, Kind: %v", res.GetName(), res.GetKind())
 		}
 
-		obj := res.DeepCopy()
+		obj := shallowCopyForMetadataMutation(res)
 		if namespaced {
 			obj.SetNamespace(opts.InstallNamespace)
 		}
@@ -293,6 +317,28 @@ func BundleAdditionalResourcesGenerator(rv1 *bundle.RegistryV1, opts render.Opti
 	return objs, nil
 }
 
+// shallowCopyForMetadataMutation returns a copy of res that's safe to mutate through its metadata
+// accessors (SetNamespace, SetLabels, SetAnnotations, and so on) without those mutations leaking
+// back into res. Callers of this generator, and the applier stages downstream of it, only ever
+// touch metadata on the resources it returns, so a full res.DeepCopy() - which recursively copies
+// spec, data, and every other field too - is more work than the contract needs. Copying just the
+// top-level object map and the metadata map one level down is enough: unstructured's metadata
+// setters replace entries in that map rather than mutating anything nested further inside it.
+func shallowCopyForMetadataMutation(res *unstructured.Unstructured) *unstructured.Unstructured {
+	objCopy := make(map[string]interface{}, len(res.Object))
+	for k, v := range res.Object {
+		objCopy[k] = v
+	}
+	if metadata, ok := objCopy["metadata"].(map[string]interface{}); ok {
+		metadataCopy := make(map[string]interface{}, len(metadata))
+		for k, v := range metadata {
+			metadataCopy[k] = v
+		}
+		objCopy["metadata"] = metadataCopy
+	}
+	return &unstructured.Unstructured{Object: objCopy}
+}
+
 // BundleValidatingWebhookResourceGenerator generates ValidatingAdmissionWebhookConfiguration resources based on
 // the bundle's cluster service version spec. The resource is modified by the CertificateProvider in opts
 // to add any annotations or modifications necessary for certificate injection.
@@ -475,6 +521,107 @@ func CertProviderResourceGenerator(rv1 *bundle.RegistryV1, opts render.Options)
 	return objs, nil
 }
 
+// BundleNetworkPolicyGenerator generates a default-deny NetworkPolicy for the bundle's workloads in
+// opts.InstallNamespace, along with allow rules for the traffic those workloads need: egress to the API server,
+// ingress for webhook callbacks, and ingress for metrics scraping. Resources are only generated when
+// opts.GenerateNetworkPolicy is true.
+func BundleNetworkPolicyGenerator(rv1 *bundle.RegistryV1, opts render.Options) ([]client.Object, error) {
+	if rv1 == nil {
+		return nil, fmt.Errorf("bundle cannot be nil")
+	}
+	if !opts.GenerateNetworkPolicy {
+		return nil, nil
+	}
+
+	objs := []client.Object{
+		CreateNetworkPolicyResource(
+			fmt.Sprintf("%s-default-deny", rv1.CSV.Name),
+			opts.InstallNamespace,
+			WithNetworkPolicySpec(networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			}),
+		),
+		// The API server's address is not known ahead of time, so egress is allowed to any
+		// destination on the ports it typically listens on.
+		CreateNetworkPolicyResource(
+			fmt.Sprintf("%s-allow-apiserver-egress", rv1.CSV.Name),
+			opts.InstallNamespace,
+			WithNetworkPolicySpec(networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+				Egress: []networkingv1.NetworkPolicyEgressRule{
+					{Ports: []networkingv1.NetworkPolicyPort{
+						{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(443))},
+						{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(6443))},
+					}},
+				},
+			}),
+		),
+	}
+
+	if webhookPorts := webhookContainerPorts(rv1); len(webhookPorts) > 0 {
+		objs = append(objs, CreateNetworkPolicyResource(
+			fmt.Sprintf("%s-allow-webhook-ingress", rv1.CSV.Name),
+			opts.InstallNamespace,
+			WithNetworkPolicySpec(networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				Ingress:     []networkingv1.NetworkPolicyIngressRule{{Ports: webhookPorts}},
+			}),
+		))
+	}
+
+	if metricsPorts := metricsContainerPorts(rv1); len(metricsPorts) > 0 {
+		objs = append(objs, CreateNetworkPolicyResource(
+			fmt.Sprintf("%s-allow-metrics-ingress", rv1.CSV.Name),
+			opts.InstallNamespace,
+			WithNetworkPolicySpec(networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				Ingress:     []networkingv1.NetworkPolicyIngressRule{{Ports: metricsPorts}},
+			}),
+		))
+	}
+
+	return objs, nil
+}
+
+// webhookContainerPorts returns the sorted, deduplicated set of ports the bundle's webhooks are served on.
+func webhookContainerPorts(rv1 *bundle.RegistryV1) []networkingv1.NetworkPolicyPort {
+	ports := sets.Set[int32]{}
+	for _, wh := range rv1.CSV.Spec.WebhookDefinitions {
+		ports.Insert(getWebhookServicePort(wh).Port)
+	}
+	return toNetworkPolicyPorts(ports)
+}
+
+// metricsContainerPorts returns the sorted, deduplicated set of container ports across the bundle's Deployments
+// whose name suggests they serve metrics, e.g. "https-metrics" or "metrics".
+func metricsContainerPorts(rv1 *bundle.RegistryV1) []networkingv1.NetworkPolicyPort {
+	ports := sets.Set[int32]{}
+	for _, depSpec := range rv1.CSV.Spec.InstallStrategy.StrategySpec.DeploymentSpecs {
+		for _, c := range depSpec.Spec.Template.Spec.Containers {
+			for _, p := range c.Ports {
+				if strings.Contains(strings.ToLower(p.Name), "metric") {
+					ports.Insert(p.ContainerPort)
+				}
+			}
+		}
+	}
+	return toNetworkPolicyPorts(ports)
+}
+
+func toNetworkPolicyPorts(ports sets.Set[int32]) []networkingv1.NetworkPolicyPort {
+	sortedPorts := ports.UnsortedList()
+	slices.Sort(sortedPorts)
+
+	npPorts := make([]networkingv1.NetworkPolicyPort, 0, len(sortedPorts))
+	for _, p := range sortedPorts {
+		npPorts = append(npPorts, networkingv1.NetworkPolicyPort{
+			Protocol: ptr.To(corev1.ProtocolTCP),
+			Port:     ptr.To(intstr.FromInt32(p)),
+		})
+	}
+	return npPorts
+}
+
 func saNameOrDefault(saName string) string {
 	return cmp.Or(saName, "default")
 }
@@ -562,6 +709,108 @@ func ensureCorrectDeploymentCertVolumes(dep *appsv1.Deployment, certSecretInfo r
 	}
 }
 
+// ensureTrustedCABundleVolume projects configMapName's ca-bundle.crt key into every container of
+// dep at trustedCABundleMountPath, replacing any volume/mount it already added under the same
+// name (e.g. from a previous render of the same bundle).
+func ensureTrustedCABundleVolume(dep *appsv1.Deployment, configMapName string) {
+	volume := corev1.Volume{
+		Name: trustedCABundleVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+				Items: []corev1.KeyToPath{
+					{Key: trustedCABundleConfigMapKey, Path: trustedCABundleConfigMapFile},
+				},
+			},
+		},
+	}
+	volumeMount := corev1.VolumeMount{
+		Name:      trustedCABundleVolumeName,
+		MountPath: trustedCABundleMountPath,
+		ReadOnly:  true,
+	}
+
+	dep.Spec.Template.Spec.Volumes = append(
+		slices.DeleteFunc(dep.Spec.Template.Spec.Volumes, func(v corev1.Volume) bool {
+			return v.Name == trustedCABundleVolumeName
+		}),
+		volume,
+	)
+	for i := range dep.Spec.Template.Spec.Containers {
+		dep.Spec.Template.Spec.Containers[i].VolumeMounts = append(
+			slices.DeleteFunc(dep.Spec.Template.Spec.Containers[i].VolumeMounts, func(v corev1.VolumeMount) bool {
+				return v.Name == trustedCABundleVolumeName
+			}),
+			volumeMount,
+		)
+	}
+}
+
+// ensureProxyEnv appends proxyEnv to every container of dep, skipping any variable a container
+// already defines so bundle-declared values take precedence over the cluster-wide default.
+func ensureProxyEnv(dep *appsv1.Deployment, proxyEnv []corev1.EnvVar) {
+	for i := range dep.Spec.Template.Spec.Containers {
+		container := &dep.Spec.Template.Spec.Containers[i]
+		existing := sets.New[string]()
+		for _, e := range container.Env {
+			existing.Insert(e.Name)
+		}
+		for _, e := range proxyEnv {
+			if !existing.Has(e.Name) {
+				container.Env = append(container.Env, e)
+			}
+		}
+	}
+}
+
+// ensureDefaultTolerations appends defaultTolerations to dep's Pod template, skipping any
+// toleration the bundle's own Deployment spec already declares so the cluster-wide default never
+// duplicates or overrides a bundle-declared toleration.
+func ensureDefaultTolerations(dep *appsv1.Deployment, defaultTolerations []corev1.Toleration) {
+	existing := sets.New[corev1.Toleration](dep.Spec.Template.Spec.Tolerations...)
+	for _, t := range defaultTolerations {
+		if !existing.Has(t) {
+			dep.Spec.Template.Spec.Tolerations = append(dep.Spec.Template.Spec.Tolerations, t)
+		}
+	}
+}
+
+// relatedImageEnvPrefix is the convention OLMv0 and bundle authors use for environment variables
+// that carry an operand image reference for the operator to read at runtime, mirroring
+// ClusterServiceVersion's own relatedImages field into the Deployment that consumes it.
+const relatedImageEnvPrefix = "RELATED_IMAGE_"
+
+// ensureMirroredImages rewrites every container and init container image in dep, and the value of
+// every RELATED_IMAGE_* environment variable, to its mirrored location per mirror.
+func ensureMirroredImages(dep *appsv1.Deployment, mirror map[string]string) {
+	rewriteContainerImages := func(containers []corev1.Container) {
+		for i := range containers {
+			containers[i].Image = mirroredImageRef(containers[i].Image, mirror)
+			for j := range containers[i].Env {
+				if strings.HasPrefix(containers[i].Env[j].Name, relatedImageEnvPrefix) {
+					containers[i].Env[j].Value = mirroredImageRef(containers[i].Env[j].Value, mirror)
+				}
+			}
+		}
+	}
+	rewriteContainerImages(dep.Spec.Template.Spec.Containers)
+	rewriteContainerImages(dep.Spec.Template.Spec.InitContainers)
+}
+
+// mirroredImageRef rewrites ref to its mirrored location if ref's registry host is a key in
+// mirror, and returns ref unchanged otherwise.
+func mirroredImageRef(ref string, mirror map[string]string) string {
+	host, _, found := strings.Cut(ref, "/")
+	if !found {
+		return ref
+	}
+	target, ok := mirror[host]
+	if !ok {
+		return ref
+	}
+	return target + strings.TrimPrefix(ref, host)
+}
+
 // getWebhookNamespaceSelector returns a label selector that matches any namespace in targetNamespaces.
 // If targetNamespaces is empty, nil, or includes "" (signifying all namespaces) nil is returned.
 func getWebhookNamespaceSelector(targetNamespaces []string) *metav1.LabelSelector {
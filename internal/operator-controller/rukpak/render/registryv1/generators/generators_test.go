@@ -10,6 +10,7 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -153,6 +154,230 @@ func Test_BundleCSVDeploymentGenerator_Succeeds(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "mounts the trusted CA bundle and propagates proxy env when configured",
+			bundle: &bundle.RegistryV1{
+				CSV: clusterserviceversion.Builder().
+					WithStrategyDeploymentSpecs(
+						v1alpha1.StrategyDeploymentSpec{
+							Name: "deployment-one",
+							Spec: appsv1.DeploymentSpec{
+								Template: corev1.PodTemplateSpec{
+									Spec: corev1.PodSpec{
+										Containers: []corev1.Container{
+											{
+												Name: "manager",
+												Env: []corev1.EnvVar{
+													{Name: "HTTP_PROXY", Value: "http://bundle-declared-proxy"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					).Build(),
+			},
+			opts: render.Options{
+				InstallNamespace:         "install-namespace",
+				TrustedCABundleConfigMap: "trusted-ca-bundle",
+				ProxyEnv: []corev1.EnvVar{
+					{Name: "HTTP_PROXY", Value: "http://cluster-proxy"},
+					{Name: "HTTPS_PROXY", Value: "https://cluster-proxy"},
+				},
+			},
+			expectedResources: []client.Object{
+				&appsv1.Deployment{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Deployment",
+						APIVersion: appsv1.SchemeGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "install-namespace",
+						Name:      "deployment-one",
+					},
+					Spec: appsv1.DeploymentSpec{
+						RevisionHistoryLimit: ptr.To(int32(1)),
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Annotations: map[string]string{
+									"olm.targetNamespaces": "",
+								},
+							},
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{
+										Name: "manager",
+										Env: []corev1.EnvVar{
+											{Name: "HTTP_PROXY", Value: "http://bundle-declared-proxy"},
+											{Name: "HTTPS_PROXY", Value: "https://cluster-proxy"},
+										},
+										VolumeMounts: []corev1.VolumeMount{
+											{Name: "trusted-ca-bundle", MountPath: "/etc/pki/ca-trust/extracted/pem", ReadOnly: true},
+										},
+									},
+								},
+								Volumes: []corev1.Volume{
+									{
+										Name: "trusted-ca-bundle",
+										VolumeSource: corev1.VolumeSource{
+											ConfigMap: &corev1.ConfigMapVolumeSource{
+												LocalObjectReference: corev1.LocalObjectReference{Name: "trusted-ca-bundle"},
+												Items: []corev1.KeyToPath{
+													{Key: "ca-bundle.crt", Path: "tls-ca-bundle.pem"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "applies default node selector and tolerations unless the bundle already sets them",
+			bundle: &bundle.RegistryV1{
+				CSV: clusterserviceversion.Builder().
+					WithStrategyDeploymentSpecs(
+						v1alpha1.StrategyDeploymentSpec{
+							Name: "deployment-one",
+							Spec: appsv1.DeploymentSpec{
+								Template: corev1.PodTemplateSpec{
+									Spec: corev1.PodSpec{
+										NodeSelector: map[string]string{
+											"bundle-declared": "wins",
+										},
+										Tolerations: []corev1.Toleration{
+											{Key: "bundle-declared", Operator: corev1.TolerationOpExists},
+										},
+									},
+								},
+							},
+						},
+					).Build(),
+			},
+			opts: render.Options{
+				InstallNamespace: "install-namespace",
+				DefaultNodeSelector: map[string]string{
+					"bundle-declared": "loses",
+					"infra-only":      "true",
+				},
+				DefaultTolerations: []corev1.Toleration{
+					{Key: "bundle-declared", Operator: corev1.TolerationOpExists},
+					{Key: "infra-only", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+				},
+			},
+			expectedResources: []client.Object{
+				&appsv1.Deployment{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Deployment",
+						APIVersion: appsv1.SchemeGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "install-namespace",
+						Name:      "deployment-one",
+					},
+					Spec: appsv1.DeploymentSpec{
+						RevisionHistoryLimit: ptr.To(int32(1)),
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Annotations: map[string]string{
+									"olm.targetNamespaces": "",
+								},
+							},
+							Spec: corev1.PodSpec{
+								NodeSelector: map[string]string{
+									"bundle-declared": "wins",
+									"infra-only":      "true",
+								},
+								Tolerations: []corev1.Toleration{
+									{Key: "bundle-declared", Operator: corev1.TolerationOpExists},
+									{Key: "infra-only", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "rewrites container images and RELATED_IMAGE_* env vars with a configured image mirror",
+			bundle: &bundle.RegistryV1{
+				CSV: clusterserviceversion.Builder().
+					WithStrategyDeploymentSpecs(
+						v1alpha1.StrategyDeploymentSpec{
+							Name: "deployment-one",
+							Spec: appsv1.DeploymentSpec{
+								Template: corev1.PodTemplateSpec{
+									Spec: corev1.PodSpec{
+										InitContainers: []corev1.Container{
+											{Name: "init", Image: "quay.io/foo/init:v1"},
+										},
+										Containers: []corev1.Container{
+											{
+												Name:  "manager",
+												Image: "quay.io/foo/manager:v1",
+												Env: []corev1.EnvVar{
+													{Name: "RELATED_IMAGE_OPERAND", Value: "docker.io/foo/operand:v1"},
+													{Name: "NOT_AN_IMAGE", Value: "quay.io/foo/manager:v1"},
+												},
+											},
+											{Name: "unmirrored", Image: "other.example.com/foo/sidecar:v1"},
+										},
+									},
+								},
+							},
+						},
+					).Build(),
+			},
+			opts: render.Options{
+				InstallNamespace: "install-namespace",
+				ImageMirror: map[string]string{
+					"quay.io":   "mirror.example.com/quay.io",
+					"docker.io": "mirror.example.com/docker.io",
+				},
+			},
+			expectedResources: []client.Object{
+				&appsv1.Deployment{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Deployment",
+						APIVersion: appsv1.SchemeGroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "install-namespace",
+						Name:      "deployment-one",
+					},
+					Spec: appsv1.DeploymentSpec{
+						RevisionHistoryLimit: ptr.To(int32(1)),
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Annotations: map[string]string{
+									"olm.targetNamespaces": "",
+								},
+							},
+							Spec: corev1.PodSpec{
+								InitContainers: []corev1.Container{
+									{Name: "init", Image: "mirror.example.com/quay.io/foo/init:v1"},
+								},
+								Containers: []corev1.Container{
+									{
+										Name:  "manager",
+										Image: "mirror.example.com/quay.io/foo/manager:v1",
+										Env: []corev1.EnvVar{
+											{Name: "RELATED_IMAGE_OPERAND", Value: "mirror.example.com/docker.io/foo/operand:v1"},
+											{Name: "NOT_AN_IMAGE", Value: "quay.io/foo/manager:v1"},
+										},
+									},
+									{Name: "unmirrored", Image: "other.example.com/foo/sidecar:v1"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			objs, err := generators.BundleCSVDeploymentGenerator(tc.bundle, tc.opts)
@@ -1482,6 +1707,38 @@ func Test_BundleAdditionalResourcesGenerator_FailsOnNil(t *testing.T) {
 	require.Contains(t, err.Error(), "bundle cannot be nil")
 }
 
+func Test_BundleAdditionalResourcesGenerator_DoesNotMutateBundle(t *testing.T) {
+	opts := render.Options{
+		InstallNamespace: "install-namespace",
+	}
+
+	original := ToUnstructuredT(t,
+		&corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ConfigMap",
+				APIVersion: corev1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "bundled-config",
+			},
+			Data: map[string]string{"key": "value"},
+		},
+	)
+	bundle := &bundle.RegistryV1{
+		Others: []unstructured.Unstructured{*original},
+	}
+
+	objs, err := generators.BundleAdditionalResourcesGenerator(bundle, opts)
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+
+	objs[0].SetNamespace("some-other-namespace")
+	objs[0].SetLabels(map[string]string{"injected": "label"})
+
+	require.Equal(t, "", bundle.Others[0].GetNamespace(), "generator must not mutate the namespace of the bundle's own copy of the resource")
+	require.Nil(t, bundle.Others[0].GetLabels(), "generator must not mutate the labels of the bundle's own copy of the resource")
+}
+
 func Test_BundleValidatingWebhookResourceGenerator_Succeeds(t *testing.T) {
 	fakeProvider := FakeCertProvider{
 		InjectCABundleFn: func(obj client.Object, cfg render.CertificateProvisionerConfig) error {
@@ -2508,3 +2765,104 @@ func Test_CertProviderResourceGenerator_Succeeds(t *testing.T) {
 		}),
 	}, objs)
 }
+
+func Test_BundleNetworkPolicyGenerator_DisabledByDefault(t *testing.T) {
+	objs, err := generators.BundleNetworkPolicyGenerator(&bundle.RegistryV1{
+		CSV: clusterserviceversion.Builder().WithName("my-csv").Build(),
+	}, render.Options{
+		InstallNamespace: "install-namespace",
+	})
+	require.NoError(t, err)
+	require.Empty(t, objs)
+}
+
+func Test_BundleNetworkPolicyGenerator_Succeeds(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		bundle        *bundle.RegistryV1
+		expectedNames []string
+	}{
+		{
+			name: "generates default-deny and apiserver-egress policies when the bundle has no webhooks or metrics ports",
+			bundle: &bundle.RegistryV1{
+				CSV: clusterserviceversion.Builder().WithName("my-csv").Build(),
+			},
+			expectedNames: []string{
+				"my-csv-default-deny",
+				"my-csv-allow-apiserver-egress",
+			},
+		},
+		{
+			name: "generates a webhook-ingress policy when the bundle has webhook definitions",
+			bundle: &bundle.RegistryV1{
+				CSV: clusterserviceversion.Builder().
+					WithName("my-csv").
+					WithWebhookDefinitions(
+						v1alpha1.WebhookDescription{
+							Type:           v1alpha1.ValidatingAdmissionWebhook,
+							DeploymentName: "my-deployment",
+							ContainerPort:  443,
+						},
+					).Build(),
+			},
+			expectedNames: []string{
+				"my-csv-default-deny",
+				"my-csv-allow-apiserver-egress",
+				"my-csv-allow-webhook-ingress",
+			},
+		},
+		{
+			name: "generates a metrics-ingress policy when a deployment has a metrics-named container port",
+			bundle: &bundle.RegistryV1{
+				CSV: clusterserviceversion.Builder().
+					WithName("my-csv").
+					WithStrategyDeploymentSpecs(
+						v1alpha1.StrategyDeploymentSpec{
+							Name: "my-deployment",
+							Spec: appsv1.DeploymentSpec{
+								Template: corev1.PodTemplateSpec{
+									Spec: corev1.PodSpec{
+										Containers: []corev1.Container{
+											{
+												Ports: []corev1.ContainerPort{
+													{Name: "https-metrics", ContainerPort: 8443},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					).Build(),
+			},
+			expectedNames: []string{
+				"my-csv-default-deny",
+				"my-csv-allow-apiserver-egress",
+				"my-csv-allow-metrics-ingress",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			objs, err := generators.BundleNetworkPolicyGenerator(tc.bundle, render.Options{
+				InstallNamespace:      "install-namespace",
+				GenerateNetworkPolicy: true,
+			})
+			require.NoError(t, err)
+
+			var names []string
+			for _, obj := range objs {
+				require.IsType(t, &networkingv1.NetworkPolicy{}, obj)
+				require.Equal(t, "install-namespace", obj.GetNamespace())
+				names = append(names, obj.GetName())
+			}
+			require.Equal(t, tc.expectedNames, names)
+		})
+	}
+}
+
+func Test_BundleNetworkPolicyGenerator_FailsOnNil(t *testing.T) {
+	objs, err := generators.BundleNetworkPolicyGenerator(nil, render.Options{GenerateNetworkPolicy: true})
+	require.Nil(t, objs)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "bundle cannot be nil")
+}
@@ -4,6 +4,7 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -95,6 +96,16 @@ func WithServiceSpec(serviceSpec corev1.ServiceSpec) func(client.Object) {
 	}
 }
 
+// WithNetworkPolicySpec applies a NetworkPolicySpec to a NetworkPolicy resource
+func WithNetworkPolicySpec(spec networkingv1.NetworkPolicySpec) func(client.Object) {
+	return func(obj client.Object) {
+		switch o := obj.(type) {
+		case *networkingv1.NetworkPolicy:
+			o.Spec = spec
+		}
+	}
+}
+
 // WithValidatingWebhooks applies validating webhooks to a ValidatingWebhookConfiguration resource
 func WithValidatingWebhooks(webhooks ...admissionregistrationv1.ValidatingWebhook) func(client.Object) {
 	return func(obj client.Object) {
@@ -262,3 +273,18 @@ func CreateServiceResource(name string, namespace string, opts ...ResourceCreato
 		},
 	}).(*corev1.Service)
 }
+
+// CreateNetworkPolicyResource creates a NetworkPolicy resource with name 'name', namespace 'namespace', and applying
+// any NetworkPolicy related options in opts
+func CreateNetworkPolicyResource(name string, namespace string, opts ...ResourceCreatorOption) *networkingv1.NetworkPolicy {
+	return ResourceCreatorOptions(opts).ApplyTo(&networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "NetworkPolicy",
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}).(*networkingv1.NetworkPolicy)
+}
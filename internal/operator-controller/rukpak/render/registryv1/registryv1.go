@@ -47,4 +47,5 @@ var ResourceGenerators = []render.ResourceGenerator{
 	generators.BundleMutatingWebhookResourceGenerator,
 	generators.BundleDeploymentServiceResourceGenerator,
 	generators.CertProviderResourceGenerator,
+	generators.BundleNetworkPolicyGenerator,
 }
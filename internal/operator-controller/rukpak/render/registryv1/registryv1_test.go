@@ -55,6 +55,7 @@ func Test_ResourceGeneratorsHasAllGenerators(t *testing.T) {
 		generators.BundleMutatingWebhookResourceGenerator,
 		generators.BundleDeploymentServiceResourceGenerator,
 		generators.CertProviderResourceGenerator,
+		generators.BundleNetworkPolicyGenerator,
 	}
 	actualGenerators := registryv1.ResourceGenerators
 
@@ -3,6 +3,8 @@ package render
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -58,10 +60,16 @@ func (r ResourceGenerators) ResourceGenerator() ResourceGenerator {
 type UniqueNameGenerator func(string, interface{}) string
 
 type Options struct {
-	InstallNamespace    string
-	TargetNamespaces    []string
-	UniqueNameGenerator UniqueNameGenerator
-	CertificateProvider CertificateProvider
+	InstallNamespace         string
+	TargetNamespaces         []string
+	UniqueNameGenerator      UniqueNameGenerator
+	CertificateProvider      CertificateProvider
+	GenerateNetworkPolicy    bool
+	TrustedCABundleConfigMap string
+	ProxyEnv                 []corev1.EnvVar
+	DefaultNodeSelector      map[string]string
+	DefaultTolerations       []corev1.Toleration
+	ImageMirror              map[string]string
 }
 
 func (o *Options) apply(opts ...Option) *Options {
@@ -109,6 +117,65 @@ func WithCertificateProvider(provider CertificateProvider) Option {
 	}
 }
 
+// WithNetworkPolicy enables generation of default-deny and required-allow
+// NetworkPolicies for the bundle's workloads.
+func WithNetworkPolicy(enabled bool) Option {
+	return func(o *Options) {
+		o.GenerateNetworkPolicy = enabled
+	}
+}
+
+// WithTrustedCABundleConfigMap arranges for every rendered Deployment to mount the named
+// ConfigMap's ca-bundle.crt key as the cluster's trusted CA bundle, the same way OpenShift
+// projects its cluster-wide trust bundle into OLMv0-managed operator Deployments. The ConfigMap
+// must already exist in the bundle's install namespace; an empty name is a no-op.
+func WithTrustedCABundleConfigMap(name string) Option {
+	return func(o *Options) {
+		o.TrustedCABundleConfigMap = name
+	}
+}
+
+// WithProxyEnv appends the given environment variables to every container of every rendered
+// Deployment, the same way OLMv0 propagates its own HTTP_PROXY/HTTPS_PROXY/NO_PROXY into CSV
+// deployments so operators behind a MITM proxy inherit it automatically. A container that
+// already defines one of these variables keeps its own value.
+func WithProxyEnv(env []corev1.EnvVar) Option {
+	return func(o *Options) {
+		o.ProxyEnv = env
+	}
+}
+
+// WithDefaultNodeSelector sets the node selector entries applied to every rendered Deployment's
+// Pod template, so a platform team can steer every operator onto infra nodes in one place. A key
+// the bundle's own Deployment spec already sets keeps the bundle's value.
+func WithDefaultNodeSelector(nodeSelector map[string]string) Option {
+	return func(o *Options) {
+		o.DefaultNodeSelector = nodeSelector
+	}
+}
+
+// WithDefaultTolerations sets the tolerations applied to every rendered Deployment's Pod
+// template, so a platform team can steer every operator onto infra nodes in one place. A
+// toleration the bundle's own Deployment spec already sets is not duplicated.
+func WithDefaultTolerations(tolerations []corev1.Toleration) Option {
+	return func(o *Options) {
+		o.DefaultTolerations = tolerations
+	}
+}
+
+// WithImageMirror rewrites every rendered Deployment container image, and every RELATED_IMAGE_*
+// environment variable value, whose registry host matches a key in mirror to the corresponding
+// value instead, so an operator installed from a mirrored bundle also runs with its operand images
+// pulled from the mirror. mirror maps a source registry host (e.g. "quay.io") to the mirror
+// location images from it were pushed under (e.g. "mirror.example.com/quay.io"), the same mapping
+// WriteMirrorRegistriesConf records. An image whose registry host isn't a key in mirror is left
+// unchanged.
+func WithImageMirror(mirror map[string]string) Option {
+	return func(o *Options) {
+		o.ImageMirror = mirror
+	}
+}
+
 type BundleRenderer struct {
 	BundleValidator    BundleValidator
 	ResourceGenerators []ResourceGenerator
@@ -138,9 +205,73 @@ func (r BundleRenderer) Render(rv1 bundle.RegistryV1, installNamespace string, o
 		return nil, err
 	}
 
+	if err := validateNamespaceContainment(objs, genOpts.InstallNamespace, genOpts.TargetNamespaces); err != nil {
+		return nil, err
+	}
+
 	return objs, nil
 }
 
+// clusterScopedKinds is the set of Kinds that are always cluster-scoped, regardless of which
+// generator rendered them or whether they're typed Go objects or *unstructured.Unstructured (e.g.
+// a CertificateProvider's AdditionalObjects). validateNamespaceContainment looks a rendered
+// object's Kind up here instead of switching on its concrete Go type, so a cluster-scoped object
+// rendered as unstructured isn't missed just because it has no namespace set to flag it by.
+//
+// CustomResourceDefinition is deliberately absent: CRDs are cluster-scoped by necessity for any
+// bundle that owns one, regardless of install mode, so their presence doesn't indicate an install
+// reaching outside the target namespace(s).
+var clusterScopedKinds = sets.New(
+	"ClusterRole",
+	"ClusterRoleBinding",
+	"ValidatingWebhookConfiguration",
+	"MutatingWebhookConfiguration",
+	"APIService",
+	"PriorityClass",
+	"StorageClass",
+	"ClusterIssuer",
+	"Namespace",
+	"PersistentVolume",
+)
+
+// validateNamespaceContainment checks that, for a namespace-scoped install (i.e. one that isn't
+// targeting all namespaces), none of the rendered objects are cluster-scoped or reference a
+// namespace other than installNamespace. A bundle that supports OwnNamespace or SingleNamespace
+// install modes but also declares clusterPermissions or webhook definitions in its CSV would
+// otherwise render cluster-scoped resources even when a namespace admin installs it expecting
+// their install to stay contained to their own namespace(s).
+func validateNamespaceContainment(objs []client.Object, installNamespace string, targetNamespaces []string) error {
+	if len(targetNamespaces) == 1 && targetNamespaces[0] == corev1.NamespaceAll {
+		return nil
+	}
+
+	var offending []string
+	for _, obj := range objs {
+		switch {
+		case clusterScopedKinds.Has(obj.GetObjectKind().GroupVersionKind().Kind):
+			offending = append(offending, describeObject(obj))
+		case obj.GetNamespace() != "" && obj.GetNamespace() != installNamespace:
+			offending = append(offending, describeObject(obj))
+		}
+	}
+	if len(offending) == 0 {
+		return nil
+	}
+	slices.Sort(offending)
+	return fmt.Errorf("bundle is not namespace-contained: install namespace %q does not support cluster-scoped or cross-namespace resource(s) %s", installNamespace, strings.Join(offending, ", "))
+}
+
+func describeObject(obj client.Object) string {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if kind == "" {
+		kind = fmt.Sprintf("%T", obj)
+	}
+	if ns := obj.GetNamespace(); ns != "" {
+		return fmt.Sprintf("%s %s/%s", kind, ns, obj.GetName())
+	}
+	return fmt.Sprintf("%s %s", kind, obj.GetName())
+}
+
 func DefaultUniqueNameGenerator(base string, o interface{}) string {
 	hashStr := hashutil.DeepHashObject(o)
 	return util.ObjectNameForBaseAndSuffix(base, hashStr)
@@ -9,6 +9,9 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -367,6 +370,90 @@ func Test_BundleRenderer_ReturnsResourceGeneratorErrors(t *testing.T) {
 	require.Contains(t, err.Error(), "generator error")
 }
 
+func Test_BundleRenderer_RejectsClusterScopedResourcesForNamespaceScopedInstall(t *testing.T) {
+	renderer := render.BundleRenderer{
+		ResourceGenerators: []render.ResourceGenerator{
+			func(rv1 *bundle.RegistryV1, opts render.Options) ([]client.Object, error) {
+				return []client.Object{&rbacv1.ClusterRole{
+					TypeMeta:   metav1.TypeMeta{Kind: "ClusterRole"},
+					ObjectMeta: metav1.ObjectMeta{Name: "my-cluster-role"},
+				}}, nil
+			},
+		},
+	}
+	objs, err := renderer.Render(
+		bundle.RegistryV1{
+			CSV: clusterserviceversion.Builder().WithInstallModeSupportFor(v1alpha1.InstallModeTypeOwnNamespace).Build(),
+		}, "install-namespace", render.WithTargetNamespaces("install-namespace"))
+	require.Nil(t, objs)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not namespace-contained")
+	require.Contains(t, err.Error(), "ClusterRole my-cluster-role")
+}
+
+func Test_BundleRenderer_RejectsCrossNamespaceResourcesForNamespaceScopedInstall(t *testing.T) {
+	renderer := render.BundleRenderer{
+		ResourceGenerators: []render.ResourceGenerator{
+			func(rv1 *bundle.RegistryV1, opts render.Options) ([]client.Object, error) {
+				return []client.Object{&corev1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap"},
+					ObjectMeta: metav1.ObjectMeta{Name: "my-config", Namespace: "some-other-namespace"},
+				}}, nil
+			},
+		},
+	}
+	objs, err := renderer.Render(
+		bundle.RegistryV1{
+			CSV: clusterserviceversion.Builder().WithInstallModeSupportFor(v1alpha1.InstallModeTypeOwnNamespace).Build(),
+		}, "install-namespace", render.WithTargetNamespaces("install-namespace"))
+	require.Nil(t, objs)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not namespace-contained")
+	require.Contains(t, err.Error(), "ConfigMap some-other-namespace/my-config")
+}
+
+func Test_BundleRenderer_RejectsUnstructuredClusterScopedResourcesForNamespaceScopedInstall(t *testing.T) {
+	clusterIssuer := &unstructured.Unstructured{}
+	clusterIssuer.SetAPIVersion("cert-manager.io/v1")
+	clusterIssuer.SetKind("ClusterIssuer")
+	clusterIssuer.SetName("my-cluster-issuer")
+
+	renderer := render.BundleRenderer{
+		ResourceGenerators: []render.ResourceGenerator{
+			func(rv1 *bundle.RegistryV1, opts render.Options) ([]client.Object, error) {
+				return []client.Object{clusterIssuer}, nil
+			},
+		},
+	}
+	objs, err := renderer.Render(
+		bundle.RegistryV1{
+			CSV: clusterserviceversion.Builder().WithInstallModeSupportFor(v1alpha1.InstallModeTypeOwnNamespace).Build(),
+		}, "install-namespace", render.WithTargetNamespaces("install-namespace"))
+	require.Nil(t, objs)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not namespace-contained")
+	require.Contains(t, err.Error(), "ClusterIssuer my-cluster-issuer")
+}
+
+func Test_BundleRenderer_AllowsClusterScopedResourcesForAllNamespacesInstall(t *testing.T) {
+	renderer := render.BundleRenderer{
+		ResourceGenerators: []render.ResourceGenerator{
+			func(rv1 *bundle.RegistryV1, opts render.Options) ([]client.Object, error) {
+				return []client.Object{&rbacv1.ClusterRole{
+					TypeMeta:   metav1.TypeMeta{Kind: "ClusterRole"},
+					ObjectMeta: metav1.ObjectMeta{Name: "my-cluster-role"},
+				}}, nil
+			},
+		},
+	}
+	objs, err := renderer.Render(
+		bundle.RegistryV1{
+			CSV: clusterserviceversion.Builder().WithInstallModeSupportFor(v1alpha1.InstallModeTypeAllNamespaces).Build(),
+		}, "install-namespace")
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+}
+
 func Test_BundleValidatorCallsAllValidationFnsInOrder(t *testing.T) {
 	actual := ""
 	val := render.BundleValidator{
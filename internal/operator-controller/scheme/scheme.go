@@ -1,6 +1,7 @@
 package scheme
 
 import (
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -8,6 +9,7 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 
 	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	ocv1alpha2 "github.com/operator-framework/operator-controller/api/v1alpha2"
 )
 
 var Scheme = runtime.NewScheme()
@@ -15,8 +17,13 @@ var Scheme = runtime.NewScheme()
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(Scheme))
 	utilruntime.Must(ocv1.AddToScheme(Scheme))
+	utilruntime.Must(ocv1alpha2.AddToScheme(Scheme))
 	utilruntime.Must(ocv1.AddToScheme(Scheme))
 	utilruntime.Must(appsv1.AddToScheme(Scheme))
 	utilruntime.Must(corev1.AddToScheme(Scheme))
+	// OLMv0's Subscription type, so CheckOLMv0PackageConflict can detect when a package is
+	// already managed by an OLMv0 Subscription. Only registered for read access; this
+	// controller never writes OLMv0 types.
+	utilruntime.Must(v1alpha1.AddToScheme(Scheme))
 	//+kubebuilder:scaffold:scheme
 }
@@ -0,0 +1,101 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing instruments the ClusterExtension reconcile pipeline with OpenTelemetry spans,
+// so a slow reconcile's resolve/unpack/render/preflight/apply stages can be attributed to the
+// right stage, and correlated with catalogd's own spans, instead of only showing up as a single
+// opaque reconcile duration.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// clusterExtensionNameKey is the span attribute key carrying the name of the ClusterExtension a
+// stage's span belongs to. There's no semconv attribute for a ClusterExtension (it's not a
+// built-in Kubernetes resource kind), so this is our own, namespaced like the rest of OLM's
+// annotations and labels.
+const clusterExtensionNameKey = attribute.Key("olm.operatorframework.io/cluster-extension-name")
+
+// TracerName identifies the tracer used for every span this package's callers start, so they show
+// up grouped by instrumentation library in a trace backend.
+const TracerName = "github.com/operator-framework/operator-controller"
+
+// NewTracerProvider returns a TracerProvider that exports spans to otlpEndpoint over OTLP/gRPC,
+// and registers it (along with a W3C trace-context propagator) as the global OpenTelemetry
+// provider, so every otel.Tracer(TracerName) call across the process uses it. If otlpEndpoint is
+// empty, it returns nil without registering anything, leaving the no-op global provider in place:
+// span-starting code pays only the (negligible) cost of a no-op span.
+//
+// Callers are responsible for calling Shutdown on the returned provider before the process exits,
+// to flush any spans still buffered in the batch exporter.
+func NewTracerProvider(ctx context.Context, otlpEndpoint, serviceName string) (*sdktrace.TracerProvider, error) {
+	if otlpEndpoint == "" {
+		return nil, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(otlpEndpoint), otlptracegrpc.WithInsecure())
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP trace exporter for endpoint %q: %w", otlpEndpoint, err)
+	}
+
+	res := resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return tp, nil
+}
+
+// StartStage starts a span named stage under the global TracerProvider, for a single stage
+// (e.g. "resolve", "unpack", "render", "preflight", "apply") of a ClusterExtension reconcile.
+// Callers are expected to end the span the same way every other stage does:
+//
+//	ctx, span := tracing.StartStage(ctx, "resolve", ext.Name)
+//	defer span.End()
+//	...
+//	if err != nil {
+//		tracing.RecordError(span, err)
+//	}
+func StartStage(ctx context.Context, stage, clusterExtensionName string) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, stage, trace.WithAttributes(
+		clusterExtensionNameKey.String(clusterExtensionName),
+	))
+}
+
+// RecordError marks span as failed with err, following the same pattern every stage uses to
+// report its error to the trace backend without also having to import otel/codes.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
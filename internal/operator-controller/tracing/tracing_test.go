@@ -0,0 +1,72 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// recordingExporter collects every span exported to it, so tests can assert on what StartStage
+// and RecordError actually produced without standing up a real OTLP collector.
+type recordingExporter struct {
+	mu    sync.Mutex
+	spans []sdktrace.ReadOnlySpan
+}
+
+func (e *recordingExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *recordingExporter) Shutdown(context.Context) error { return nil }
+
+func TestStartStageAndRecordError(t *testing.T) {
+	exporter := &recordingExporter{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	prevTP := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prevTP) })
+
+	_, span := StartStage(context.Background(), "resolve", "my-extension")
+	RecordError(span, errors.New("boom"))
+	span.End()
+
+	require.Len(t, exporter.spans, 1)
+	got := exporter.spans[0]
+	assert.Equal(t, "resolve", got.Name())
+	assert.Equal(t, codes.Error, got.Status().Code)
+	require.Len(t, got.Attributes(), 1)
+	assert.Equal(t, clusterExtensionNameKey, got.Attributes()[0].Key)
+	assert.Equal(t, "my-extension", got.Attributes()[0].Value.AsString())
+}
+
+func TestRecordErrorNilErrorIsNoop(t *testing.T) {
+	exporter := &recordingExporter{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(TracerName).Start(context.Background(), "unpack")
+	RecordError(span, nil)
+	span.End()
+
+	require.Len(t, exporter.spans, 1)
+	assert.Equal(t, codes.Unset, exporter.spans[0].Status().Code)
+}
+
+func TestNewTracerProviderDisabledWhenEndpointEmpty(t *testing.T) {
+	tp, err := NewTracerProvider(context.Background(), "", "operator-controller")
+	require.NoError(t, err)
+	assert.Nil(t, tp)
+}
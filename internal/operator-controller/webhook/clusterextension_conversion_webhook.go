@@ -0,0 +1,19 @@
+package webhook
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocv1alpha2 "github.com/operator-framework/operator-controller/api/v1alpha2"
+)
+
+// SetupClusterExtensionConversionWebhook registers the /convert endpoint that lets the API server
+// translate ClusterExtension objects between api/v1 (the storage version) and api/v1alpha2, so that
+// clients reading or writing either version see a ClusterExtension converted to the version they
+// asked for. It has no defaulting or validation logic of its own: ocv1alpha2.ClusterExtension's
+// ConvertTo/ConvertFrom methods do the actual conversion, and ClusterExtension (this package) still
+// owns defaulting and validation against the storage version.
+func SetupClusterExtensionConversionWebhook(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&ocv1alpha2.ClusterExtension{}).
+		Complete()
+}
@@ -0,0 +1,223 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/catalogmetadata/compare"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/features"
+)
+
+// ClusterExtension wraps the external v1.ClusterExtension type and implements
+// admission.CustomDefaulter and admission.CustomValidator.
+//
+// The defaulting and validation performed here mostly duplicate what the CEL rules and
+// +kubebuilder:default markers already declared on the ClusterExtension CRD enforce. Those
+// rules are applied by the API server on every write, so this webhook is not required for
+// correctness. It exists so that: (a) fields with a documented default that isn't expressed as
+// a CRD default get persisted onto the object instead of only being applied implicitly at
+// reconcile time, and (b) malformed requests are rejected synchronously, with a single
+// consolidated error message, instead of the request succeeding admission and the problem only
+// surfacing later as a status condition once the controller attempts to resolve or install it.
+type ClusterExtension struct {
+	// Client is used to look up the labels of a ClusterExtension's install
+	// namespace when InstallNamespaceLabelSelector is set. It's nil-able: if
+	// InstallNamespaceLabelSelector is also nil, no lookups are performed.
+	Client client.Reader
+
+	// InstallNamespaceLabelSelector, when set, restricts spec.namespace to
+	// namespaces whose labels match it. This lets cluster admins reserve
+	// some namespaces (e.g. kube-system, or namespaces belonging to another
+	// tenant) so that they can't be targeted as an install namespace.
+	InstallNamespaceLabelSelector k8slabels.Selector
+}
+
+// Default applies defaulting to a ClusterExtension on create and update.
+func (v *ClusterExtension) Default(ctx context.Context, obj runtime.Object) error {
+	ext, ok := obj.(*ocv1.ClusterExtension)
+	if !ok {
+		return fmt.Errorf("expected a ClusterExtension but got a %T", obj)
+	}
+
+	if catalog := ext.Spec.Source.Catalog; catalog != nil {
+		catalog.Version = strings.TrimSpace(catalog.Version)
+		if catalog.UpgradeConstraintPolicy == "" {
+			catalog.UpgradeConstraintPolicy = ocv1.UpgradeConstraintPolicyCatalogProvided
+		}
+	}
+
+	if install := ext.Spec.Install; install != nil {
+		if install.PruneBehavior == "" {
+			install.PruneBehavior = ocv1.PruneBehaviorDelete
+		}
+		if install.AdoptionPolicy == "" {
+			install.AdoptionPolicy = ocv1.AdoptionPolicyCreateOnly
+		}
+		if install.CreateNamespace != nil && install.CreateNamespace.DeletionPolicy == "" {
+			install.CreateNamespace.DeletionPolicy = ocv1.NamespaceDeletionPolicyDelete
+		}
+	}
+
+	return nil
+}
+
+// ValidateCreate validates a ClusterExtension on creation.
+func (v *ClusterExtension) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	ext, ok := obj.(*ocv1.ClusterExtension)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterExtension but got a %T", obj)
+	}
+	if err := validateClusterExtension(ext); err != nil {
+		return nil, err
+	}
+	if err := v.validatePackageSingleton(ctx, ext); err != nil {
+		return nil, err
+	}
+	return nil, v.validateInstallNamespace(ctx, ext)
+}
+
+// ValidateUpdate validates a ClusterExtension on update.
+func (v *ClusterExtension) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	log := log.FromContext(ctx)
+	oldExt, ok := oldObj.(*ocv1.ClusterExtension)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterExtension but got a %T", oldObj)
+	}
+	newExt, ok := newObj.(*ocv1.ClusterExtension)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterExtension but got a %T", newObj)
+	}
+	log.V(1).Info("validating ClusterExtension update", "name", newExt.Name)
+
+	if err := validateClusterExtension(newExt); err != nil {
+		return nil, err
+	}
+	if err := v.validatePackageSingleton(ctx, newExt); err != nil {
+		return nil, err
+	}
+	if oldExt.Spec.Namespace != newExt.Spec.Namespace {
+		return nil, fmt.Errorf("spec.namespace is immutable: to move to a different namespace, delete and recreate this ClusterExtension")
+	}
+	if oldExt.Spec.ServiceAccount.Name != newExt.Spec.ServiceAccount.Name {
+		return nil, fmt.Errorf("spec.serviceAccount.name is immutable: to use a different ServiceAccount, delete and recreate this ClusterExtension")
+	}
+	if oldExt.Spec.Namespace == newExt.Spec.Namespace {
+		return nil, nil
+	}
+	return nil, v.validateInstallNamespace(ctx, newExt)
+}
+
+// validateInstallNamespace rejects a ClusterExtension whose install namespace doesn't
+// match InstallNamespaceLabelSelector. If the namespace doesn't exist yet (e.g. it's
+// about to be created via spec.install.createNamespace), the check is skipped: there
+// are no labels to evaluate, and the reconciler enforces the same policy once the
+// namespace exists.
+func (v *ClusterExtension) validateInstallNamespace(ctx context.Context, ext *ocv1.ClusterExtension) error {
+	if v.InstallNamespaceLabelSelector == nil {
+		return nil
+	}
+
+	ns := &corev1.Namespace{}
+	if err := v.Client.Get(ctx, client.ObjectKey{Name: ext.Spec.Namespace}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("checking install namespace %q: %w", ext.Spec.Namespace, err)
+	}
+
+	if !v.InstallNamespaceLabelSelector.Matches(k8slabels.Set(ns.Labels)) {
+		return fmt.Errorf("namespace %q is not permitted as an install namespace: it does not match the required label selector %q", ext.Spec.Namespace, v.InstallNamespaceLabelSelector.String())
+	}
+	return nil
+}
+
+// validatePackageSingleton rejects a ClusterExtension that would install a catalog package
+// already installed by a different ClusterExtension, when the PackageSingletonPreflight feature
+// gate is enabled. A conflict with a given other ClusterExtension is suppressed only when BOTH
+// ext and that other ClusterExtension set spec.install.allowMultiplePerPackage: true - either one
+// opting out is enough to keep the guard active for that pair.
+//
+// This is a best-effort check: concurrent creates can race past it, since admission isn't
+// transactional across objects. The reconciler's CheckPackageSingleton step is authoritative and
+// catches whatever slips through here.
+func (v *ClusterExtension) validatePackageSingleton(ctx context.Context, ext *ocv1.ClusterExtension) error {
+	if !features.OperatorControllerFeatureGate.Enabled(features.PackageSingletonPreflight) {
+		return nil
+	}
+	packageName := getPackageName(ext)
+	if packageName == "" {
+		return nil
+	}
+	extAllowsMultiple := ext.Spec.Install != nil && ext.Spec.Install.AllowMultiplePerPackage
+
+	var exts ocv1.ClusterExtensionList
+	if err := v.Client.List(ctx, &exts); err != nil {
+		return fmt.Errorf("checking for other ClusterExtensions installing package %q: %w", packageName, err)
+	}
+
+	var owners []string
+	for _, other := range exts.Items {
+		if other.Name == ext.Name || getPackageName(&other) != packageName {
+			continue
+		}
+		otherAllowsMultiple := other.Spec.Install != nil && other.Spec.Install.AllowMultiplePerPackage
+		if extAllowsMultiple && otherAllowsMultiple {
+			continue
+		}
+		owners = append(owners, other.Name)
+	}
+	if len(owners) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("package %q is already installed by ClusterExtension(s) %s; set spec.install.allowMultiplePerPackage on both to scope each install to a distinct watch namespace instead",
+		packageName, strings.Join(owners, ", "))
+}
+
+func getPackageName(ext *ocv1.ClusterExtension) string {
+	if ext.Spec.Source.Catalog == nil {
+		return ""
+	}
+	return ext.Spec.Source.Catalog.PackageName
+}
+
+// ValidateDelete validates a ClusterExtension on deletion. There is nothing to validate.
+func (v *ClusterExtension) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// SetupWebhookWithManager sets up the webhook with the manager.
+func (v *ClusterExtension) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&ocv1.ClusterExtension{}).
+		WithDefaulter(v).
+		WithValidator(v).
+		Complete()
+}
+
+func validateClusterExtension(ext *ocv1.ClusterExtension) error {
+	if catalog := ext.Spec.Source.Catalog; catalog != nil && catalog.Version != "" {
+		if _, err := compare.NewVersionRange(catalog.Version); err != nil {
+			return fmt.Errorf("spec.source.catalog.version %q is invalid: %w", catalog.Version, err)
+		}
+	}
+
+	hasServiceAccount := ext.Spec.ServiceAccount.Name != ""
+	hasImpersonate := ext.Spec.Install != nil && ext.Spec.Install.Impersonate != nil
+	if hasServiceAccount == hasImpersonate {
+		return fmt.Errorf("exactly one of spec.serviceAccount.name, spec.install.impersonate is required")
+	}
+
+	return nil
+}
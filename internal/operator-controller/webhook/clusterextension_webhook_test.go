@@ -0,0 +1,348 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/features"
+)
+
+// NotClusterExtension implements runtime.Object but isn't a ClusterExtension
+type NotClusterExtension struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+}
+
+func (n *NotClusterExtension) DeepCopyObject() runtime.Object {
+	return &NotClusterExtension{}
+}
+
+func validClusterExtension() *ocv1.ClusterExtension {
+	return &ocv1.ClusterExtension{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-extension"},
+		Spec: ocv1.ClusterExtensionSpec{
+			Namespace: "test-namespace",
+			ServiceAccount: ocv1.ServiceAccountReference{
+				Name: "test-installer",
+			},
+			Source: ocv1.SourceConfig{
+				SourceType: "Catalog",
+				Catalog: &ocv1.CatalogFilter{
+					PackageName: "test-package",
+					Version:     ">=1.0.0 <2.0.0",
+				},
+			},
+		},
+	}
+}
+
+func TestClusterExtensionDefault(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ext          runtime.Object
+		expectErr    bool
+		errorMessage string
+		assert       func(t *testing.T, ext *ocv1.ClusterExtension)
+	}{
+		"defaults upgradeConstraintPolicy and trims whitespace from version": {
+			ext: func() *ocv1.ClusterExtension {
+				ext := validClusterExtension()
+				ext.Spec.Source.Catalog.Version = "  >=1.0.0 <2.0.0  "
+				return ext
+			}(),
+			assert: func(t *testing.T, ext *ocv1.ClusterExtension) {
+				assert.Equal(t, ocv1.UpgradeConstraintPolicyCatalogProvided, ext.Spec.Source.Catalog.UpgradeConstraintPolicy)
+				assert.Equal(t, ">=1.0.0 <2.0.0", ext.Spec.Source.Catalog.Version)
+			},
+		},
+		"leaves an explicit upgradeConstraintPolicy untouched": {
+			ext: func() *ocv1.ClusterExtension {
+				ext := validClusterExtension()
+				ext.Spec.Source.Catalog.UpgradeConstraintPolicy = ocv1.UpgradeConstraintPolicySelfCertified
+				return ext
+			}(),
+			assert: func(t *testing.T, ext *ocv1.ClusterExtension) {
+				assert.Equal(t, ocv1.UpgradeConstraintPolicySelfCertified, ext.Spec.Source.Catalog.UpgradeConstraintPolicy)
+			},
+		},
+		"defaults install pruneBehavior, adoptionPolicy, and createNamespace.deletionPolicy": {
+			ext: func() *ocv1.ClusterExtension {
+				ext := validClusterExtension()
+				ext.Spec.Install = &ocv1.ClusterExtensionInstallConfig{
+					CreateNamespace: &ocv1.CreateNamespaceConfig{},
+				}
+				return ext
+			}(),
+			assert: func(t *testing.T, ext *ocv1.ClusterExtension) {
+				assert.Equal(t, ocv1.PruneBehaviorDelete, ext.Spec.Install.PruneBehavior)
+				assert.Equal(t, ocv1.AdoptionPolicyCreateOnly, ext.Spec.Install.AdoptionPolicy)
+				assert.Equal(t, ocv1.NamespaceDeletionPolicyDelete, ext.Spec.Install.CreateNamespace.DeletionPolicy)
+			},
+		},
+		"leaves explicit install options untouched": {
+			ext: func() *ocv1.ClusterExtension {
+				ext := validClusterExtension()
+				ext.Spec.Install = &ocv1.ClusterExtensionInstallConfig{
+					PruneBehavior:   ocv1.PruneBehaviorOrphan,
+					AdoptionPolicy:  ocv1.AdoptionPolicyAdoptOLMV0,
+					CreateNamespace: &ocv1.CreateNamespaceConfig{DeletionPolicy: ocv1.NamespaceDeletionPolicyKeep},
+				}
+				return ext
+			}(),
+			assert: func(t *testing.T, ext *ocv1.ClusterExtension) {
+				assert.Equal(t, ocv1.PruneBehaviorOrphan, ext.Spec.Install.PruneBehavior)
+				assert.Equal(t, ocv1.AdoptionPolicyAdoptOLMV0, ext.Spec.Install.AdoptionPolicy)
+				assert.Equal(t, ocv1.NamespaceDeletionPolicyKeep, ext.Spec.Install.CreateNamespace.DeletionPolicy)
+			},
+		},
+		"invalid object type": {
+			ext:          &NotClusterExtension{},
+			expectErr:    true,
+			errorMessage: "expected a ClusterExtension but got a *webhook.NotClusterExtension",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			v := &ClusterExtension{}
+			err := v.Default(context.TODO(), tc.ext)
+			if tc.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMessage)
+			} else {
+				assert.NoError(t, err)
+				tc.assert(t, tc.ext.(*ocv1.ClusterExtension))
+			}
+		})
+	}
+}
+
+func TestClusterExtensionValidateCreate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ext          runtime.Object
+		expectErr    bool
+		errorMessage string
+	}{
+		"valid ClusterExtension": {
+			ext: validClusterExtension(),
+		},
+		"invalid version range": {
+			ext: func() *ocv1.ClusterExtension {
+				ext := validClusterExtension()
+				ext.Spec.Source.Catalog.Version = "not-a-version-range"
+				return ext
+			}(),
+			expectErr:    true,
+			errorMessage: "spec.source.catalog.version",
+		},
+		"neither serviceAccount nor impersonate set": {
+			ext: func() *ocv1.ClusterExtension {
+				ext := validClusterExtension()
+				ext.Spec.ServiceAccount.Name = ""
+				return ext
+			}(),
+			expectErr:    true,
+			errorMessage: "exactly one of spec.serviceAccount.name, spec.install.impersonate is required",
+		},
+		"both serviceAccount and impersonate set": {
+			ext: func() *ocv1.ClusterExtension {
+				ext := validClusterExtension()
+				ext.Spec.Install = &ocv1.ClusterExtensionInstallConfig{
+					Impersonate: &ocv1.ImpersonationConfig{Username: "test-user"},
+				}
+				return ext
+			}(),
+			expectErr:    true,
+			errorMessage: "exactly one of spec.serviceAccount.name, spec.install.impersonate is required",
+		},
+		"impersonate set without serviceAccount": {
+			ext: func() *ocv1.ClusterExtension {
+				ext := validClusterExtension()
+				ext.Spec.ServiceAccount.Name = ""
+				ext.Spec.Install = &ocv1.ClusterExtensionInstallConfig{
+					Impersonate: &ocv1.ImpersonationConfig{Username: "test-user"},
+				}
+				return ext
+			}(),
+		},
+		"invalid object type": {
+			ext:          &NotClusterExtension{},
+			expectErr:    true,
+			errorMessage: "expected a ClusterExtension but got a *webhook.NotClusterExtension",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			v := &ClusterExtension{}
+			_, err := v.ValidateCreate(context.TODO(), tc.ext)
+			if tc.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMessage)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClusterExtensionValidateUpdate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		oldExt       *ocv1.ClusterExtension
+		newExt       *ocv1.ClusterExtension
+		expectErr    bool
+		errorMessage string
+	}{
+		"no changes to immutable fields": {
+			oldExt: validClusterExtension(),
+			newExt: validClusterExtension(),
+		},
+		"namespace changed": {
+			oldExt: validClusterExtension(),
+			newExt: func() *ocv1.ClusterExtension {
+				ext := validClusterExtension()
+				ext.Spec.Namespace = "other-namespace"
+				return ext
+			}(),
+			expectErr:    true,
+			errorMessage: "spec.namespace is immutable",
+		},
+		"serviceAccount name changed": {
+			oldExt: validClusterExtension(),
+			newExt: func() *ocv1.ClusterExtension {
+				ext := validClusterExtension()
+				ext.Spec.ServiceAccount.Name = "other-installer"
+				return ext
+			}(),
+			expectErr:    true,
+			errorMessage: "spec.serviceAccount.name is immutable",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			v := &ClusterExtension{}
+			_, err := v.ValidateUpdate(context.TODO(), tc.oldExt, tc.newExt)
+			if tc.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMessage)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClusterExtensionValidateDelete(t *testing.T) {
+	v := &ClusterExtension{}
+	_, err := v.ValidateDelete(context.TODO(), validClusterExtension())
+	assert.NoError(t, err)
+}
+
+func TestClusterExtensionValidatePackageSingleton(t *testing.T) {
+	require.NoError(t, features.OperatorControllerFeatureGate.Set(fmt.Sprintf("%s=true", features.PackageSingletonPreflight)))
+	t.Cleanup(func() {
+		require.NoError(t, features.OperatorControllerFeatureGate.Set(fmt.Sprintf("%s=false", features.PackageSingletonPreflight)))
+	})
+
+	otherOwner := func(allowMultiple bool) *ocv1.ClusterExtension {
+		other := validClusterExtension()
+		other.Name = "other-extension"
+		other.Spec.Install = &ocv1.ClusterExtensionInstallConfig{AllowMultiplePerPackage: allowMultiple}
+		return other
+	}
+
+	for name, tc := range map[string]struct {
+		existing      []ocv1.ClusterExtension
+		allowMultiple bool
+		expectErr     bool
+	}{
+		"no other ClusterExtension installs the package": {},
+		"another ClusterExtension already installs the package": {
+			existing:  []ocv1.ClusterExtension{*otherOwner(false)},
+			expectErr: true,
+		},
+		"only the other ClusterExtension opted in via allowMultiplePerPackage": {
+			existing:  []ocv1.ClusterExtension{*otherOwner(true)},
+			expectErr: true,
+		},
+		"only this ClusterExtension opted in via allowMultiplePerPackage": {
+			existing:      []ocv1.ClusterExtension{*otherOwner(false)},
+			allowMultiple: true,
+			expectErr:     true,
+		},
+		"both ClusterExtensions opted in via allowMultiplePerPackage": {
+			existing:      []ocv1.ClusterExtension{*otherOwner(true)},
+			allowMultiple: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, ocv1.AddToScheme(scheme))
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			for i := range tc.existing {
+				builder = builder.WithObjects(&tc.existing[i])
+			}
+
+			ext := validClusterExtension()
+			ext.Spec.Install = &ocv1.ClusterExtensionInstallConfig{AllowMultiplePerPackage: tc.allowMultiple}
+
+			v := &ClusterExtension{Client: builder.Build()}
+			_, err := v.ValidateCreate(context.TODO(), ext)
+			if tc.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "already installed by ClusterExtension(s) other-extension")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClusterExtensionValidateInstallNamespace(t *testing.T) {
+	for name, tc := range map[string]struct {
+		namespace    *corev1.Namespace
+		expectErr    bool
+		errorMessage string
+	}{
+		"namespace matches selector": {
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-namespace", Labels: map[string]string{"olm.operatorframework.io/allow-install": "true"}},
+			},
+		},
+		"namespace does not match selector": {
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"},
+			},
+			expectErr:    true,
+			errorMessage: `namespace "test-namespace" is not permitted as an install namespace`,
+		},
+		"namespace does not exist yet": {
+			expectErr: false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(scheme))
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			if tc.namespace != nil {
+				builder = builder.WithObjects(tc.namespace)
+			}
+			selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+				MatchLabels: map[string]string{"olm.operatorframework.io/allow-install": "true"},
+			})
+			require.NoError(t, err)
+
+			v := &ClusterExtension{Client: builder.Build(), InstallNamespaceLabelSelector: selector}
+			_, err = v.ValidateCreate(context.TODO(), validClusterExtension())
+			if tc.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMessage)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
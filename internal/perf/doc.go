@@ -0,0 +1,21 @@
+// Package perf hosts reproducible benchmarks for the operator-controller code paths that scale
+// with fleet size: catalog resolution, registry+v1 rendering, and ClusterExtension reconciliation.
+// Each concern lives in its own subpackage (resolve, render, reconcile) so that running one
+// benchmark doesn't pay for the setup cost of the others, most notably the envtest environment
+// the reconcile benchmark requires.
+//
+// Benchmarks build their input synthetically (a 1000-package catalog, a 500-object bundle, 200
+// ClusterExtensions) rather than depending on fixtures checked into the repo, so the scale stays
+// easy to dial up or down as the project's performance targets change.
+//
+// To track regressions over time, run with -benchmem and capture output in the standard
+// benchstat-compatible text format, e.g.:
+//
+//	go test ./internal/perf/... -run '^$' -bench . -benchmem -count 5 | tee new.txt
+//	benchstat old.txt new.txt
+//
+// For a machine-readable (JSON Lines) capture of the same run, suitable for storing alongside CI
+// artifacts:
+//
+//	go test ./internal/perf/... -run '^$' -bench . -benchmem -json > benchmarks.json
+package perf
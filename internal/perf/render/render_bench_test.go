@@ -0,0 +1,88 @@
+// Package render benchmarks the registry+v1 renderer against a synthetic bundle carrying a large
+// number of additional resources, to catch regressions in rendering cost as bundle size grows.
+package render
+
+import (
+	"fmt"
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/bundle"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/render/registryv1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/util/testing/clusterserviceversion"
+)
+
+// numSyntheticObjects matches the 500-object bundle size called out in the performance target
+// this benchmark tracks.
+const numSyntheticObjects = 500
+
+// syntheticPolicyRules stands in for the kind of RBAC rule list a bundled Role commonly carries,
+// so the benchmark's resources have the nested-slice-of-maps shape real bundle content has, rather
+// than a single flat field.
+func syntheticPolicyRules() []rbacv1.PolicyRule {
+	rules := make([]rbacv1.PolicyRule, 10)
+	for i := range rules {
+		rules[i] = rbacv1.PolicyRule{
+			APIGroups: []string{"", fmt.Sprintf("group-%02d.example.io", i)},
+			Resources: []string{"pods", "configmaps", "secrets"},
+			Verbs:     []string{"get", "list", "watch"},
+		}
+	}
+	return rules
+}
+
+// buildSyntheticBundle returns a registry+v1 bundle with a minimal valid CSV and numObjects
+// Roles as additional resources.
+func buildSyntheticBundle(numObjects int) bundle.RegistryV1 {
+	others := make([]unstructured.Unstructured, numObjects)
+	for i := range others {
+		role := &rbacv1.Role{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Role",
+				APIVersion: rbacv1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("synthetic-role-%04d", i),
+			},
+			Rules: syntheticPolicyRules(),
+		}
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(role)
+		if err != nil {
+			panic(err)
+		}
+		others[i] = unstructured.Unstructured{Object: u}
+	}
+
+	return bundle.RegistryV1{
+		PackageName: "synthetic-package",
+		CSV: clusterserviceversion.Builder().
+			WithName("synthetic-package.v1.0.0").
+			WithInstallModeSupportFor(v1alpha1.InstallModeTypeAllNamespaces).
+			Build(),
+		Others: others,
+	}
+}
+
+// BenchmarkRenderBundle renders a synthetic bundle carrying 500 additional resources into plain
+// Kubernetes manifests.
+func BenchmarkRenderBundle(b *testing.B) {
+	rv1 := buildSyntheticBundle(numSyntheticObjects)
+
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		objs, err := registryv1.Renderer.Render(rv1, "install-namespace")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(objs) != numSyntheticObjects {
+			b.Fatalf("expected %d rendered objects, got %d", numSyntheticObjects, len(objs))
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Package resolve benchmarks resolve.CatalogResolver against a synthetic catalog, to catch
+// regressions in resolution cost as the number of packages a catalog serves grows.
+package resolve
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/resolve"
+)
+
+// numSyntheticPackages matches the 1000-package catalog size called out in the performance
+// target this benchmark tracks.
+const numSyntheticPackages = 1000
+
+var syntheticVersions = []string{"1.0.0", "1.0.1", "1.1.0"}
+
+func syntheticPackageName(i int) string {
+	return fmt.Sprintf("pkg-%04d", i)
+}
+
+func syntheticBundleName(pkg, version string) string {
+	return fmt.Sprintf("%s.v%s", pkg, version)
+}
+
+// buildSyntheticFBC builds a single catalog's worth of declarative config containing
+// numPackages packages, each with one channel and a handful of bundles forming an upgrade chain.
+func buildSyntheticFBC(numPackages int) *declcfg.DeclarativeConfig {
+	fbc := &declcfg.DeclarativeConfig{}
+	for i := 0; i < numPackages; i++ {
+		pkg := syntheticPackageName(i)
+		fbc.Packages = append(fbc.Packages, declcfg.Package{Name: pkg, DefaultChannel: "stable"})
+
+		entries := make([]declcfg.ChannelEntry, 0, len(syntheticVersions))
+		for j, version := range syntheticVersions {
+			entry := declcfg.ChannelEntry{Name: syntheticBundleName(pkg, version)}
+			if j > 0 {
+				entry.Replaces = syntheticBundleName(pkg, syntheticVersions[j-1])
+			}
+			entries = append(entries, entry)
+
+			fbc.Bundles = append(fbc.Bundles, declcfg.Bundle{
+				Package: pkg,
+				Name:    syntheticBundleName(pkg, version),
+				Properties: []property.Property{
+					property.MustBuildPackage(pkg, version),
+				},
+			})
+		}
+		fbc.Channels = append(fbc.Channels, declcfg.Channel{Package: pkg, Name: "stable", Entries: entries})
+	}
+	return fbc
+}
+
+// syntheticCatalogWalker implements resolve.CatalogResolver's WalkCatalogsFunc over a single
+// in-memory catalog, so the benchmark exercises real resolution logic without a live cluster.
+type syntheticCatalogWalker struct {
+	catalog *ocv1.ClusterCatalog
+	fbc     *declcfg.DeclarativeConfig
+}
+
+func (w *syntheticCatalogWalker) WalkCatalogs(ctx context.Context, _ string, f resolve.CatalogWalkFunc, _ ...client.ListOption) error {
+	return f(ctx, w.catalog, w.fbc, nil)
+}
+
+func buildSyntheticClusterExtension(pkg string) *ocv1.ClusterExtension {
+	return &ocv1.ClusterExtension{
+		ObjectMeta: metav1.ObjectMeta{Name: pkg},
+		Spec: ocv1.ClusterExtensionSpec{
+			Namespace:      "default",
+			ServiceAccount: ocv1.ServiceAccountReference{Name: "default"},
+			Source: ocv1.SourceConfig{
+				SourceType: "Catalog",
+				Catalog: &ocv1.CatalogFilter{
+					PackageName: pkg,
+				},
+			},
+		},
+	}
+}
+
+// BenchmarkResolvePackages resolves every package in a synthetic 1000-package catalog, simulating
+// a fleet with one ClusterExtension per package.
+func BenchmarkResolvePackages(b *testing.B) {
+	fbc := buildSyntheticFBC(numSyntheticPackages)
+	walker := &syntheticCatalogWalker{
+		catalog: &ocv1.ClusterCatalog{ObjectMeta: metav1.ObjectMeta{Name: "synthetic"}},
+		fbc:     fbc,
+	}
+	r := &resolve.CatalogResolver{WalkCatalogsFunc: walker.WalkCatalogs}
+
+	exts := make([]*ocv1.ClusterExtension, numSyntheticPackages)
+	for i := range exts {
+		exts[i] = buildSyntheticClusterExtension(syntheticPackageName(i))
+	}
+
+	ctx := context.Background()
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ext := exts[i%numSyntheticPackages]
+		if _, _, _, err := r.Resolve(ctx, ext, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
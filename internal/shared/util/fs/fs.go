@@ -101,3 +101,30 @@ func GetDirectoryModTime(dirPath string) (time.Time, error) {
 	}
 	return dirStat.ModTime(), nil
 }
+
+// DirectorySize walks the directory tree rooted at dirPath and returns the total size, in bytes, of
+// every regular file beneath it. If dirPath does not exist, it returns zero and a nil error, since
+// an as-yet-unpopulated cache directory is not an error condition for callers reporting usage.
+func DirectorySize(dirPath string) (int64, error) {
+	var totalBytes int64
+	err := filepath.WalkDir(dirPath, func(path string, d os.DirEntry, err error) error {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			totalBytes += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return totalBytes, nil
+}
@@ -172,3 +172,21 @@ func TestGetDirectoryModTime(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, stat.ModTime(), modTime)
 }
+
+func TestDirectorySize(t *testing.T) {
+	t.Log("Test case: directory does not exist")
+	size, err := DirectorySize(filepath.Join(t.TempDir(), "does-not-exist"))
+	require.NoError(t, err)
+	require.Zero(t, size)
+
+	t.Log("Test case: directory containing nested files")
+	tempDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "a"), []byte("1234"), ownerWritableFileMode))
+	nestedDir := filepath.Join(tempDir, "nested")
+	require.NoError(t, os.Mkdir(nestedDir, ownerWritableDirMode))
+	require.NoError(t, os.WriteFile(filepath.Join(nestedDir, "b"), []byte("123"), ownerWritableFileMode))
+
+	size, err = DirectorySize(tempDir)
+	require.NoError(t, err)
+	require.EqualValues(t, 7, size)
+}
@@ -47,6 +47,7 @@ const ConfigDirLabel = "operators.operatorframework.io.index.configs.v1"
 func CatalogCache(basePath string) Cache {
 	return &diskCache{
 		basePath:   basePath,
+		name:       "catalog",
 		filterFunc: filterForCatalogImage(),
 	}
 }
@@ -73,6 +74,7 @@ func filterForCatalogImage() func(ctx context.Context, srcRef reference.Named, i
 func BundleCache(basePath string) Cache {
 	return &diskCache{
 		basePath:   basePath,
+		name:       "bundle",
 		filterFunc: filterForBundleImage(),
 	}
 }
@@ -85,6 +87,7 @@ func filterForBundleImage() func(ctx context.Context, srcRef reference.Named, im
 
 type diskCache struct {
 	basePath   string
+	name       string
 	filterFunc func(context.Context, reference.Named, ocispecv1.Image) (archive.Filter, error)
 }
 
@@ -94,6 +97,7 @@ func (a *diskCache) Fetch(ctx context.Context, ownerID string, canonicalRef refe
 	modTime, err := fsutil.GetDirectoryModTime(unpackPath)
 	switch {
 	case errors.Is(err, os.ErrNotExist):
+		CacheMissesTotal.WithLabelValues(a.name).Inc()
 		return nil, time.Time{}, nil
 	case errors.Is(err, fsutil.ErrNotDirectory):
 		l.Info("unpack path is not a directory; attempting to delete", "path", unpackPath)
@@ -101,10 +105,52 @@ func (a *diskCache) Fetch(ctx context.Context, ownerID string, canonicalRef refe
 	case err != nil:
 		return nil, time.Time{}, fmt.Errorf("error checking image content already unpacked: %w", err)
 	}
+	CacheHitsTotal.WithLabelValues(a.name).Inc()
 	l.Info("image already unpacked")
 	return os.DirFS(a.unpackPath(ownerID, canonicalRef.Digest())), modTime, nil
 }
 
+// refreshUsageMetrics recomputes CacheEntries and CacheBytes for this cache from disk. It's called
+// after every mutation (store, delete, garbage collect) rather than on a timer, since those are the
+// only points at which usage can change and they're already far from the reconcile hot path.
+func (a *diskCache) refreshUsageMetrics(ctx context.Context) {
+	l := log.FromContext(ctx)
+
+	entries, err := a.countEntries()
+	if err != nil {
+		l.Error(err, "unable to count on-disk cache entries", "cache", a.name)
+		return
+	}
+	bytes, err := fsutil.DirectorySize(a.basePath)
+	if err != nil {
+		l.Error(err, "unable to compute on-disk cache size", "cache", a.name)
+		return
+	}
+	CacheEntries.WithLabelValues(a.name).Set(float64(entries))
+	CacheBytes.WithLabelValues(a.name).Set(float64(bytes))
+}
+
+// countEntries returns the number of unpacked image contents currently on disk, i.e. the number of
+// ownerID/digest directories beneath a.basePath.
+func (a *diskCache) countEntries() (int, error) {
+	ownerEntries, err := os.ReadDir(a.basePath)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, ownerEntry := range ownerEntries {
+		digestEntries, err := os.ReadDir(filepath.Join(a.basePath, ownerEntry.Name()))
+		if err != nil {
+			return 0, err
+		}
+		count += len(digestEntries)
+	}
+	return count, nil
+}
+
 func (a *diskCache) ownerIDPath(ownerID string) string {
 	return filepath.Join(a.basePath, ownerID)
 }
@@ -186,6 +232,7 @@ func (a *diskCache) Store(ctx context.Context, ownerID string, srcRef reference.
 	if err != nil {
 		return nil, time.Time{}, fmt.Errorf("error getting mod time of unpack directory: %w", err)
 	}
+	a.refreshUsageMetrics(ctx)
 	return os.DirFS(dest), modTime, nil
 }
 
@@ -223,11 +270,16 @@ func storeChartLayer(path string, layer LayerData) error {
 	return chart.CloseAtomicallyReplace()
 }
 
-func (a *diskCache) Delete(_ context.Context, ownerID string) error {
-	return fsutil.DeleteReadOnlyRecursive(a.ownerIDPath(ownerID))
+func (a *diskCache) Delete(ctx context.Context, ownerID string) error {
+	if err := fsutil.DeleteReadOnlyRecursive(a.ownerIDPath(ownerID)); err != nil {
+		return err
+	}
+	a.refreshUsageMetrics(ctx)
+	return nil
 }
 
-func (a *diskCache) GarbageCollect(_ context.Context, ownerID string, keep reference.Canonical) error {
+func (a *diskCache) GarbageCollect(ctx context.Context, ownerID string, keep reference.Canonical) error {
+	CacheGarbageCollectionsTotal.WithLabelValues(a.name).Inc()
 	ownerIDPath := a.ownerIDPath(ownerID)
 	dirEntries, err := os.ReadDir(ownerIDPath)
 	if err != nil {
@@ -257,5 +309,6 @@ func (a *diskCache) GarbageCollect(_ context.Context, ownerID string, keep refer
 			return fmt.Errorf("error deleting unused owner data: %w", err)
 		}
 	}
+	a.refreshUsageMetrics(ctx)
 	return nil
 }
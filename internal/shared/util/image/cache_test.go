@@ -19,6 +19,8 @@ import (
 
 	"github.com/containerd/containerd/archive"
 	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.podman.io/image/v5/docker/reference"
@@ -649,6 +651,71 @@ func TestDiskCacheGarbageCollection(t *testing.T) {
 	}
 }
 
+func TestDiskCacheCountEntries(t *testing.T) {
+	dc := &diskCache{basePath: t.TempDir()}
+
+	count, err := dc.countEntries()
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	require.NoError(t, os.MkdirAll(dc.unpackPath("owner-a", "digest-1"), 0700))
+	require.NoError(t, os.MkdirAll(dc.unpackPath("owner-a", "digest-2"), 0700))
+	require.NoError(t, os.MkdirAll(dc.unpackPath("owner-b", "digest-1"), 0700))
+
+	count, err = dc.countEntries()
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestDiskCacheUsageMetrics(t *testing.T) {
+	dc := &diskCache{basePath: t.TempDir(), name: t.Name()}
+
+	hitsBefore := counterValue(t, CacheHitsTotal.WithLabelValues(dc.name))
+	missesBefore := counterValue(t, CacheMissesTotal.WithLabelValues(dc.name))
+
+	myRef := mustParseCanonical(t, "my.registry.io/ns/repo@sha256:5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03")
+
+	_, _, err := dc.Fetch(context.Background(), "myOwner", myRef)
+	require.NoError(t, err)
+	assert.Equal(t, missesBefore+1, counterValue(t, CacheMissesTotal.WithLabelValues(dc.name)))
+	assert.Equal(t, hitsBefore, counterValue(t, CacheHitsTotal.WithLabelValues(dc.name)))
+
+	_, _, err = dc.Store(context.Background(), "myOwner", reference.TrimNamed(myRef), myRef, ocispecv1.Image{}, emptyLayers())
+	require.NoError(t, err)
+	assert.Equal(t, float64(1), gaugeValue(t, CacheEntries.WithLabelValues(dc.name)))
+
+	_, _, err = dc.Fetch(context.Background(), "myOwner", myRef)
+	require.NoError(t, err)
+	assert.Equal(t, hitsBefore+1, counterValue(t, CacheHitsTotal.WithLabelValues(dc.name)))
+
+	gcBefore := counterValue(t, CacheGarbageCollectionsTotal.WithLabelValues(dc.name))
+	require.NoError(t, dc.GarbageCollect(context.Background(), "myOwner", myRef))
+	assert.Equal(t, gcBefore+1, counterValue(t, CacheGarbageCollectionsTotal.WithLabelValues(dc.name)))
+	assert.Equal(t, float64(1), gaugeValue(t, CacheEntries.WithLabelValues(dc.name)))
+
+	require.NoError(t, dc.Delete(context.Background(), "myOwner"))
+	assert.Equal(t, float64(0), gaugeValue(t, CacheEntries.WithLabelValues(dc.name)))
+	assert.Equal(t, float64(0), gaugeValue(t, CacheBytes.WithLabelValues(dc.name)))
+}
+
+func emptyLayers() iter.Seq[LayerData] {
+	return func(yield func(LayerData) bool) {}
+}
+
+func counterValue(t *testing.T, c prometheus.Counter) float64 {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, c.Write(m))
+	return m.GetCounter().GetValue()
+}
+
+func gaugeValue(t *testing.T, g prometheus.Gauge) float64 {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, g.Write(m))
+	return m.GetGauge().GetValue()
+}
+
 func Test_storeChartLayer(t *testing.T) {
 	tmp := t.TempDir()
 	type args struct {
@@ -0,0 +1,70 @@
+package image
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/BurntSushi/toml"
+	"go.podman.io/image/v5/pkg/sysregistriesv2"
+)
+
+// workloadIdentityCredentialHelpers lists the docker-credential-<suffix>
+// helper binaries (https://github.com/docker/docker-credential-helpers)
+// that exchange a cloud workload identity for a short-lived registry token,
+// so bundle images in cloud registries can be pulled without a long-lived
+// imagePullSecret: docker-credential-ecr-login (AWS IRSA/EKS Pod Identity),
+// docker-credential-gcr (GCP Workload Identity), and docker-credential-acr-env
+// (Azure managed identity).
+var workloadIdentityCredentialHelpers = []string{"ecr-login", "gcr", "acr-env"}
+
+// DetectWorkloadIdentityCredentialHelpers probes PATH for the credential
+// helper binaries operator-controller knows how to use for cloud workload
+// identity, returning the suffix (the part after "docker-credential-") of
+// each one that's actually installed. Nothing is returned by default: these
+// helpers ship as optional additions to the operator-controller image, and
+// most clusters won't have any of them installed.
+func DetectWorkloadIdentityCredentialHelpers() []string {
+	var found []string
+	for _, suffix := range workloadIdentityCredentialHelpers {
+		if _, err := exec.LookPath("docker-credential-" + suffix); err == nil {
+			found = append(found, suffix)
+		}
+	}
+	return found
+}
+
+// WriteWorkloadIdentityRegistriesConf writes a containers/image
+// registries.conf to path declaring helpers (as returned by
+// DetectWorkloadIdentityCredentialHelpers) as global credential-helpers,
+// consulted for any registry that the static auth file synced from
+// imagePullSecrets doesn't already have credentials for. The built-in
+// "containers-auth.json" helper is always appended last so that auth-file
+// based authentication keeps working unchanged.
+//
+// If helpers is empty, WriteWorkloadIdentityRegistriesConf removes any
+// stale file at path instead of writing one, so that SystemContext falls
+// back to its default (auth file only) behavior.
+func WriteWorkloadIdentityRegistriesConf(path string, helpers []string) error {
+	if len(helpers) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing stale workload identity registries.conf: %w", err)
+		}
+		return nil
+	}
+
+	conf := sysregistriesv2.V2RegistriesConf{
+		CredentialHelpers: append(helpers, sysregistriesv2.AuthenticationFileHelper),
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("creating workload identity registries.conf: %w", err)
+	}
+	defer f.Close()
+
+	if err := toml.NewEncoder(f).Encode(conf); err != nil {
+		return fmt.Errorf("writing workload identity registries.conf: %w", err)
+	}
+	return nil
+}
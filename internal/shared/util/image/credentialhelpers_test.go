@@ -0,0 +1,46 @@
+package image
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/image/v5/pkg/sysregistriesv2"
+)
+
+func TestWriteWorkloadIdentityRegistriesConf(t *testing.T) {
+	t.Run("writes the given helpers plus the auth file helper", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "registries.conf")
+		require.NoError(t, WriteWorkloadIdentityRegistriesConf(path, []string{"ecr-login"}))
+
+		var conf sysregistriesv2.V2RegistriesConf
+		_, err := toml.DecodeFile(path, &conf)
+		require.NoError(t, err)
+		require.Equal(t, []string{"ecr-login", sysregistriesv2.AuthenticationFileHelper}, conf.CredentialHelpers)
+	})
+
+	t.Run("removes an existing file when no helpers are found", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "registries.conf")
+		require.NoError(t, WriteWorkloadIdentityRegistriesConf(path, []string{"gcr"}))
+		require.NoError(t, WriteWorkloadIdentityRegistriesConf(path, nil))
+
+		_, err := os.Stat(path)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("no-op when no helpers are found and no file exists", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "registries.conf")
+		require.NoError(t, WriteWorkloadIdentityRegistriesConf(path, nil))
+
+		_, err := os.Stat(path)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestDetectWorkloadIdentityCredentialHelpers(t *testing.T) {
+	// None of the known helper binaries are expected to be on PATH in the
+	// test environment; this just exercises that detection doesn't error.
+	require.Empty(t, DetectWorkloadIdentityCredentialHelpers())
+}
@@ -0,0 +1,58 @@
+package image
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// The following metrics instrument the on-disk caches created by CatalogCache and BundleCache, so
+// operators can size the volume backing cfg.cachePath/cfg.cacheDir instead of discovering it's too
+// small after a pod starts failing to unpack. Every metric is labelled by "cache" ("catalog" or
+// "bundle") so the two caches, which are typically backed by different volumes, can be told apart.
+var (
+	// CacheHitsTotal counts the number of times requested image content was already present in
+	// the on-disk cache and didn't need to be re-unpacked.
+	CacheHitsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "image_cache_hits_total",
+			Help: "The number of times requested image content was already present in the on-disk cache",
+		},
+		[]string{"cache"},
+	)
+
+	// CacheMissesTotal counts the number of times requested image content was not present in the
+	// on-disk cache and had to be unpacked.
+	CacheMissesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "image_cache_misses_total",
+			Help: "The number of times requested image content was not present in the on-disk cache and had to be unpacked",
+		},
+		[]string{"cache"},
+	)
+
+	// CacheGarbageCollectionsTotal counts the number of times the on-disk cache ran garbage
+	// collection for an owner.
+	CacheGarbageCollectionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "image_cache_garbage_collections_total",
+			Help: "The number of times the on-disk cache ran garbage collection for an owner",
+		},
+		[]string{"cache"},
+	)
+
+	// CacheEntries reflects the number of image contents currently unpacked in the on-disk cache.
+	CacheEntries = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "image_cache_entries",
+			Help: "The number of image contents currently unpacked in the on-disk cache",
+		},
+		[]string{"cache"},
+	)
+
+	// CacheBytes reflects the total size, in bytes, of image contents currently unpacked in the
+	// on-disk cache.
+	CacheBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "image_cache_bytes",
+			Help: "The total size, in bytes, of image contents currently unpacked in the on-disk cache",
+		},
+		[]string{"cache"},
+	)
+)
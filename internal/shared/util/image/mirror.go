@@ -0,0 +1,97 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/BurntSushi/toml"
+	"go.podman.io/image/v5/copy"
+	"go.podman.io/image/v5/docker"
+	"go.podman.io/image/v5/docker/reference"
+	"go.podman.io/image/v5/pkg/sysregistriesv2"
+	"go.podman.io/image/v5/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// CopyImage copies srcRef to destRef using the same signature policy pull.go falls back to when
+// no policy.json is configured, so that an air-gapped mirroring pass doesn't require one either.
+// Unlike the unpack path, the destination is a registry rather than an OCI layout, and signatures
+// are dropped exactly as they are for the temporary OCI layout pull.go copies into, since the
+// destination registry has no use for a policy scoped to the source.
+func CopyImage(ctx context.Context, srcCtx, destCtx *types.SystemContext, srcRef, destRef string) error {
+	l := log.FromContext(ctx, "source", srcRef, "destination", destRef)
+
+	srcDockerRef, err := reference.ParseNamed(srcRef)
+	if err != nil {
+		return fmt.Errorf("parsing source image reference %q: %w", srcRef, err)
+	}
+	srcImgRef, err := docker.NewReference(srcDockerRef)
+	if err != nil {
+		return fmt.Errorf("creating source image reference: %w", err)
+	}
+
+	destDockerRef, err := reference.ParseNamed(destRef)
+	if err != nil {
+		return fmt.Errorf("parsing destination image reference %q: %w", destRef, err)
+	}
+	destImgRef, err := docker.NewReference(destDockerRef)
+	if err != nil {
+		return fmt.Errorf("creating destination image reference: %w", err)
+	}
+
+	policyContext, err := loadPolicyContext(srcCtx, l)
+	if err != nil {
+		return fmt.Errorf("loading signature policy: %w", err)
+	}
+	defer func() {
+		if err := policyContext.Destroy(); err != nil {
+			l.Error(err, "error destroying policy context")
+		}
+	}()
+
+	if _, err := copy.Image(ctx, policyContext, destImgRef, srcImgRef, &copy.Options{
+		SourceCtx:        srcCtx,
+		DestinationCtx:   destCtx,
+		RemoveSignatures: true,
+	}); err != nil {
+		return fmt.Errorf("copying %q to %q: %w", srcRef, destRef, err)
+	}
+	l.Info("mirrored image")
+	return nil
+}
+
+// WriteMirrorRegistriesConf writes a containers/image registries.conf to path declaring, for each
+// source registry host in mirrors, a mirror at the corresponding target location. The unpacker's
+// SystemContext already points SystemRegistriesConfPath at a file in this format (see
+// WriteWorkloadIdentityRegistriesConf), so once this file is in place, bundle/catalog/related-image
+// pulls by the original reference are transparently redirected to the mirror without the puller or
+// its callers needing to know a mirror is in use.
+//
+// mirrors maps each source registry host (e.g. "quay.io") to the mirror location images mirrored
+// from it were pushed under (e.g. "mirror.example.com/quay.io"). PullFromMirror is left at its
+// default ("" / not-digest-only), since the mirror is expected to be a faithful, writable copy
+// rather than a digest-addressed cache.
+func WriteMirrorRegistriesConf(path string, mirrors map[string]string) error {
+	conf := sysregistriesv2.V2RegistriesConf{}
+	for source, target := range mirrors {
+		conf.Registries = append(conf.Registries, sysregistriesv2.Registry{
+			Endpoint: sysregistriesv2.Endpoint{Location: source},
+			Prefix:   source,
+			Mirrors: []sysregistriesv2.Endpoint{
+				{Location: target},
+			},
+		})
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("creating mirror registries.conf: %w", err)
+	}
+	defer f.Close()
+
+	if err := toml.NewEncoder(f).Encode(conf); err != nil {
+		return fmt.Errorf("writing mirror registries.conf: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package image
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/image/v5/pkg/sysregistriesv2"
+)
+
+func TestWriteMirrorRegistriesConf(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "registries.conf")
+	require.NoError(t, WriteMirrorRegistriesConf(path, map[string]string{
+		"quay.io": "mirror.example.com/quay.io",
+	}))
+
+	var conf sysregistriesv2.V2RegistriesConf
+	_, err := toml.DecodeFile(path, &conf)
+	require.NoError(t, err)
+	require.Len(t, conf.Registries, 1)
+	require.Equal(t, "quay.io", conf.Registries[0].Prefix)
+	require.Equal(t, "quay.io", conf.Registries[0].Location)
+	require.Equal(t, []sysregistriesv2.Endpoint{{Location: "mirror.example.com/quay.io"}}, conf.Registries[0].Mirrors)
+}
@@ -0,0 +1,153 @@
+package provenance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/docker/distribution/registry/api/errcode"
+	distributionv2 "github.com/docker/distribution/registry/api/v2"
+	"go.podman.io/image/v5/docker"
+	"go.podman.io/image/v5/docker/reference"
+	"go.podman.io/image/v5/manifest"
+	"go.podman.io/image/v5/pkg/blobinfocache/none"
+	"go.podman.io/image/v5/types"
+)
+
+// inTotoStatementMediaType is the media type cosign uses for the single layer
+// of an attestation manifest, which holds an in-toto statement as its
+// payload.
+const inTotoStatementMediaType = "application/vnd.in-toto+json"
+
+// maxAttestationSize bounds how much of an attestation layer is read into
+// memory. SLSA provenance predicates are small JSON documents; this is far
+// larger than any legitimate one while still bounding worst-case memory use.
+const maxAttestationSize = 10 << 20 // 10 MiB
+
+// slsaProvenanceV02 is the subset of the SLSA v0.2 provenance predicate
+// (https://slsa.dev/provenance/v0.2) that Attestation is built from.
+type slsaProvenanceV02 struct {
+	Builder struct {
+		ID string `json:"id"`
+	} `json:"builder"`
+	Invocation struct {
+		ConfigSource struct {
+			URI string `json:"uri"`
+		} `json:"configSource"`
+	} `json:"invocation"`
+}
+
+// inTotoStatement is the subset of the in-toto attestation statement format
+// (https://github.com/in-toto/attestation) needed to reach the SLSA
+// provenance predicate it wraps.
+type inTotoStatement struct {
+	PredicateType string            `json:"predicateType"`
+	Predicate     slsaProvenanceV02 `json:"predicate"`
+}
+
+// CosignTagFetcher fetches a SLSA provenance attestation published using
+// cosign's attestation tag convention: an OCI artifact tagged
+// "<repo>:sha256-<digest>.att", whose single layer is an in-toto statement
+// wrapping the provenance predicate. This predates, and is still more widely
+// used than, the OCI 1.1 referrers API for attaching attestations.
+type CosignTagFetcher struct{}
+
+func (f *CosignTagFetcher) Fetch(ctx context.Context, ref reference.Canonical, srcCtx *types.SystemContext) (*Attestation, error) {
+	attRef, err := attestationReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("error building attestation reference: %w", err)
+	}
+
+	imgSrc, err := attRef.NewImageSource(ctx, srcCtx)
+	if err != nil {
+		if isManifestUnknownError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error creating image source for attestation %s: %w", attRef.DockerReference(), err)
+	}
+	defer imgSrc.Close()
+
+	manifestBlob, manifestType, err := imgSrc.GetManifest(ctx, nil)
+	if err != nil {
+		if isManifestUnknownError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting attestation manifest: %w", err)
+	}
+	parsedManifest, err := manifest.FromBlob(manifestBlob, manifestType)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing attestation manifest: %w", err)
+	}
+
+	var statement *inTotoStatement
+	for _, layer := range parsedManifest.LayerInfos() {
+		if layer.MediaType != inTotoStatementMediaType {
+			continue
+		}
+		payload, err := fetchLayer(ctx, imgSrc, layer.BlobInfo)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching attestation layer %s: %w", layer.Digest, err)
+		}
+		var s inTotoStatement
+		if err := json.Unmarshal(payload, &s); err != nil {
+			return nil, fmt.Errorf("error parsing in-toto statement: %w", err)
+		}
+		if s.PredicateType != "https://slsa.dev/provenance/v0.2" {
+			continue
+		}
+		statement = &s
+		break
+	}
+	if statement == nil {
+		return nil, nil
+	}
+
+	return &Attestation{
+		BuilderID:        statement.Predicate.Builder.ID,
+		SourceRepository: statement.Predicate.Invocation.ConfigSource.URI,
+	}, nil
+}
+
+// attestationReference builds the docker reference for ref's cosign
+// attestation tag, "<repo>:sha256-<digest>.att".
+func attestationReference(ref reference.Canonical) (types.ImageReference, error) {
+	attTag := fmt.Sprintf("%s-%s.att", ref.Digest().Algorithm(), ref.Digest().Encoded())
+	tagged, err := reference.WithTag(reference.TrimNamed(ref), attTag)
+	if err != nil {
+		return nil, err
+	}
+	return docker.NewReference(tagged)
+}
+
+func fetchLayer(ctx context.Context, imgSrc types.ImageSource, blobInfo types.BlobInfo) ([]byte, error) {
+	reader, _, err := imgSrc.GetBlob(ctx, blobInfo, none.NoCache)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	payload, err := io.ReadAll(io.LimitReader(reader, maxAttestationSize))
+	if err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// isManifestUnknownError reports whether err indicates the attestation
+// artifact doesn't exist, meaning the image simply has no provenance
+// attestation attached, rather than some other fetch failure that should be
+// surfaced. Any other error (auth, network, registry outage) is treated as a
+// real failure rather than "no attestation", so it isn't silently swallowed.
+func isManifestUnknownError(err error) bool {
+	var ec errcode.ErrorCoder
+	if errors.As(err, &ec) && ec.ErrorCode() == distributionv2.ErrorCodeManifestUnknown {
+		return true
+	}
+	var e errcode.Error
+	if errors.As(err, &e) && e.ErrorCode() == errcode.ErrorCodeUnknown && strings.Contains(strings.ToLower(e.Message), "not found") {
+		return true
+	}
+	return false
+}
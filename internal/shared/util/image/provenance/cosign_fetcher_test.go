@@ -0,0 +1,153 @@
+package provenance_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	digestpkg "github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+	"go.podman.io/image/v5/docker/reference"
+	"go.podman.io/image/v5/pkg/sysregistriesv2"
+	imagetypes "go.podman.io/image/v5/types"
+
+	"github.com/operator-framework/operator-controller/internal/shared/util/image/provenance"
+)
+
+// rawLayer is a v1.Layer wrapping an arbitrary, uncompressed byte payload, so
+// tests can build an attestation image whose single layer is a raw in-toto
+// statement rather than a tarball, matching what cosign actually pushes.
+type rawLayer struct {
+	content   []byte
+	mediaType types.MediaType
+}
+
+func (l rawLayer) Digest() (v1.Hash, error) {
+	h, _, err := v1.SHA256(bytes.NewReader(l.content))
+	return h, err
+}
+
+func (l rawLayer) DiffID() (v1.Hash, error) { return l.Digest() }
+
+func (l rawLayer) Compressed() (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(l.content)), nil
+}
+
+func (l rawLayer) Uncompressed() (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(l.content)), nil
+}
+
+func (l rawLayer) Size() (int64, error) { return int64(len(l.content)), nil }
+
+func (l rawLayer) MediaType() (types.MediaType, error) { return l.mediaType, nil }
+
+// insecureSourceContext returns a containers/image SystemContext that allows
+// pulling from an httptest-backed in-memory registry without TLS, mirroring
+// the image package's own setupRegistry test helper.
+func insecureSourceContext(t *testing.T, host string) *imagetypes.SystemContext {
+	t.Helper()
+
+	registriesConf := sysregistriesv2.V2RegistriesConf{Registries: []sysregistriesv2.Registry{
+		{
+			Prefix: host,
+			Endpoint: sysregistriesv2.Endpoint{
+				Location: host,
+				Insecure: true,
+			},
+		},
+	}}
+	configDir := t.TempDir()
+	registriesConfPath := filepath.Join(configDir, "registries.conf")
+	f, err := os.Create(registriesConfPath)
+	require.NoError(t, err)
+	require.NoError(t, toml.NewEncoder(f).Encode(registriesConf))
+	require.NoError(t, f.Close())
+
+	return &imagetypes.SystemContext{SystemRegistriesConfPath: registriesConfPath}
+}
+
+func TestCosignTagFetcher_Fetch(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	host := serverURL.Host
+
+	statement := map[string]any{
+		"predicateType": "https://slsa.dev/provenance/v0.2",
+		"predicate": map[string]any{
+			"builder": map[string]any{"id": "https://example.com/builder"},
+			"invocation": map[string]any{
+				"configSource": map[string]any{"uri": "https://github.com/example/operator"},
+			},
+		},
+	}
+	statementBytes, err := json.Marshal(statement)
+	require.NoError(t, err)
+
+	attImg, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:     rawLayer{content: statementBytes, mediaType: "application/vnd.in-toto+json"},
+		MediaType: "application/vnd.in-toto+json",
+	})
+	require.NoError(t, err)
+	// Cosign publishes attestation manifests as OCI, not Docker schema2, which requires
+	// layer media types from a fixed set that doesn't include in-toto's.
+	attImg = mutate.MediaType(attImg, types.OCIManifestSchema1)
+	attImg = mutate.ConfigMediaType(attImg, types.OCIConfigJSON)
+
+	subjectDigest := digestpkg.FromBytes([]byte("fake bundle image manifest"))
+	attTag := fmt.Sprintf("%s-%s.att", subjectDigest.Algorithm(), subjectDigest.Encoded())
+	require.NoError(t, crane.Push(attImg, fmt.Sprintf("%s/test-repo/test-image:%s", host, attTag)))
+
+	subjectRef, err := reference.ParseNamed(fmt.Sprintf("%s/test-repo/test-image", host))
+	require.NoError(t, err)
+	canonicalRef, err := reference.WithDigest(subjectRef, subjectDigest)
+	require.NoError(t, err)
+
+	fetcher := &provenance.CosignTagFetcher{}
+	att, err := fetcher.Fetch(context.Background(), canonicalRef, insecureSourceContext(t, host))
+	require.NoError(t, err)
+	require.NotNil(t, att)
+	require.Equal(t, "https://example.com/builder", att.BuilderID)
+	require.Equal(t, "https://github.com/example/operator", att.SourceRepository)
+}
+
+func TestCosignTagFetcher_Fetch_NoAttestation(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	host := serverURL.Host
+
+	// Push the subject image itself, but no attestation tag for it: there's nothing at
+	// "<repo>:sha256-<digest>.att" for the fetcher to find.
+	img, err := crane.Image(map[string][]byte{"f": []byte("c")})
+	require.NoError(t, err)
+	imgDigest, err := img.Digest()
+	require.NoError(t, err)
+	require.NoError(t, crane.Push(img, fmt.Sprintf("%s/test-repo/test-image@%s", host, imgDigest.String())))
+
+	subjectRef, err := reference.ParseNamed(fmt.Sprintf("%s/test-repo/test-image", host))
+	require.NoError(t, err)
+	canonicalRef, err := reference.WithDigest(subjectRef, digestpkg.Digest(imgDigest.String()))
+	require.NoError(t, err)
+
+	fetcher := &provenance.CosignTagFetcher{}
+	att, err := fetcher.Fetch(context.Background(), canonicalRef, insecureSourceContext(t, host))
+	require.NoError(t, err)
+	require.Nil(t, att)
+}
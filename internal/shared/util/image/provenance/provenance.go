@@ -0,0 +1,71 @@
+// Package provenance implements an optional preflight check that verifies a
+// bundle image's SLSA provenance attestation against a configured policy
+// before the image is unpacked and installed.
+//
+// This check is independent of, and complementary to, the signature policy
+// enforced by containers/image (see internal/shared/util/image.loadPolicyContext):
+// signature verification proves an image was signed by a trusted key, while
+// provenance verification proves *how* and *where* the image was built.
+package provenance
+
+import (
+	"context"
+	"fmt"
+
+	"go.podman.io/image/v5/docker/reference"
+	"go.podman.io/image/v5/types"
+)
+
+// Attestation is the subset of an in-toto SLSA provenance predicate that
+// policy decisions are made against.
+type Attestation struct {
+	// BuilderID identifies the builder that produced the image, e.g.
+	// "https://github.com/actions/runner" or a specific trusted builder URI.
+	BuilderID string
+
+	// SourceRepository is the URI of the source repository the build was
+	// triggered from, e.g. "https://github.com/example/operator".
+	SourceRepository string
+}
+
+// Policy describes the provenance an image must have in order to be
+// considered trustworthy.
+//
+// Both fields are optional; an unset field is not checked. A Policy with
+// both fields unset accepts any attestation, including the absence of one.
+type Policy struct {
+	// BuilderID, when set, must exactly match the attestation's BuilderID.
+	BuilderID string
+
+	// SourceRepository, when set, must exactly match the attestation's
+	// SourceRepository.
+	SourceRepository string
+}
+
+// Fetcher retrieves the provenance attestation for a bundle image, if one
+// is available. It returns a nil Attestation if the image has no attached
+// provenance attestation.
+type Fetcher interface {
+	Fetch(ctx context.Context, ref reference.Canonical, srcCtx *types.SystemContext) (*Attestation, error)
+}
+
+// Verify checks att against policy, returning a descriptive error for the
+// first policy requirement that isn't satisfied.
+//
+// A nil att fails verification unless policy has no requirements, since a
+// missing attestation cannot satisfy a configured policy.
+func Verify(att *Attestation, policy Policy) error {
+	if policy.BuilderID == "" && policy.SourceRepository == "" {
+		return nil
+	}
+	if att == nil {
+		return fmt.Errorf("no provenance attestation found for image, but a provenance policy is configured")
+	}
+	if policy.BuilderID != "" && att.BuilderID != policy.BuilderID {
+		return fmt.Errorf("provenance builder id %q does not match required builder id %q", att.BuilderID, policy.BuilderID)
+	}
+	if policy.SourceRepository != "" && att.SourceRepository != policy.SourceRepository {
+		return fmt.Errorf("provenance source repository %q does not match required source repository %q", att.SourceRepository, policy.SourceRepository)
+	}
+	return nil
+}
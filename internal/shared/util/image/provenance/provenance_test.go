@@ -0,0 +1,70 @@
+package provenance_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/operator-framework/operator-controller/internal/shared/util/image/provenance"
+)
+
+func TestVerify(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		att     *provenance.Attestation
+		policy  provenance.Policy
+		wantErr string
+	}{
+		{
+			name:   "no policy configured accepts missing attestation",
+			att:    nil,
+			policy: provenance.Policy{},
+		},
+		{
+			name:    "policy configured rejects missing attestation",
+			att:     nil,
+			policy:  provenance.Policy{BuilderID: "https://example.com/builder"},
+			wantErr: "no provenance attestation found",
+		},
+		{
+			name: "matching builder id and source repository passes",
+			att: &provenance.Attestation{
+				BuilderID:        "https://example.com/builder",
+				SourceRepository: "https://github.com/example/operator",
+			},
+			policy: provenance.Policy{
+				BuilderID:        "https://example.com/builder",
+				SourceRepository: "https://github.com/example/operator",
+			},
+		},
+		{
+			name: "mismatched builder id fails",
+			att: &provenance.Attestation{
+				BuilderID: "https://untrusted.example.com/builder",
+			},
+			policy:  provenance.Policy{BuilderID: "https://example.com/builder"},
+			wantErr: "does not match required builder id",
+		},
+		{
+			name: "mismatched source repository fails",
+			att: &provenance.Attestation{
+				BuilderID:        "https://example.com/builder",
+				SourceRepository: "https://github.com/example/fork",
+			},
+			policy: provenance.Policy{
+				BuilderID:        "https://example.com/builder",
+				SourceRepository: "https://github.com/example/operator",
+			},
+			wantErr: "does not match required source repository",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := provenance.Verify(tc.att, tc.policy)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
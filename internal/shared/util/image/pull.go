@@ -26,6 +26,7 @@ import (
 
 	"github.com/operator-framework/operator-controller/internal/operator-controller/features"
 	"github.com/operator-framework/operator-controller/internal/shared/util/http"
+	"github.com/operator-framework/operator-controller/internal/shared/util/image/provenance"
 )
 
 type Puller interface {
@@ -36,6 +37,16 @@ var insecurePolicy = []byte(`{"default":[{"type":"insecureAcceptAnything"}]}`)
 
 type ContainersImagePuller struct {
 	SourceCtxFunc func(context.Context) (*types.SystemContext, error)
+
+	// ProvenanceFetcher, when set, is used to retrieve the SLSA provenance
+	// attestation for the bundle image so it can be checked against
+	// ProvenancePolicy. When unset, provenance verification is skipped
+	// regardless of ProvenancePolicy.
+	ProvenanceFetcher provenance.Fetcher
+
+	// ProvenancePolicy is the policy that a fetched provenance attestation
+	// must satisfy. It has no effect unless ProvenanceFetcher is also set.
+	ProvenancePolicy provenance.Policy
 }
 
 func (p *ContainersImagePuller) Pull(ctx context.Context, ownerID string, ref string, cache Cache) (fs.FS, reference.Canonical, time.Time, error) {
@@ -87,6 +98,24 @@ func (p *ContainersImagePuller) pull(ctx context.Context, ownerID string, docker
 	l = l.WithValues("digest", canonicalRef.Digest().String())
 	ctx = log.IntoContext(ctx, l)
 
+	//////////////////////////////////////////////////////
+	//
+	// Verify the image's provenance attestation, if a
+	// fetcher has been configured. This is independent of,
+	// and in addition to, the signature policy applied
+	// during the image copy below.
+	//
+	//////////////////////////////////////////////////////
+	if p.ProvenanceFetcher != nil {
+		att, err := p.ProvenanceFetcher.Fetch(ctx, canonicalRef, srcCtx)
+		if err != nil {
+			return nil, nil, time.Time{}, fmt.Errorf("error fetching provenance attestation: %w", err)
+		}
+		if err := provenance.Verify(att, p.ProvenancePolicy); err != nil {
+			return nil, nil, time.Time{}, reconcile.TerminalError(fmt.Errorf("provenance verification failed: %w", err))
+		}
+	}
+
 	///////////////////////////////////////////////////////
 	//
 	// Check if the cache has already applied the
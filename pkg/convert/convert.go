@@ -0,0 +1,77 @@
+// Package convert renders registry+v1 bundles (the format OLMv0 CSVs and their manifests ship in)
+// into the plain Kubernetes manifests OLMv1's operator-controller applies for a ClusterExtension.
+//
+// It wraps operator-controller's internal rendering pipeline behind a small, stable API so that
+// catalog authors and other tooling can validate how a bundle will render without running a
+// cluster, rather than reimplementing the conversion rules themselves. operator-controller itself
+// continues to render bundles through its internal pipeline directly; this package is an additional,
+// non-breaking entry point for external callers.
+//
+// Bundles whose ClusterServiceVersion declares webhookDefinitions or apiServiceDefinitions are not
+// supported here, since injecting their certificates requires the same cert-manager/Service-CA
+// integration operator-controller wires up at runtime, which has no stable, externally
+// implementable interface to offer outside that integration.
+package convert
+
+import (
+	"fmt"
+	"io/fs"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/bundle/source"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/render"
+	"github.com/operator-framework/operator-controller/internal/operator-controller/rukpak/render/registryv1"
+)
+
+// Option customizes how RegistryV1ToPlainManifests renders a bundle.
+type Option func(*options)
+
+type options struct {
+	targetNamespaces []string
+}
+
+// WithTargetNamespaces sets the namespaces the installed operator would watch, for bundles that
+// support the SingleNamespace or OwnNamespace install modes. It has no effect on a bundle that only
+// supports AllNamespaces.
+func WithTargetNamespaces(namespaces ...string) Option {
+	return func(o *options) {
+		o.targetNamespaces = namespaces
+	}
+}
+
+// RegistryV1ToPlainManifests parses the registry+v1 bundle rooted at bundleFS and renders it into the
+// plain manifests operator-controller would apply for a ClusterExtension installing it into
+// installNamespace.
+func RegistryV1ToPlainManifests(bundleFS fs.FS, installNamespace string, opts ...Option) ([]client.Object, error) {
+	rv1, err := source.FromFS(bundleFS).GetBundle()
+	if err != nil {
+		return nil, fmt.Errorf("parsing registry+v1 bundle: %w", err)
+	}
+
+	if len(rv1.CSV.Spec.APIServiceDefinitions.Owned) > 0 {
+		return nil, fmt.Errorf("unsupported bundle: apiServiceDefinitions are not supported")
+	}
+	if len(rv1.CSV.Spec.WebhookDefinitions) > 0 {
+		return nil, fmt.Errorf("unsupported bundle: webhookDefinitions are not supported")
+	}
+
+	installModes := sets.New(rv1.CSV.Spec.InstallModes...)
+	if !installModes.HasAny(
+		v1alpha1.InstallMode{Type: v1alpha1.InstallModeTypeAllNamespaces, Supported: true},
+		v1alpha1.InstallMode{Type: v1alpha1.InstallModeTypeSingleNamespace, Supported: true},
+		v1alpha1.InstallMode{Type: v1alpha1.InstallModeTypeOwnNamespace, Supported: true},
+	) {
+		return nil, fmt.Errorf("unsupported bundle: bundle must support at least one of [AllNamespaces SingleNamespace OwnNamespace] install modes")
+	}
+
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return registryv1.Renderer.Render(rv1, installNamespace, render.WithTargetNamespaces(o.targetNamespaces...))
+}
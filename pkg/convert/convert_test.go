@@ -0,0 +1,46 @@
+package convert_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/operator-framework/operator-controller/pkg/convert"
+)
+
+// TestRegistryV1ToPlainManifests_GoldenFiles renders a known registry+v1 bundle and compares the
+// result against the files in testdata/golden/test-operator/, to catch unintended changes in the
+// plain manifests this package produces for external callers.
+//
+// To update the golden files after an intentional rendering change, regenerate them from the
+// bundle at ../../testdata/images/bundles/test-operator/v1.0.3 and review the diff.
+func TestRegistryV1ToPlainManifests_GoldenFiles(t *testing.T) {
+	objs, err := convert.RegistryV1ToPlainManifests(os.DirFS("../../testdata/images/bundles/test-operator/v1.0.3"), "test-operator-system")
+	require.NoError(t, err)
+
+	const goldenDir = "testdata/golden/test-operator"
+	entries, err := os.ReadDir(goldenDir)
+	require.NoError(t, err)
+	require.Len(t, objs, len(entries), "rendered object count does not match the number of golden files")
+
+	for _, obj := range objs {
+		kind := obj.GetObjectKind().GroupVersionKind().Kind
+		fileName := fmt.Sprintf("%s_%s.yaml", strings.ToLower(kind), obj.GetName())
+
+		want, err := os.ReadFile(goldenDir + "/" + fileName)
+		require.NoErrorf(t, err, "no golden file %q for rendered object", fileName)
+
+		got, err := yaml.Marshal(obj)
+		require.NoError(t, err)
+		require.Equal(t, string(want), string(got), "rendered %s does not match golden file %q", kind, fileName)
+	}
+}
+
+func TestRegistryV1ToPlainManifests_RejectsWebhookBundle(t *testing.T) {
+	_, err := convert.RegistryV1ToPlainManifests(os.DirFS("../../testdata/images/bundles/webhook-operator/v0.0.1"), "webhook-operator-system")
+	require.ErrorContains(t, err, "webhookDefinitions are not supported")
+}
@@ -0,0 +1,61 @@
+package resolve_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	"github.com/operator-framework/operator-controller/pkg/resolve"
+)
+
+// Example constructs a Resolver over a single in-memory catalog and resolves a fresh install of a
+// package. Real callers would back walkCatalogs with a Kubernetes client listing ClusterCatalogs
+// and a reader for their exported content, rather than the in-memory map used here.
+func Example() {
+	catalog := &ocv1.ClusterCatalog{}
+	catalog.Name = "example-catalog"
+
+	fbc := &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{{Name: "example-operator"}},
+		Channels: []declcfg.Channel{{
+			Package: "example-operator",
+			Name:    "stable",
+			Entries: []declcfg.ChannelEntry{{Name: "example-operator.v1.0.0"}},
+		}},
+		Bundles: []declcfg.Bundle{{
+			Package: "example-operator",
+			Name:    "example-operator.v1.0.0",
+			Properties: []property.Property{
+				property.MustBuildPackage("example-operator", "1.0.0"),
+			},
+		}},
+	}
+
+	walkCatalogs := func(
+		ctx context.Context,
+		packageName string,
+		walk resolve.WalkFunc,
+		listOpts ...client.ListOption,
+	) error {
+		return walk(ctx, catalog, fbc, nil)
+	}
+
+	r := resolve.New(walkCatalogs, resolve.WithValidations(resolve.ValidationFunc(func(*declcfg.Bundle) error {
+		return nil
+	})))
+
+	ext := &ocv1.ClusterExtension{}
+	ext.Spec.Source.Catalog = &ocv1.CatalogFilter{PackageName: "example-operator"}
+
+	bundle, _, _, err := r.Resolve(context.Background(), ext, nil)
+	if err != nil {
+		fmt.Println("resolve error:", err)
+		return
+	}
+	fmt.Println(bundle.Name)
+	// Output: example-operator.v1.0.0
+}
@@ -0,0 +1,85 @@
+// Package resolve picks the Bundle operator-controller would install or upgrade to for a
+// ClusterExtension, given a source of ClusterCatalog content.
+//
+// It wraps operator-controller's internal resolution engine behind a small, stable API so that
+// downstream tooling (UIs, CLIs, platform operators) can answer "what would OLMv1 install here?"
+// without running a reconcile loop or reimplementing the catalog/upgrade-graph/package-selection
+// rules themselves. operator-controller itself continues to use the internal engine directly; this
+// package is an additional, non-breaking entry point for external callers.
+//
+// Callers supply their own source of catalog content via a WalkFunc (typically backed by a
+// Kubernetes client listing ClusterCatalogs and an HTTP or filesystem reader for their exported
+// content), and customize resolution behavior with Option.
+package resolve
+
+import (
+	"context"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocv1 "github.com/operator-framework/operator-controller/api/v1"
+	internalresolve "github.com/operator-framework/operator-controller/internal/operator-controller/resolve"
+)
+
+// Resolver picks the Bundle to install or upgrade to for a ClusterExtension, given the currently
+// installed bundle (nil for a fresh install).
+type Resolver = internalresolve.Resolver
+
+// ValidationFunc rejects a candidate Bundle, for example one that declares properties a caller's
+// resolution model doesn't support.
+type ValidationFunc = internalresolve.ValidationFunc
+
+// WalkFunc is called once per enabled ClusterCatalog while resolving packageName, with that
+// catalog's content for the package (or a non-nil err if it couldn't be retrieved). WalkFunc
+// returning a non-nil error stops the walk and fails resolution.
+type WalkFunc = internalresolve.CatalogWalkFunc
+
+// Walker returns a source of catalog content for New: the list of catalogs to consider, and a way
+// to retrieve a single package's content from one of them.
+func Walker(
+	listCatalogs func(context.Context, ...client.ListOption) ([]ocv1.ClusterCatalog, error),
+	getPackage func(ctx context.Context, catalog *ocv1.ClusterCatalog, packageName string) (*declcfg.DeclarativeConfig, error),
+) func(ctx context.Context, packageName string, walk WalkFunc, listOpts ...client.ListOption) error {
+	return internalresolve.CatalogWalker(listCatalogs, getPackage)
+}
+
+// Option customizes a Resolver built by New.
+type Option func(*internalresolve.CatalogResolver)
+
+// WithValidations adds checks that every candidate Bundle must pass before it can be resolved,
+// beyond the package selection and upgrade constraints ext itself expresses.
+func WithValidations(fns ...ValidationFunc) Option {
+	return func(r *internalresolve.CatalogResolver) {
+		r.Validations = append(r.Validations, fns...)
+	}
+}
+
+// WithEdgeOverrides lets getOverrides supply upgrade edges to allow alongside a catalog's own
+// upgrade graph, for packages whose UpgradeConstraintPolicy is CatalogProvided. A nil getOverrides
+// (the default) disables edge overrides.
+func WithEdgeOverrides(getOverrides func(ctx context.Context, packageName string) ([]ocv1.UpgradeEdgeOverride, error)) Option {
+	return func(r *internalresolve.CatalogResolver) {
+		r.EdgeOverridesFunc = getOverrides
+	}
+}
+
+// WithForceSemverUpgradeConstraints makes New's Resolver enforce a catalog's upgrade graph even for
+// ClusterExtensions whose UpgradeConstraintPolicy is SelfCertified.
+func WithForceSemverUpgradeConstraints(force bool) Option {
+	return func(r *internalresolve.CatalogResolver) {
+		r.ForceSemverUpgradeConstraints = force
+	}
+}
+
+// New constructs a Resolver that selects bundles from the catalogs walk provides, as customized by
+// opts.
+func New(walk func(ctx context.Context, packageName string, f WalkFunc, listOpts ...client.ListOption) error, opts ...Option) Resolver {
+	r := &internalresolve.CatalogResolver{
+		WalkCatalogsFunc: walk,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
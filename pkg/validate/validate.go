@@ -0,0 +1,208 @@
+// Package validate checks a File-Based Catalog against operator-controller's expectations, not
+// just against the generic FBC schema: resolvable channels, bundle properties operator-controller
+// understands, bundles whose metadata is complete enough to render, and bundles that don't declare
+// dependencies operator-controller's resolver doesn't support.
+//
+// It's meant for catalog CI: running it against a catalog image or an on-disk FBC directory before
+// publishing surfaces problems a ClusterCatalog pointed at that content would hit later, as
+// resolution or rendering failures on a live cluster instead of a build-time report.
+//
+// Validate does not pull bundle images or render any bundle's manifests; its "renderable bundles"
+// check is limited to what a bundle's olm.csv.metadata property declares. A bundle can pass here
+// and still fail to render if its image's manifests don't match that metadata.
+package validate
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/model"
+	"github.com/operator-framework/operator-registry/alpha/property"
+
+	"github.com/operator-framework/operator-controller/internal/operator-controller/resolve"
+)
+
+// Severity is how serious a Finding is. Catalog CI should fail a build on SeverityError findings;
+// SeverityWarning findings are worth surfacing but don't make the catalog unusable.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Check names one of the validations Catalog runs, for grouping and filtering a Report's Findings.
+type Check string
+
+const (
+	CheckResolvableChannels  Check = "resolvable-channels"
+	CheckSupportedProperties Check = "supported-bundle-properties"
+	CheckRenderableBundles   Check = "renderable-bundles"
+	CheckUnsupportedDeps     Check = "unsupported-dependencies"
+)
+
+// Finding is a single problem Catalog found, scoped to the package/channel/bundle it applies to.
+type Finding struct {
+	Check    Check    `json:"check"`
+	Severity Severity `json:"severity"`
+	Package  string   `json:"package,omitempty"`
+	Channel  string   `json:"channel,omitempty"`
+	Bundle   string   `json:"bundle,omitempty"`
+	Message  string   `json:"message"`
+}
+
+// Report is the result of validating a catalog. It's intended to be marshaled to JSON for
+// consumption by catalog CI.
+type Report struct {
+	Findings []Finding `json:"findings"`
+}
+
+// Passed reports whether the catalog is free of SeverityError findings. Warnings don't affect it.
+func (r *Report) Passed() bool {
+	for _, f := range r.Findings {
+		if f.Severity == SeverityError {
+			return false
+		}
+	}
+	return true
+}
+
+// knownBundleProperties are the property types declcfg.LoadFS itself already understands how to
+// parse. Anything else is an extension operator-controller's resolver and renderer don't act on.
+var knownBundleProperties = sets.New(
+	property.TypePackage,
+	property.TypePackageRequired,
+	property.TypeGVK,
+	property.TypeGVKRequired,
+	property.TypeBundleObject,
+	property.TypeCSVMetadata,
+	property.TypeConstraint,
+	property.TypeChannel,
+)
+
+// Catalog loads the FBC rooted at fsys and validates it, returning a Report of everything it
+// found. It returns an error only if the catalog couldn't be loaded at all.
+func Catalog(ctx context.Context, fsys fs.FS) (*Report, error) {
+	fbc, err := declcfg.LoadFS(ctx, fsys)
+	if err != nil {
+		return nil, fmt.Errorf("loading FBC: %w", err)
+	}
+
+	report := &Report{}
+	report.Findings = append(report.Findings, checkResolvableChannels(*fbc)...)
+	for _, b := range fbc.Bundles {
+		report.Findings = append(report.Findings, checkSupportedProperties(b)...)
+		report.Findings = append(report.Findings, checkRenderableBundle(b)...)
+		report.Findings = append(report.Findings, checkUnsupportedDependencies(b)...)
+	}
+	return report, nil
+}
+
+// checkResolvableChannels validates the catalog's packages, channels, and replaces chains using
+// operator-registry's own model validation, the same structural check opm runs. A catalog that
+// fails this can't be loaded into a resolvable index at all, regardless of anything
+// operator-controller does on top of it.
+func checkResolvableChannels(fbc declcfg.DeclarativeConfig) []Finding {
+	m, err := declcfg.ConvertToModel(fbc)
+	if err != nil {
+		return []Finding{{Check: CheckResolvableChannels, Severity: SeverityError, Message: err.Error()}}
+	}
+	if err := model.Model(m).Validate(); err != nil {
+		return []Finding{{Check: CheckResolvableChannels, Severity: SeverityError, Message: err.Error()}}
+	}
+	return nil
+}
+
+// checkSupportedProperties flags any property type on b that operator-controller's resolver and
+// renderer have no handling for, since the bundle's behavior will silently ignore whatever that
+// property was meant to declare.
+func checkSupportedProperties(b declcfg.Bundle) []Finding {
+	var findings []Finding
+	for _, p := range b.Properties {
+		if knownBundleProperties.Has(p.Type) {
+			continue
+		}
+		findings = append(findings, Finding{
+			Check:    CheckSupportedProperties,
+			Severity: SeverityWarning,
+			Package:  b.Package,
+			Bundle:   b.Name,
+			Message:  fmt.Sprintf("property %q is not a type operator-controller acts on", p.Type),
+		})
+	}
+	return findings
+}
+
+// checkRenderableBundle flags bundles that are missing the metadata operator-controller's renderer
+// needs, or that only declare install modes the renderer has no equivalent for. It can't detect a
+// bundle whose image manifests don't match this metadata, since it never pulls the image.
+func checkRenderableBundle(b declcfg.Bundle) []Finding {
+	props, err := property.Parse(b.Properties)
+	if err != nil {
+		return []Finding{{
+			Check:    CheckRenderableBundles,
+			Severity: SeverityError,
+			Package:  b.Package,
+			Bundle:   b.Name,
+			Message:  fmt.Sprintf("parsing properties: %v", err),
+		}}
+	}
+
+	if len(props.CSVMetadatas) == 0 {
+		return []Finding{{
+			Check:    CheckRenderableBundles,
+			Severity: SeverityWarning,
+			Package:  b.Package,
+			Bundle:   b.Name,
+			Message:  "bundle has no olm.csv.metadata property; renderability can't be checked without pulling its image",
+		}}
+	}
+
+	var findings []Finding
+	for _, md := range props.CSVMetadatas {
+		if !hasRenderableInstallMode(md.InstallModes) {
+			findings = append(findings, Finding{
+				Check:    CheckRenderableBundles,
+				Severity: SeverityError,
+				Package:  b.Package,
+				Bundle:   b.Name,
+				Message:  "CSV metadata supports only the MultiNamespace install mode, which operator-controller's renderer has no equivalent for",
+			})
+		}
+	}
+	return findings
+}
+
+func hasRenderableInstallMode(modes []v1alpha1.InstallMode) bool {
+	if len(modes) == 0 {
+		return true
+	}
+	for _, mode := range modes {
+		if mode.Supported && mode.Type != v1alpha1.InstallModeTypeMultiNamespace {
+			return true
+		}
+	}
+	return false
+}
+
+// checkUnsupportedDependencies flags bundles that declare a dependency via a property
+// operator-controller's resolver doesn't resolve, reusing the same validation the resolver itself
+// runs during ClusterExtension resolution so this check can never drift from what the resolver
+// actually enforces.
+func checkUnsupportedDependencies(b declcfg.Bundle) []Finding {
+	if err := resolve.NoDependencyValidation(&b); err != nil {
+		return []Finding{{
+			Check:    CheckUnsupportedDeps,
+			Severity: SeverityError,
+			Package:  b.Package,
+			Bundle:   b.Name,
+			Message:  err.Error(),
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package validate_test
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/operator-framework/operator-controller/pkg/validate"
+)
+
+func TestCatalog(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		fbc     string
+		wantErr []validate.Check
+		wantOK  bool
+	}{
+		{
+			name: "valid catalog passes",
+			fbc: `{"schema":"olm.package","name":"demo","defaultChannel":"stable"}
+{"schema":"olm.channel","package":"demo","name":"stable","entries":[{"name":"demo.v1.0.0"}]}
+{"schema":"olm.bundle","package":"demo","name":"demo.v1.0.0","image":"example.com/demo@sha256:abc","properties":[{"type":"olm.package","value":{"packageName":"demo","version":"1.0.0"}},{"type":"olm.csv.metadata","value":{"installModes":[{"type":"AllNamespaces","supported":true}]}}]}
+`,
+			wantOK: true,
+		},
+		{
+			name: "multiple channel heads fails resolvable-channels",
+			fbc: `{"schema":"olm.package","name":"demo","defaultChannel":"stable"}
+{"schema":"olm.channel","package":"demo","name":"stable","entries":[{"name":"demo.v1.0.0"},{"name":"demo.v1.1.0"}]}
+{"schema":"olm.bundle","package":"demo","name":"demo.v1.0.0","image":"example.com/demo@sha256:abc","properties":[{"type":"olm.package","value":{"packageName":"demo","version":"1.0.0"}}]}
+{"schema":"olm.bundle","package":"demo","name":"demo.v1.1.0","image":"example.com/demo@sha256:def","properties":[{"type":"olm.package","value":{"packageName":"demo","version":"1.1.0"}}]}
+`,
+			wantErr: []validate.Check{validate.CheckResolvableChannels},
+		},
+		{
+			name: "required package property fails unsupported-dependencies",
+			fbc: `{"schema":"olm.package","name":"demo","defaultChannel":"stable"}
+{"schema":"olm.channel","package":"demo","name":"stable","entries":[{"name":"demo.v1.0.0"}]}
+{"schema":"olm.bundle","package":"demo","name":"demo.v1.0.0","image":"example.com/demo@sha256:abc","properties":[{"type":"olm.package","value":{"packageName":"demo","version":"1.0.0"}},{"type":"olm.package.required","value":{"packageName":"other","versionRange":">=1.0.0"}}]}
+`,
+			wantErr: []validate.Check{validate.CheckUnsupportedDeps},
+		},
+		{
+			name: "MultiNamespace-only install mode fails renderable-bundles",
+			fbc: `{"schema":"olm.package","name":"demo","defaultChannel":"stable"}
+{"schema":"olm.channel","package":"demo","name":"stable","entries":[{"name":"demo.v1.0.0"}]}
+{"schema":"olm.bundle","package":"demo","name":"demo.v1.0.0","image":"example.com/demo@sha256:abc","properties":[{"type":"olm.package","value":{"packageName":"demo","version":"1.0.0"}},{"type":"olm.csv.metadata","value":{"installModes":[{"type":"MultiNamespace","supported":true}]}}]}
+`,
+			wantErr: []validate.Check{validate.CheckRenderableBundles},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"catalog.json": &fstest.MapFile{Data: []byte(tc.fbc)},
+			}
+
+			report, err := validate.Catalog(context.Background(), fsys)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantOK, report.Passed())
+
+			for _, check := range tc.wantErr {
+				require.Truef(t, hasFinding(report, check, validate.SeverityError), "expected a %s error finding, got %+v", check, report.Findings)
+			}
+		})
+	}
+}
+
+func hasFinding(report *validate.Report, check validate.Check, severity validate.Severity) bool {
+	for _, f := range report.Findings {
+		if f.Check == check && f.Severity == severity {
+			return true
+		}
+	}
+	return false
+}
@@ -41,9 +41,12 @@ import (
 )
 
 const (
-	olmDeploymentName = "operator-controller-controller-manager"
-	timeout           = 5 * time.Minute
-	tick              = 1 * time.Second
+	olmDeploymentName      = "operator-controller-controller-manager"
+	catalogdDeploymentName = "catalogd-controller-manager"
+	registryNamespace      = "operator-controller-e2e"
+	registryDeploymentName = "docker-registry"
+	timeout                = 5 * time.Minute
+	tick                   = 1 * time.Second
 )
 
 var (
@@ -101,6 +104,13 @@ func RegisterSteps(sc *godog.ScenarioContext) {
 	sc.Step(`^(?i)ClusterCatalog "([^"]+)" image version "([^"]+)" is also tagged as "([^"]+)"$`, TagCatalogImage)
 	sc.Step(`^(?i)ClusterCatalog "([^"]+)" is deleted$`, CatalogIsDeleted)
 
+	sc.Step(`^(?i)the operator-controller pod is killed$`, OperatorControllerPodIsKilled)
+	sc.Step(`^(?i)the catalogd pod is killed$`, CatalogdPodIsKilled)
+	sc.Step(`^(?i)the image registry is unavailable$`, ImageRegistryIsUnavailable)
+	sc.Step(`^(?i)the image registry is available again$`, ImageRegistryIsAvailable)
+	sc.Step(`^(?i)catalogd is unavailable$`, CatalogdIsUnavailable)
+	sc.Step(`^(?i)catalogd is available again$`, CatalogdIsAvailable)
+
 	sc.Step(`^(?i)operator "([^"]+)" target namespace is "([^"]+)"$`, OperatorTargetNamespace)
 	sc.Step(`^(?i)Prometheus metrics are returned in the response$`, PrometheusMetricsAreReturned)
 
@@ -843,6 +853,87 @@ func CatalogIsDeleted(ctx context.Context, catalogName string) error {
 	return nil
 }
 
+// podSelectorLabels returns the pod selector labels for the deployment named name in namespace.
+func podSelectorLabels(namespace, name string) (map[string]string, error) {
+	v, err := k8sClient("get", "deployment", "-n", namespace, name, "-o", "jsonpath={.spec.selector.matchLabels}")
+	if err != nil {
+		return nil, err
+	}
+	var labels map[string]string
+	if err := json.Unmarshal([]byte(v), &labels); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// killPodForDeployment deletes the pod(s) backing the deployment named name in namespace, relying on
+// the deployment controller to replace them, so that callers can exercise restart resilience.
+func killPodForDeployment(namespace, name string) error {
+	labels, err := podSelectorLabels(namespace, name)
+	if err != nil {
+		return err
+	}
+	args := []string{"delete", "pod", "-n", namespace}
+	for k, v := range labels {
+		args = append(args, fmt.Sprintf("--selector=%s=%s", k, v))
+	}
+	_, err = k8sClient(args...)
+	return err
+}
+
+// OperatorControllerPodIsKilled deletes the operator-controller pod, forcing it to be recreated, so
+// that scenarios can assert extensions still converge when the controller is interrupted mid-reconcile.
+func OperatorControllerPodIsKilled(ctx context.Context) error {
+	return killPodForDeployment(olmNamespace, olmDeploymentName)
+}
+
+// CatalogdPodIsKilled deletes the catalogd pod, forcing it to be recreated.
+func CatalogdPodIsKilled(ctx context.Context) error {
+	return killPodForDeployment(olmNamespace, catalogdDeploymentName)
+}
+
+func scaleDeployment(namespace, name string, replicas int) error {
+	_, err := k8sClient("scale", "deployment", "-n", namespace, name, fmt.Sprintf("--replicas=%d", replicas))
+	return err
+}
+
+func waitForDeploymentAvailable(ctx context.Context, namespace, name string) {
+	waitFor(ctx, func() bool {
+		v, err := k8sClient("get", "deployment", "-n", namespace, name, "-o", "jsonpath={.status.conditions[?(@.type==\"Available\")].status}")
+		return err == nil && v == "True"
+	})
+}
+
+// ImageRegistryIsUnavailable scales the e2e image registry to zero replicas, simulating an outage of
+// the registry that catalog and bundle images are pulled from.
+func ImageRegistryIsUnavailable(ctx context.Context) error {
+	return scaleDeployment(registryNamespace, registryDeploymentName, 0)
+}
+
+// ImageRegistryIsAvailable scales the e2e image registry back up and waits for it to become available.
+func ImageRegistryIsAvailable(ctx context.Context) error {
+	if err := scaleDeployment(registryNamespace, registryDeploymentName, 1); err != nil {
+		return err
+	}
+	waitForDeploymentAvailable(ctx, registryNamespace, registryDeploymentName)
+	return nil
+}
+
+// CatalogdIsUnavailable scales catalogd to zero replicas, simulating catalogd being down, as opposed
+// to the source ClusterCatalog itself being deleted.
+func CatalogdIsUnavailable(ctx context.Context) error {
+	return scaleDeployment(olmNamespace, catalogdDeploymentName, 0)
+}
+
+// CatalogdIsAvailable scales catalogd back up and waits for it to become available.
+func CatalogdIsAvailable(ctx context.Context) error {
+	if err := scaleDeployment(olmNamespace, catalogdDeploymentName, 1); err != nil {
+		return err
+	}
+	waitForDeploymentAvailable(ctx, olmNamespace, catalogdDeploymentName)
+	return nil
+}
+
 func PrometheusMetricsAreReturned(ctx context.Context) error {
 	sc := scenarioCtx(ctx)
 	for podName, mr := range sc.metricsResponse {
@@ -1,5 +1,3 @@
-// Package utils provides helper functions for e2e tests, including
-// feature gate detection and validation utilities.
 package utils
 
 import (
@@ -0,0 +1,174 @@
+// Package synthcatalog programmatically builds synthetic FBC catalogs and pushes them to a
+// registry as catalog images, so upgrade-edge logic and scale behavior can be exercised with
+// catalogs far larger and more varied than the repo's hand-maintained test-catalog fixtures.
+package synthcatalog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+)
+
+// catalogConfigsLabel tells catalogd where to find the FBC content inside the image, matching the
+// label testdata/push applies to the repo's hand-built catalog image fixtures.
+const catalogConfigsLabel = "operators.operatorframework.io.index.configs.v1"
+
+// Config describes the packages a synthetic catalog should contain.
+type Config struct {
+	Packages []Package
+}
+
+// Package describes one package's worth of synthetic catalog content.
+type Package struct {
+	// Name is the package name.
+	Name string
+	// DefaultChannel is the package's default channel. Defaults to Channels[0].Name if empty.
+	DefaultChannel string
+	// Channels are the package's channels.
+	Channels []Channel
+	// Deprecated marks the whole package as deprecated.
+	Deprecated bool
+}
+
+// Channel describes one channel's worth of bundles, forming an upgrade graph.
+type Channel struct {
+	// Name is the channel name.
+	Name string
+	// Entries are the channel's entries, giving full control over the upgrade graph via each
+	// entry's Replaces/Skips/SkipRange. Use Linear to build a simple sequential chain.
+	Entries []declcfg.ChannelEntry
+	// DeprecatedEntries marks the named entries' bundles as deprecated.
+	DeprecatedEntries []string
+}
+
+// Linear returns the Entries for a channel whose versions form a single upgrade chain, each
+// replacing the one before it, named "<pkg>.v<version>".
+func Linear(pkg string, versions ...string) []declcfg.ChannelEntry {
+	entries := make([]declcfg.ChannelEntry, len(versions))
+	for i, version := range versions {
+		entries[i] = declcfg.ChannelEntry{Name: BundleName(pkg, version)}
+		if i > 0 {
+			entries[i].Replaces = BundleName(pkg, versions[i-1])
+		}
+	}
+	return entries
+}
+
+// BundleName returns the conventional bundle name for version of pkg.
+func BundleName(pkg, version string) string {
+	return fmt.Sprintf("%s.v%s", pkg, version)
+}
+
+// Build renders cfg into a DeclarativeConfig. image is called for each bundle to compute its
+// image reference.
+func Build(cfg Config, image func(pkg, bundleName string) string) *declcfg.DeclarativeConfig {
+	fbc := &declcfg.DeclarativeConfig{}
+	for _, pkg := range cfg.Packages {
+		defaultChannel := pkg.DefaultChannel
+		if defaultChannel == "" && len(pkg.Channels) > 0 {
+			defaultChannel = pkg.Channels[0].Name
+		}
+		fbc.Packages = append(fbc.Packages, declcfg.Package{
+			Schema:         declcfg.SchemaPackage,
+			Name:           pkg.Name,
+			DefaultChannel: defaultChannel,
+		})
+
+		var deprecationEntries []declcfg.DeprecationEntry
+		if pkg.Deprecated {
+			deprecationEntries = append(deprecationEntries, declcfg.DeprecationEntry{
+				Reference: declcfg.PackageScopedReference{Schema: declcfg.SchemaPackage},
+				Message:   fmt.Sprintf("package %s is deprecated", pkg.Name),
+			})
+		}
+
+		seenBundles := map[string]bool{}
+		for _, ch := range pkg.Channels {
+			fbc.Channels = append(fbc.Channels, declcfg.Channel{
+				Schema:  declcfg.SchemaChannel,
+				Name:    ch.Name,
+				Package: pkg.Name,
+				Entries: ch.Entries,
+			})
+
+			deprecated := map[string]bool{}
+			for _, name := range ch.DeprecatedEntries {
+				deprecated[name] = true
+			}
+
+			for _, entry := range ch.Entries {
+				if seenBundles[entry.Name] {
+					continue
+				}
+				seenBundles[entry.Name] = true
+				fbc.Bundles = append(fbc.Bundles, bundleFor(pkg.Name, entry.Name, image))
+				if deprecated[entry.Name] {
+					deprecationEntries = append(deprecationEntries, declcfg.DeprecationEntry{
+						Reference: declcfg.PackageScopedReference{Schema: declcfg.SchemaBundle, Name: entry.Name},
+						Message:   fmt.Sprintf("bundle %s is deprecated", entry.Name),
+					})
+				}
+			}
+		}
+
+		if len(deprecationEntries) > 0 {
+			fbc.Deprecations = append(fbc.Deprecations, declcfg.Deprecation{
+				Schema:  declcfg.SchemaDeprecation,
+				Package: pkg.Name,
+				Entries: deprecationEntries,
+			})
+		}
+	}
+	return fbc
+}
+
+func bundleFor(pkg, bundleName string, image func(pkg, bundleName string) string) declcfg.Bundle {
+	return declcfg.Bundle{
+		Schema:  declcfg.SchemaBundle,
+		Name:    bundleName,
+		Package: pkg,
+		Image:   image(pkg, bundleName),
+		Properties: []property.Property{
+			property.MustBuildPackage(pkg, bundleVersion(bundleName)),
+		},
+	}
+}
+
+// bundleVersion extracts the version suffix from a "<pkg>.v<version>" bundle name.
+func bundleVersion(bundleName string) string {
+	for i := len(bundleName) - 1; i >= 0; i-- {
+		if bundleName[i] == 'v' && i > 0 && bundleName[i-1] == '.' {
+			return bundleName[i+1:]
+		}
+	}
+	return bundleName
+}
+
+// Push renders fbc as a single-layer catalog image labeled for catalogd and pushes it to ref.
+func Push(_ context.Context, fbc *declcfg.DeclarativeConfig, ref string) error {
+	var buf bytes.Buffer
+	if err := declcfg.WriteJSON(*fbc, &buf); err != nil {
+		return fmt.Errorf("encoding synthetic catalog: %w", err)
+	}
+
+	img, err := crane.Image(map[string][]byte{"configs/catalog.json": buf.Bytes()})
+	if err != nil {
+		return fmt.Errorf("building synthetic catalog image: %w", err)
+	}
+	img, err = mutate.Config(img, v1.Config{Labels: map[string]string{catalogConfigsLabel: "/configs"}})
+	if err != nil {
+		return fmt.Errorf("labeling synthetic catalog image: %w", err)
+	}
+
+	if err := crane.Push(img, ref); err != nil {
+		return fmt.Errorf("pushing synthetic catalog image to %q: %w", ref, err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package synthcatalog
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/require"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+func testImageRef(pkg, bundleName string) string {
+	return fmt.Sprintf("example.com/bundles/%s", bundleName)
+}
+
+func TestBuild(t *testing.T) {
+	cfg := Config{
+		Packages: []Package{
+			{
+				Name:           "pkg-a",
+				DefaultChannel: "stable",
+				Channels: []Channel{
+					{
+						Name:              "stable",
+						Entries:           Linear("pkg-a", "1.0.0", "1.0.1", "1.1.0"),
+						DeprecatedEntries: []string{BundleName("pkg-a", "1.0.0")},
+					},
+				},
+			},
+			{
+				Name: "pkg-b",
+				Channels: []Channel{
+					{Name: "alpha", Entries: Linear("pkg-b", "0.1.0")},
+				},
+				Deprecated: true,
+			},
+		},
+	}
+
+	fbc := Build(cfg, testImageRef)
+
+	require.Len(t, fbc.Packages, 2)
+	require.Equal(t, "stable", fbc.Packages[0].DefaultChannel)
+	require.Equal(t, "alpha", fbc.Packages[1].DefaultChannel)
+
+	require.Len(t, fbc.Bundles, 4)
+	require.Equal(t, "example.com/bundles/pkg-a.v1.0.0", bundleImage(t, fbc, "pkg-a.v1.0.0"))
+
+	require.Len(t, fbc.Channels, 2)
+	require.Equal(t, "pkg-a.v1.0.0", fbc.Channels[0].Entries[1].Replaces)
+
+	require.Len(t, fbc.Deprecations, 2)
+	pkgADeprecation := deprecationFor(t, fbc, "pkg-a")
+	require.Len(t, pkgADeprecation.Entries, 1)
+	require.Equal(t, declcfg.SchemaBundle, pkgADeprecation.Entries[0].Reference.Schema)
+	require.Equal(t, "pkg-a.v1.0.0", pkgADeprecation.Entries[0].Reference.Name)
+
+	pkgBDeprecation := deprecationFor(t, fbc, "pkg-b")
+	require.Len(t, pkgBDeprecation.Entries, 1)
+	require.Equal(t, declcfg.SchemaPackage, pkgBDeprecation.Entries[0].Reference.Schema)
+}
+
+func TestPush(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	fbc := Build(Config{
+		Packages: []Package{
+			{Name: "pkg-a", Channels: []Channel{{Name: "stable", Entries: Linear("pkg-a", "1.0.0")}}},
+		},
+	}, testImageRef)
+
+	ref := fmt.Sprintf("%s/e2e/synthetic-catalog:v1", serverURL.Host)
+	require.NoError(t, Push(context.Background(), fbc, ref))
+
+	img, err := crane.Pull(ref)
+	require.NoError(t, err)
+	cfg, err := img.ConfigFile()
+	require.NoError(t, err)
+	require.Equal(t, "/configs", cfg.Config.Labels[catalogConfigsLabel])
+}
+
+func bundleImage(t *testing.T, fbc *declcfg.DeclarativeConfig, name string) string {
+	t.Helper()
+	for _, b := range fbc.Bundles {
+		if b.Name == name {
+			return b.Image
+		}
+	}
+	t.Fatalf("bundle %q not found", name)
+	return ""
+}
+
+func deprecationFor(t *testing.T, fbc *declcfg.DeclarativeConfig, pkg string) declcfg.Deprecation {
+	t.Helper()
+	for _, d := range fbc.Deprecations {
+		if d.Package == pkg {
+			return d
+		}
+	}
+	t.Fatalf("no deprecation found for package %q", pkg)
+	return declcfg.Deprecation{}
+}
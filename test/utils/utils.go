@@ -1,3 +1,13 @@
+// Package utils is a reusable test harness for exercising OLMv1 against a running cluster: catalog
+// creation and unpack validation, ClusterExtension install-scaffolding (ServiceAccount, RBAC,
+// namespace), condition-waiting assertions, and teardown. operator-controller's own e2e suite
+// (test/e2e) uses it, and it's also intended for downstream distributions and extension authors
+// who want conformance-style tests against their own clusters without reimplementing this
+// scaffolding.
+//
+// It expects a kubeconfig pointing at a cluster with OLMv1 already installed (see hack/ for
+// cluster setup and local registry scripts, which this package doesn't wrap), and a client is
+// built from it on import.
 package utils
 
 import (